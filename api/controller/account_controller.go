@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseAccountController struct {
+	env            *domain.Env
+	loggerUtil     domain.LoggerUtil
+	accountUsecase domain.AccountUsecase
+}
+
+func NewAccountController(env *domain.Env, loggerUtil domain.LoggerUtil, accountUsecase domain.AccountUsecase) domain.AccountController {
+	return &baseAccountController{
+		env:            env,
+		loggerUtil:     loggerUtil,
+		accountUsecase: accountUsecase,
+	}
+}
+
+// DeleteAccount godoc
+//
+//	@Summary		Delete the current user
+//	@Description	Deletes the user from the auth provider and anonymises the account, order history is kept.
+//	@Tags			user
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200
+//	@Failure		403	"access denied"
+//	@Failure		409	"cannot revoke the last admin"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/me [delete]
+func (b *baseAccountController) DeleteAccount(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.accountUsecase.DeleteAccount(c.Request().Context(), user, c.RealIP())
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// ExportData godoc
+//
+//	@Summary		Export personal data of the current user
+//	@Description	Starts a data export when there is none, it failed or it is older than 24 hours. Poll until the status is READY.
+//	@Tags			user
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	domain.AccountControllerResponseDataExport	"export is ready"
+//	@Success		202	{object}	domain.AccountControllerResponseDataExport	"export is pending"
+//	@Failure		403	"access denied"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/me/export [get]
+func (b *baseAccountController) ExportData(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	res, err := b.accountUsecase.ExportData(c.Request().Context(), user, c.RealIP())
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if res.Status != domain.DataExportStatusReady {
+		return response_util.FromAcceptedData(res).WithEcho(c)
+	}
+
+	return response_util.FromData(res).WithEcho(c)
+}
@@ -0,0 +1,107 @@
+package controller_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountControllerSuite struct {
+	suite.Suite
+	ucMock    *mocks.AccountUsecaseMock
+	ct        domain.AccountController
+	user      *domain.UserModel
+	reqHelper func(method, target string) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *AccountControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	accountUsecaseMock := &mocks.AccountUsecaseMock{}
+	ct := controller.NewAccountController(env, nil, accountUsecaseMock)
+
+	s.ct = ct
+	s.ucMock = accountUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID(), Email: gofakeit.Email()}
+	s.reqHelper = func(method, target string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestAccountControllerSuite(t *testing.T) {
+	suite.Run(t, new(AccountControllerSuite))
+}
+
+func (s *AccountControllerSuite) TestDeleteAccount() {
+	s.Run("Delete account should pass the current user to usecase", func() {
+		c, rec := s.reqHelper(http.MethodDelete, "/")
+
+		if s.NoError(s.ct.DeleteAccount(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+			_, user, _ := s.ucMock.DeleteAccountArgsForCall(0)
+			s.Equal(s.user, user)
+		}
+	})
+
+	s.Run("Delete account should return conflict error given the last admin", func() {
+		c, rec := s.reqHelper(http.MethodDelete, "/")
+
+		s.ucMock.DeleteAccountReturns(domain.ErrLastAdmin)
+		if s.NoError(s.ct.DeleteAccount(c)) {
+			s.Equal(http.StatusConflict, rec.Code)
+		}
+	})
+
+	s.Run("Delete account should pass unexpected usecase error to the error handler", func() {
+		err := errors.New("auth provider unavailable")
+		c, rec := s.reqHelper(http.MethodDelete, "/")
+
+		s.ucMock.DeleteAccountReturns(err)
+		s.ErrorIs(s.ct.DeleteAccount(c), err)
+		s.Equal(0, rec.Body.Len())
+	})
+}
+
+func (s *AccountControllerSuite) TestExportData() {
+	s.Run("Export data should return accepted given a pending export", func() {
+		c, rec := s.reqHelper(http.MethodGet, "/export")
+
+		s.ucMock.ExportDataReturns(&domain.AccountControllerResponseDataExport{Status: domain.DataExportStatusPending}, nil)
+		if s.NoError(s.ct.ExportData(c)) {
+			s.Equal(http.StatusAccepted, rec.Code)
+		}
+	})
+
+	s.Run("Export data should return ok given a ready export", func() {
+		c, rec := s.reqHelper(http.MethodGet, "/export")
+
+		s.ucMock.ExportDataReturns(&domain.AccountControllerResponseDataExport{Status: domain.DataExportStatusReady, Archive: []byte(`{}`)}, nil)
+		if s.NoError(s.ct.ExportData(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+			s.Contains(rec.Body.String(), `"archive":{}`)
+		}
+	})
+
+	s.Run("Export data should return forbidden error given no user", func() {
+		c, rec := s.reqHelper(http.MethodGet, "/export")
+		c.Set("user", nil)
+
+		if s.NoError(s.ct.ExportData(c)) {
+			s.Equal(http.StatusForbidden, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseAddressController struct {
+	env            *domain.Env
+	loggerUtil     domain.LoggerUtil
+	addressUsecase domain.AddressUsecase
+	validate       *validator.Validate
+}
+
+func NewAddressController(env *domain.Env, loggerUtil domain.LoggerUtil, addressUsecase domain.AddressUsecase, validate *validator.Validate) domain.AddressController {
+	return &baseAddressController{
+		env:            env,
+		loggerUtil:     loggerUtil,
+		addressUsecase: addressUsecase,
+		validate:       validate,
+	}
+}
+
+// ListAddresses godoc
+//
+//	@Summary	List addresses of the current user, default address first
+//	@Tags		address
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{array}	domain.AddressControllerResponseAddress
+//	@Failure	403	"access denied"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/me/addresses [get]
+func (b *baseAddressController) ListAddresses(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	addresses, err := b.addressUsecase.ListAddresses(c.Request().Context(), user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(addresses).WithEcho(c)
+}
+
+// GetAddress godoc
+//
+//	@Summary	Get an address of the current user
+//	@Tags		address
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path		string	true	"address uid"
+//	@Success	200	{object}	domain.AddressControllerResponseAddress
+//	@Failure	403	"access denied"
+//	@Failure	404	"address not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/me/addresses/{uid} [get]
+func (b *baseAddressController) GetAddress(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	address, err := b.addressUsecase.GetAddress(c.Request().Context(), user.ID, c.Param("uid"))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(address).WithEcho(c)
+}
+
+// CreateAddress godoc
+//
+//	@Summary		Create an address for the current user
+//	@Description	The first address of a user always becomes the default address.
+//	@Tags			address
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			payload	body		domain.AddressControllerPayloadCreateAddress	true	"address"
+//	@Success		201		{object}	domain.AddressControllerResponseAddress
+//	@Failure		400		"validation error | invalid phone"
+//	@Failure		403		"access denied"
+//	@Failure		500		"Internal Server Error"
+//	@Router			/me/addresses [post]
+func (b *baseAddressController) CreateAddress(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.AddressControllerPayloadCreateAddress
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	address, err := b.addressUsecase.CreateAddress(c.Request().Context(), user.ID, &payload)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(address).WithEcho(c)
+}
+
+// UpdateAddress godoc
+//
+//	@Summary	Update an address of the current user
+//	@Tags		address
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid		path		string										true	"address uid"
+//	@Param		payload	body		domain.AddressControllerPayloadUpdateAddress	true	"address"
+//	@Success	200		{object}	domain.AddressControllerResponseAddress
+//	@Failure	400		"validation error | invalid phone"
+//	@Failure	403		"access denied"
+//	@Failure	404		"address not found"
+//	@Failure	500		"Internal Server Error"
+//	@Router		/me/addresses/{uid} [put]
+func (b *baseAddressController) UpdateAddress(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.AddressControllerPayloadUpdateAddress
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	address, err := b.addressUsecase.UpdateAddress(c.Request().Context(), user.ID, c.Param("uid"), &payload)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(address).WithEcho(c)
+}
+
+// SetDefaultAddress godoc
+//
+//	@Summary	Make an address the default address of the current user
+//	@Tags		address
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"address uid"
+//	@Success	200
+//	@Failure	403	"access denied"
+//	@Failure	404	"address not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/me/addresses/{uid}/default [put]
+func (b *baseAddressController) SetDefaultAddress(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.addressUsecase.SetDefaultAddress(c.Request().Context(), user.ID, c.Param("uid"))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// DeleteAddress godoc
+//
+//	@Summary		Delete an address of the current user
+//	@Description	Deleting the default address makes the most recently created remaining address the default.
+//	@Tags			address
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			uid	path	string	true	"address uid"
+//	@Success		200
+//	@Failure		403	"access denied"
+//	@Failure		404	"address not found"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/me/addresses/{uid} [delete]
+func (b *baseAddressController) DeleteAddress(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.addressUsecase.DeleteAddress(c.Request().Context(), user.ID, c.Param("uid"))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
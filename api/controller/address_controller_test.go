@@ -0,0 +1,152 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddressControllerSuite struct {
+	suite.Suite
+	ucMock    *mocks.AddressUsecaseMock
+	ct        domain.AddressController
+	user      *domain.UserModel
+	reqHelper func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *AddressControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	addressUsecaseMock := &mocks.AddressUsecaseMock{}
+	ct := controller.NewAddressController(env, nil, addressUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = addressUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID()}
+	s.reqHelper = func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestAddressControllerSuite(t *testing.T) {
+	suite.Run(t, new(AddressControllerSuite))
+}
+
+func (s *AddressControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *AddressControllerSuite) TestCreateAddress() {
+	body := `{"recipient_name":"Budi","phone":"081234567890","street":"Jl. Merdeka No. 10","province":"Jawa Barat","city":"Kota Bandung","district":"Sumur Bandung","sub_district":"Braga","postal_code":"40111"}`
+
+	s.Run("Create address should be scoped to the current user", func() {
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(body))
+
+		s.ucMock.CreateAddressReturns(&domain.AddressControllerResponseAddress{UID: gofakeit.UUID()}, nil)
+		if s.NoError(s.ct.CreateAddress(c)) {
+			s.Equal(http.StatusCreated, rec.Code)
+			_, userID, payload := s.ucMock.CreateAddressArgsForCall(0)
+			s.Equal(s.user.ID, userID)
+			s.Equal("Braga", payload.SubDistrict)
+			s.Equal("40111", payload.PostalCode)
+		}
+	})
+
+	s.Run("Create address should return validation error given invalid postal code", func() {
+		callCount := s.ucMock.CreateAddressCallCount()
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(strings.Replace(body, "40111", "4011", 1)))
+
+		if s.NoError(s.ct.CreateAddress(c)) {
+			s.Equal(http.StatusBadRequest, rec.Code)
+			s.Equal(callCount, s.ucMock.CreateAddressCallCount())
+		}
+	})
+
+	s.Run("Create address should return bad request error given invalid phone", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrInvalidPhone.Error(),
+			ErrorCode: domain.ErrInvalidPhone.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(body))
+
+		s.ucMock.CreateAddressReturns(nil, domain.ErrInvalidPhone)
+		if s.NoError(s.ct.CreateAddress(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *AddressControllerSuite) TestDeleteAddress() {
+	s.Run("Delete address should return not found error given address of another user", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusNotFound,
+			Status:    http.StatusText(http.StatusNotFound),
+			Error:     domain.ErrAddressNotFound.Error(),
+			ErrorCode: domain.ErrAddressNotFound.Code,
+		}
+		addressUID := gofakeit.UUID()
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(addressUID)
+
+		s.ucMock.DeleteAddressReturns(domain.ErrAddressNotFound)
+		if s.NoError(s.ct.DeleteAddress(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, userID, UID := s.ucMock.DeleteAddressArgsForCall(0)
+			s.Equal(s.user.ID, userID)
+			s.Equal(addressUID, UID)
+		}
+	})
+
+	s.Run("Delete address should return forbidden error given no user", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusForbidden,
+			Status:    http.StatusText(http.StatusForbidden),
+			Error:     domain.ErrAccessDenied.Error(),
+			ErrorCode: domain.ErrAccessDenied.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.Set("user", nil)
+
+		if s.NoError(s.ct.DeleteAddress(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseAdminController struct {
+	env          *domain.Env
+	loggerUtil   domain.LoggerUtil
+	adminUsecase domain.AdminUsecase
+	validate     *validator.Validate
+}
+
+func NewAdminController(env *domain.Env, loggerUtil domain.LoggerUtil, adminUsecase domain.AdminUsecase, validate *validator.Validate) domain.AdminController {
+	return &baseAdminController{
+		env:          env,
+		loggerUtil:   loggerUtil,
+		adminUsecase: adminUsecase,
+		validate:     validate,
+	}
+}
+
+// ListAdmins godoc
+//
+//	@Summary	List admins
+//	@Tags		admin
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{array}	domain.AdminControllerResponseAdmin
+//	@Failure	403	"access denied"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/admins [get]
+func (b *baseAdminController) ListAdmins(c echo.Context) error {
+	admins, err := b.adminUsecase.ListAdmins(c.Request().Context())
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(admins).WithEcho(c)
+}
+
+// GrantAdmin godoc
+//
+//	@Summary	Grant admin role to a user
+//	@Tags		admin
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		payload	body	domain.AdminControllerPayloadGrantAdmin	true	"email of the user"
+//	@Success	201
+//	@Failure	400	"validation error"
+//	@Failure	403	"access denied"
+//	@Failure	404	"user not found"
+//	@Failure	409	"admin already exist"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/admins [post]
+func (b *baseAdminController) GrantAdmin(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.AdminControllerPayloadGrantAdmin
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	UID, err := b.adminUsecase.GrantAdmin(c.Request().Context(), &domain.AdminUsecasePayloadGrantAdmin{
+		Email:       payload.Email,
+		ActorUserID: user.ID,
+		IPAddress:   c.RealIP(),
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(map[string]string{"uid": UID}).WithEcho(c)
+}
+
+// RevokeAdmin godoc
+//
+//	@Summary	Revoke admin role
+//	@Tags		admin
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"admin uid"
+//	@Success	200
+//	@Failure	403	"access denied"
+//	@Failure	404	"admin not found"
+//	@Failure	409	"cannot revoke the last admin"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/admins/{uid} [delete]
+func (b *baseAdminController) RevokeAdmin(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.adminUsecase.RevokeAdmin(c.Request().Context(), &domain.AdminUsecasePayloadRevokeAdmin{
+		UID:         c.Param("uid"),
+		ActorUserID: user.ID,
+		IPAddress:   c.RealIP(),
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
@@ -0,0 +1,162 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdminControllerSuite struct {
+	suite.Suite
+	ucMock      *mocks.AdminUsecaseMock
+	ct          domain.AdminController
+	user        *domain.UserModel
+	notFoundRes response_util.Response
+	conflictRes response_util.Response
+	reqHelper   func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *AdminControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	adminUsecaseMock := &mocks.AdminUsecaseMock{}
+	ct := controller.NewAdminController(env, nil, adminUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = adminUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID()}
+	s.notFoundRes = response_util.Response{
+		Code:   http.StatusNotFound,
+		Status: http.StatusText(http.StatusNotFound),
+	}
+	s.conflictRes = response_util.Response{
+		Code:   http.StatusConflict,
+		Status: http.StatusText(http.StatusConflict),
+	}
+	s.reqHelper = func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestAdminControllerSuite(t *testing.T) {
+	suite.Run(t, new(AdminControllerSuite))
+}
+
+func (s *AdminControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *AdminControllerSuite) TestGrantAdmin() {
+	s.Run("Grant admin should return created with the actor of the request", func() {
+		expectedUID := gofakeit.UUID()
+		expectedRes := response_util.Response{
+			Code:   http.StatusCreated,
+			Status: http.StatusText(http.StatusCreated),
+			Data:   map[string]interface{}{"uid": expectedUID},
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(`{"email":"admin@email.com"}`))
+
+		s.ucMock.GrantAdminReturns(expectedUID, nil)
+		if s.NoError(s.ct.GrantAdmin(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, payload := s.ucMock.GrantAdminArgsForCall(0)
+			s.Equal("admin@email.com", payload.Email)
+			s.Equal(s.user.ID, payload.ActorUserID)
+		}
+	})
+
+	s.Run("Grant admin should return not found error given unknown user", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrUserNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrUserNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(`{"email":"admin@email.com"}`))
+
+		s.ucMock.GrantAdminReturns("", domain.ErrUserNotFound)
+		if s.NoError(s.ct.GrantAdmin(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Grant admin should return conflict error given existing admin", func() {
+		expectedRes := s.conflictRes
+		expectedRes.Error = domain.ErrAdminAlreadyExist.Error()
+		expectedRes.ErrorCode = domain.ErrAdminAlreadyExist.Code
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(`{"email":"admin@email.com"}`))
+
+		s.ucMock.GrantAdminReturns("", domain.ErrAdminAlreadyExist)
+		if s.NoError(s.ct.GrantAdmin(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *AdminControllerSuite) TestRevokeAdmin() {
+	s.Run("Revoke admin should return conflict error given the last admin", func() {
+		expectedRes := s.conflictRes
+		expectedRes.Error = domain.ErrLastAdmin.Error()
+		expectedRes.ErrorCode = domain.ErrLastAdmin.Code
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.RevokeAdminReturns(domain.ErrLastAdmin)
+		if s.NoError(s.ct.RevokeAdmin(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Revoke admin should return OK if successful", func() {
+		expectedRes := response_util.Response{
+			Code:   http.StatusOK,
+			Status: http.StatusText(http.StatusOK),
+		}
+		UID := gofakeit.UUID()
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(UID)
+
+		s.ucMock.RevokeAdminReturns(nil)
+		if s.NoError(s.ct.RevokeAdmin(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, payload := s.ucMock.RevokeAdminArgsForCall(1)
+			s.Equal(UID, payload.UID)
+		}
+	})
+}
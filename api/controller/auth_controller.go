@@ -16,6 +16,14 @@ type baseAuthController struct {
 	validate    *validator.Validate
 }
 
+// client returns the device a session is started or refreshed from.
+func client(c echo.Context) *domain.AuthUsecasePropertyClient {
+	return &domain.AuthUsecasePropertyClient{
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
+	}
+}
+
 func NewAuthController(env *domain.Env, loggerUtil domain.LoggerUtil, authUsecase domain.AuthUsecase, validate *validator.Validate) domain.AuthController {
 	return &baseAuthController{
 		env:         env,
@@ -33,7 +41,8 @@ func NewAuthController(env *domain.Env, loggerUtil domain.LoggerUtil, authUsecas
 //	@Produce	json
 //	@Param		credential body	domain.AuthControllerPayloadSignUp true	"email and password"
 //	@Success	201
-//	@Failure	400	"validation error | user already exist"
+//	@Failure	400	"validation error"
+//	@Failure	409	"user already exist"
 //	@Failure	500	"Internal Server Error"
 //	@Router		/auth/signup [post]
 func (b *baseAuthController) SignUp(c echo.Context) error {
@@ -50,12 +59,8 @@ func (b *baseAuthController) SignUp(c echo.Context) error {
 		}
 	}
 
-	err = b.authUsecase.SignUp(c.Request().Context(), payload.Email, payload.Password, payload.IsAdmin)
+	err = b.authUsecase.SignUp(c.Request().Context(), payload.Email, payload.Password)
 	if err != nil {
-		if err.Error() == "user already exist" {
-			return response_util.FromBadRequestError(err).WithEcho(c)
-		}
-
 		return response_util.FromError(err).WithEcho(c)
 	}
 
@@ -69,11 +74,12 @@ func (b *baseAuthController) SignUp(c echo.Context) error {
 //	@Accept		json
 //	@Produce	json
 //	@Param		credential body domain.AuthControllerPayloadGetAccessToken	true "email and password"
-//	@Success	200
+//	@Success	200	{object}	domain.AuthControllerResponseTokenPair
 //	@Failure	400	"validation error"
-//	@Failure	404	"user not found"
+//	@Failure	401	"invalid email or password"
+//	@Failure	429	"too many login attempts, try again later"
 //	@Failure	500	"Internal Server Error"
-//	@Router		/auth/access-token [post]
+//	@Router		/auth/token [post]
 func (b *baseAuthController) GetAccessToken(c echo.Context) error {
 	var payload domain.AuthControllerPayloadGetAccessToken
 	err := c.Bind(&payload)
@@ -88,14 +94,264 @@ func (b *baseAuthController) GetAccessToken(c echo.Context) error {
 		}
 	}
 
-	accessToken, err := b.authUsecase.GetAccessToken(c.Request().Context(), payload.Email, payload.Password)
+	tokenPair, err := b.authUsecase.GetAccessToken(c.Request().Context(), payload.Email, payload.Password, client(c))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(tokenPair).WithEcho(c)
+}
+
+// RefreshToken godoc
+//
+//	@Summary		Refresh token
+//	@Description	Exchange a refresh token for a new token pair, the refresh token can only be used once
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		domain.AuthControllerPayloadRefreshToken	true	"refresh token"
+//	@Success		200		{object}	domain.AuthControllerResponseTokenPair
+//	@Failure		400		"validation error"
+//	@Failure		401		"invalid refresh token | refresh token has already been used"
+//	@Failure		500		"Internal Server Error"
+//	@Router			/auth/refresh [post]
+func (b *baseAuthController) RefreshToken(c echo.Context) error {
+	var payload domain.AuthControllerPayloadRefreshToken
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	tokenPair, err := b.authUsecase.RefreshToken(c.Request().Context(), payload.RefreshToken, client(c))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(tokenPair).WithEcho(c)
+}
+
+// Logout godoc
+//
+//	@Summary		Logout
+//	@Description	End the session of the refresh token
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			payload	body	domain.AuthControllerPayloadLogout	true	"refresh token of the session"
+//	@Success		200
+//	@Failure		400	"validation error"
+//	@Failure		401	"invalid refresh token"
+//	@Failure		403	"access denied"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/auth/logout [post]
+func (b *baseAuthController) Logout(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.AuthControllerPayloadLogout
+	err := c.Bind(&payload)
 	if err != nil {
-		if err.Error() == "user not found" {
-			return response_util.FromNotFoundError(err).WithEcho(c)
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
 		}
+	}
+
+	err = b.authUsecase.Logout(c.Request().Context(), user.ID, payload.RefreshToken)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
 
+// LogoutAll godoc
+//
+//	@Summary		Logout from all devices
+//	@Description	End every session of the user, access tokens issued before are rejected
+//	@Tags			auth
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200
+//	@Failure		403	"access denied"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/auth/logout-all [post]
+func (b *baseAuthController) LogoutAll(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.authUsecase.LogoutAll(c.Request().Context(), user)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// ListSessions godoc
+//
+//	@Summary	List active sessions
+//	@Tags		auth
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{array}	domain.AuthControllerResponseSession
+//	@Failure	403	"access denied"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/auth/sessions [get]
+func (b *baseAuthController) ListSessions(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	sessions, err := b.authUsecase.ListSessions(c.Request().Context(), user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(sessions).WithEcho(c)
+}
+
+// ForgotPassword godoc
+//
+//	@Summary		Forgot password
+//	@Description	Email a reset password link, the response is the same whether the email is registered or not
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body	domain.AuthControllerPayloadForgotPassword	true	"email of the account"
+//	@Success		200
+//	@Failure		400	"validation error"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/auth/password/forgot [post]
+func (b *baseAuthController) ForgotPassword(c echo.Context) error {
+	var payload domain.AuthControllerPayloadForgotPassword
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.authUsecase.ForgotPassword(c.Request().Context(), payload.Email)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// ResetPassword godoc
+//
+//	@Summary		Reset password
+//	@Description	Set a new password with the oobCode of the reset password link, every session of the user is ended
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body	domain.AuthControllerPayloadResetPassword	true	"code and new password"
+//	@Success		200
+//	@Failure		400	"validation error | invalid or expired code"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/auth/password/reset [post]
+func (b *baseAuthController) ResetPassword(c echo.Context) error {
+	var payload domain.AuthControllerPayloadResetPassword
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.authUsecase.ResetPassword(c.Request().Context(), payload.Code, payload.NewPassword)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// VerifyEmail godoc
+//
+//	@Summary		Verify email
+//	@Description	Verify the email of an account with the oobCode of the verification link
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body	domain.AuthControllerPayloadVerifyEmail	true	"code"
+//	@Success		200
+//	@Failure		400	"validation error | invalid or expired code"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/auth/email/verify [post]
+func (b *baseAuthController) VerifyEmail(c echo.Context) error {
+	var payload domain.AuthControllerPayloadVerifyEmail
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.authUsecase.VerifyEmail(c.Request().Context(), payload.Code)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// SendEmailVerification godoc
+//
+//	@Summary		Send email verification
+//	@Description	Email a new verification link to the current user
+//	@Tags			auth
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200
+//	@Failure		400	"email already verified"
+//	@Failure		403	"access denied"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/auth/email/verification [post]
+func (b *baseAuthController) SendEmailVerification(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.authUsecase.SendEmailVerification(c.Request().Context(), user)
+	if err != nil {
 		return response_util.FromError(err).WithEcho(c)
 	}
 
-	return response_util.FromData(accessToken).WithEcho(c)
+	return response_util.FromOK().WithEcho(c)
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/go-playground/validator/v10"
@@ -22,13 +23,12 @@ import (
 
 type AuthControllerSuite struct {
 	suite.Suite
-	ucMock                 *mocks.AuthUsecaseMock
-	ct                     domain.AuthController
-	createdRes             response_util.Response
-	badRequestRes          response_util.Response
-	notFoundRes            response_util.Response
-	internalServerErrorRes response_util.Response
-	reqHelper              func(body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+	ucMock        *mocks.AuthUsecaseMock
+	ct            domain.AuthController
+	createdRes    response_util.Response
+	badRequestRes response_util.Response
+	notFoundRes   response_util.Response
+	reqHelper     func(body io.Reader) (echo.Context, *httptest.ResponseRecorder)
 }
 
 func (s *AuthControllerSuite) SetupTest() {
@@ -51,10 +51,6 @@ func (s *AuthControllerSuite) SetupTest() {
 		Code:   http.StatusNotFound,
 		Status: http.StatusText(http.StatusNotFound),
 	}
-	s.internalServerErrorRes = response_util.Response{
-		Code:   http.StatusInternalServerError,
-		Status: http.StatusText(http.StatusInternalServerError),
-	}
 	s.reqHelper = func(body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
 		req := httptest.NewRequest(http.MethodPost, "/", body)
 		if body != nil {
@@ -87,31 +83,12 @@ func (s *AuthControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expect
 }
 
 func (s *AuthControllerSuite) TestSignUp() {
-	s.Run("Signup as admin should return created if successful", func() {
+	s.Run("Signup should return created if successful", func() {
 		expectedRes := s.createdRes
 
 		reqBody := &domain.AuthControllerPayloadSignUp{
 			Email:    gofakeit.Email(),
 			Password: gofakeit.Password(true, true, true, true, false, 8),
-			IsAdmin:  true,
-		}
-		reqBytes, err := json.Marshal(reqBody)
-		s.NoError(err)
-		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
-
-		s.ucMock.SignUpReturns(nil)
-		if s.NoError(s.ct.SignUp(c)) {
-			s.ValidateRes(rec, expectedRes)
-		}
-	})
-
-	s.Run("Signup as non-admin should return created if successful", func() {
-		expectedRes := s.createdRes
-
-		reqBody := &domain.AuthControllerPayloadSignUp{
-			Email:    gofakeit.Email(),
-			Password: gofakeit.Password(true, true, true, true, false, 8),
-			IsAdmin:  false,
 		}
 		reqBytes, err := json.Marshal(reqBody)
 		s.NoError(err)
@@ -130,7 +107,6 @@ func (s *AuthControllerSuite) TestSignUp() {
 		reqBody := &domain.AuthControllerPayloadSignUp{
 			Email:    "invalid",
 			Password: gofakeit.Password(true, true, true, true, false, 8),
-			IsAdmin:  false,
 		}
 		reqBytes, err := json.Marshal(reqBody)
 		s.NoError(err)
@@ -148,7 +124,6 @@ func (s *AuthControllerSuite) TestSignUp() {
 		reqBody := &domain.AuthControllerPayloadSignUp{
 			Email:    gofakeit.Email(),
 			Password: gofakeit.Password(true, true, true, true, false, 5),
-			IsAdmin:  false,
 		}
 		reqBytes, err := json.Marshal(reqBody)
 		s.NoError(err)
@@ -160,51 +135,63 @@ func (s *AuthControllerSuite) TestSignUp() {
 		}
 	})
 
-	s.Run("Signup should return bad request error if user already exist", func() {
-		expectedRes := s.badRequestRes
-		expectedRes.Error = "user already exist"
+	s.Run("Signup should return conflict error if user already exist", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     domain.ErrUserAlreadyExist.Error(),
+			ErrorCode: domain.ErrUserAlreadyExist.Code,
+		}
 
 		reqBody := &domain.AuthControllerPayloadSignUp{
 			Email:    gofakeit.Email(),
 			Password: gofakeit.Password(true, true, true, true, false, 8),
-			IsAdmin:  false,
 		}
 		reqBytes, err := json.Marshal(reqBody)
 		s.NoError(err)
 		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
 
-		s.ucMock.SignUpReturns(errors.New("user already exist"))
+		s.ucMock.SignUpReturns(domain.ErrUserAlreadyExist)
 		if s.NoError(s.ct.SignUp(c)) {
 			s.ValidateRes(rec, expectedRes)
 		}
 	})
 
-	s.Run("Signup should return internal server error if business logic failed", func() {
-		expectedRes := s.internalServerErrorRes
+	s.Run("Signup should pass unexpected error to the error handler", func() {
 
 		reqBody := &domain.AuthControllerPayloadSignUp{
 			Email:    gofakeit.Email(),
 			Password: gofakeit.Password(true, true, true, true, false, 8),
-			IsAdmin:  false,
 		}
 		reqBytes, err := json.Marshal(reqBody)
 		s.NoError(err)
 		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
 
-		s.ucMock.SignUpReturns(errors.New(""))
-		if s.NoError(s.ct.SignUp(c)) {
-			s.ValidateRes(rec, expectedRes)
-		}
+		ucErr := errors.New("")
+		s.ucMock.SignUpReturns(ucErr)
+		s.ErrorIs(s.ct.SignUp(c), ucErr)
+		s.Equal(0, rec.Body.Len())
 	})
 }
 
 func (s *AuthControllerSuite) TestSignIn() {
 	s.Run("Get access token should return OK if successful", func() {
-		expectedData := gofakeit.UUID()
+		expiresAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		tokenPair := &domain.AuthControllerResponseTokenPair{
+			AccessToken:           gofakeit.UUID(),
+			AccessTokenExpiresAt:  expiresAt,
+			RefreshToken:          gofakeit.UUID(),
+			RefreshTokenExpiresAt: expiresAt.Add(24 * time.Hour),
+		}
 		expectedRes := response_util.Response{
 			Code:   http.StatusOK,
 			Status: http.StatusText(http.StatusOK),
-			Data:   expectedData,
+			Data: map[string]interface{}{
+				"access_token":             tokenPair.AccessToken,
+				"access_token_expires_at":  "2024-01-01T00:00:00Z",
+				"refresh_token":            tokenPair.RefreshToken,
+				"refresh_token_expires_at": "2024-01-02T00:00:00Z",
+			},
 		}
 
 		reqBody := &domain.AuthControllerPayloadGetAccessToken{
@@ -215,7 +202,7 @@ func (s *AuthControllerSuite) TestSignIn() {
 		s.NoError(err)
 		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
 
-		s.ucMock.GetAccessTokenReturns(expectedData, nil)
+		s.ucMock.GetAccessTokenReturns(tokenPair, nil)
 		if s.NoError(s.ct.GetAccessToken(c)) {
 			s.ValidateRes(rec, expectedRes)
 		}
@@ -238,9 +225,13 @@ func (s *AuthControllerSuite) TestSignIn() {
 		}
 	})
 
-	s.Run("Get access token should return not found error if user not found", func() {
-		expectedRes := s.notFoundRes
-		expectedRes.Error = "user not found"
+	s.Run("Get access token should return unauthorized error given invalid credentials", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusUnauthorized,
+			Status:    http.StatusText(http.StatusUnauthorized),
+			Error:     domain.ErrInvalidCredentials.Error(),
+			ErrorCode: domain.ErrInvalidCredentials.Code,
+		}
 
 		reqBody := &domain.AuthControllerPayloadGetAccessToken{
 			Email:    gofakeit.Email(),
@@ -250,14 +241,19 @@ func (s *AuthControllerSuite) TestSignIn() {
 		s.NoError(err)
 		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
 
-		s.ucMock.GetAccessTokenReturns("", errors.New("user not found"))
+		s.ucMock.GetAccessTokenReturns(nil, domain.ErrInvalidCredentials)
 		if s.NoError(s.ct.GetAccessToken(c)) {
 			s.ValidateRes(rec, expectedRes)
 		}
 	})
 
-	s.Run("Get access token should return internal server error if business logic failed", func() {
-		expectedRes := s.internalServerErrorRes
+	s.Run("Get access token should return too many requests error given locked out login", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusTooManyRequests,
+			Status:    http.StatusText(http.StatusTooManyRequests),
+			Error:     domain.ErrTooManyLoginAttempts.Error(),
+			ErrorCode: domain.ErrTooManyLoginAttempts.Code,
+		}
 
 		reqBody := &domain.AuthControllerPayloadGetAccessToken{
 			Email:    gofakeit.Email(),
@@ -267,9 +263,222 @@ func (s *AuthControllerSuite) TestSignIn() {
 		s.NoError(err)
 		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
 
-		s.ucMock.GetAccessTokenReturns("", errors.New(""))
+		s.ucMock.GetAccessTokenReturns(nil, domain.ErrTooManyLoginAttempts)
 		if s.NoError(s.ct.GetAccessToken(c)) {
 			s.ValidateRes(rec, expectedRes)
 		}
 	})
+
+	s.Run("Get access token should pass unexpected error to the error handler", func() {
+
+		reqBody := &domain.AuthControllerPayloadGetAccessToken{
+			Email:    gofakeit.Email(),
+			Password: gofakeit.Password(true, true, true, true, false, 8),
+		}
+		reqBytes, err := json.Marshal(reqBody)
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		ucErr := errors.New("")
+		s.ucMock.GetAccessTokenReturns(nil, ucErr)
+		s.ErrorIs(s.ct.GetAccessToken(c), ucErr)
+		s.Equal(0, rec.Body.Len())
+	})
+}
+
+func (s *AuthControllerSuite) TestRefreshToken() {
+	s.Run("Refresh token should return OK if successful", func() {
+		expiresAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		tokenPair := &domain.AuthControllerResponseTokenPair{
+			AccessToken:           gofakeit.UUID(),
+			AccessTokenExpiresAt:  expiresAt,
+			RefreshToken:          gofakeit.UUID(),
+			RefreshTokenExpiresAt: expiresAt,
+		}
+		expectedRes := response_util.Response{
+			Code:   http.StatusOK,
+			Status: http.StatusText(http.StatusOK),
+			Data: map[string]interface{}{
+				"access_token":             tokenPair.AccessToken,
+				"access_token_expires_at":  "2024-01-01T00:00:00Z",
+				"refresh_token":            tokenPair.RefreshToken,
+				"refresh_token_expires_at": "2024-01-01T00:00:00Z",
+			},
+		}
+
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadRefreshToken{RefreshToken: gofakeit.UUID()})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		s.ucMock.RefreshTokenReturns(tokenPair, nil)
+		if s.NoError(s.ct.RefreshToken(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Refresh token should return bad request given empty refresh token", func() {
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadRefreshToken{})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		if s.NoError(s.ct.RefreshToken(c)) {
+			s.Equal(http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	s.Run("Refresh token should return unauthorized error given reused refresh token", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusUnauthorized,
+			Status:    http.StatusText(http.StatusUnauthorized),
+			Error:     domain.ErrRefreshTokenReused.Error(),
+			ErrorCode: domain.ErrRefreshTokenReused.Code,
+		}
+
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadRefreshToken{RefreshToken: gofakeit.UUID()})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		s.ucMock.RefreshTokenReturns(nil, domain.ErrRefreshTokenReused)
+		if s.NoError(s.ct.RefreshToken(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *AuthControllerSuite) TestLogout() {
+	user := &domain.UserModel{ID: 1, Email: gofakeit.Email()}
+
+	s.Run("Logout should return forbidden error given no user", func() {
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadLogout{RefreshToken: gofakeit.UUID()})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		if s.NoError(s.ct.Logout(c)) {
+			s.Equal(http.StatusForbidden, rec.Code)
+		}
+	})
+
+	s.Run("Logout should return unauthorized error given refresh token of another user", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusUnauthorized,
+			Status:    http.StatusText(http.StatusUnauthorized),
+			Error:     domain.ErrInvalidRefreshToken.Error(),
+			ErrorCode: domain.ErrInvalidRefreshToken.Code,
+		}
+
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadLogout{RefreshToken: gofakeit.UUID()})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+		c.Set("user", user)
+
+		s.ucMock.LogoutReturns(domain.ErrInvalidRefreshToken)
+		if s.NoError(s.ct.Logout(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Logout all should return OK if successful", func() {
+		expectedRes := response_util.Response{
+			Code:   http.StatusOK,
+			Status: http.StatusText(http.StatusOK),
+		}
+
+		c, rec := s.reqHelper(nil)
+		c.Set("user", user)
+
+		s.ucMock.LogoutAllReturns(nil)
+		if s.NoError(s.ct.LogoutAll(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, argUser := s.ucMock.LogoutAllArgsForCall(0)
+			s.Equal(user, argUser)
+		}
+	})
+}
+
+func (s *AuthControllerSuite) TestPasswordReset() {
+	s.Run("Forgot password should return OK given any valid email", func() {
+		expectedRes := response_util.Response{
+			Code:   http.StatusOK,
+			Status: http.StatusText(http.StatusOK),
+		}
+		email := gofakeit.Email()
+
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadForgotPassword{Email: email})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		s.ucMock.ForgotPasswordReturns(nil)
+		if s.NoError(s.ct.ForgotPassword(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, forgotEmail := s.ucMock.ForgotPasswordArgsForCall(0)
+			s.Equal(email, forgotEmail)
+		}
+	})
+
+	s.Run("Reset password should return bad request error given invalid code", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrInvalidActionCode.Error(),
+			ErrorCode: domain.ErrInvalidActionCode.Code,
+		}
+
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadResetPassword{Code: gofakeit.UUID(), NewPassword: "newpassword1234"})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		s.ucMock.ResetPasswordReturns(domain.ErrInvalidActionCode)
+		if s.NoError(s.ct.ResetPassword(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Reset password should return validation error given short password", func() {
+		callCount := s.ucMock.ResetPasswordCallCount()
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadResetPassword{Code: gofakeit.UUID(), NewPassword: "short"})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		if s.NoError(s.ct.ResetPassword(c)) {
+			s.Equal(http.StatusBadRequest, rec.Code)
+			s.Equal(callCount, s.ucMock.ResetPasswordCallCount())
+		}
+	})
+}
+
+func (s *AuthControllerSuite) TestEmailVerification() {
+	s.Run("Verify email should return bad request error given used code", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrInvalidActionCode.Error(),
+			ErrorCode: domain.ErrInvalidActionCode.Code,
+		}
+
+		reqBytes, err := json.Marshal(&domain.AuthControllerPayloadVerifyEmail{Code: gofakeit.UUID()})
+		s.NoError(err)
+		c, rec := s.reqHelper(bytes.NewBuffer(reqBytes))
+
+		s.ucMock.VerifyEmailReturns(domain.ErrInvalidActionCode)
+		if s.NoError(s.ct.VerifyEmail(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Send email verification should return bad request error given verified email", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrEmailAlreadyVerified.Error(),
+			ErrorCode: domain.ErrEmailAlreadyVerified.Code,
+		}
+
+		c, rec := s.reqHelper(nil)
+		c.Set("user", &domain.UserModel{ID: 1, Email: gofakeit.Email()})
+
+		s.ucMock.SendEmailVerificationReturns(domain.ErrEmailAlreadyVerified)
+		if s.NoError(s.ct.SendEmailVerification(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
 }
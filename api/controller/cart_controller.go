@@ -0,0 +1,267 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseCartController struct {
+	env         *domain.Env
+	loggerUtil  domain.LoggerUtil
+	cartUsecase domain.CartUsecase
+	validate    *validator.Validate
+}
+
+func NewCartController(env *domain.Env, loggerUtil domain.LoggerUtil, cartUsecase domain.CartUsecase, validate *validator.Validate) domain.CartController {
+	return &baseCartController{
+		env:         env,
+		loggerUtil:  loggerUtil,
+		cartUsecase: cartUsecase,
+		validate:    validate,
+	}
+}
+
+// getCart resolves the cart owned by the user set in context by the auth middleware, or the guest
+// cart of the cart token if there is no user.
+func (b *baseCartController) getCart(c echo.Context) (*domain.CartModel, *response_util.Response) {
+	var cart *domain.CartModel
+	var err error
+	user, ok := c.Get("user").(*domain.UserModel)
+	cartToken := c.Request().Header.Get(domain.CartTokenHeader)
+	switch {
+	case ok && user != nil:
+		cart, err = b.cartUsecase.GetCartByUserIDMiddleware(c.Request().Context(), user.ID)
+	case cartToken != "":
+		cart, err = b.cartUsecase.GetGuestCartMiddleware(c.Request().Context(), cartToken)
+	default:
+		return nil, response_util.FromError(domain.ErrAccessDenied)
+	}
+	if err != nil {
+		return nil, response_util.FromError(err)
+	}
+	if cart == nil {
+		return nil, response_util.FromError(domain.ErrCartNotFound)
+	}
+
+	return cart, nil
+}
+
+// getCartItem resolves a cart item by uid and makes sure it belongs to the given cart.
+func (b *baseCartController) getCartItem(c echo.Context, cart *domain.CartModel) (*domain.CartItemModel, *response_util.Response) {
+	cartItem, err := b.cartUsecase.GetCartItemByUID(c.Request().Context(), c.Param("uid"))
+	if err != nil {
+		return nil, response_util.FromError(err)
+	}
+	if cartItem == nil {
+		return nil, response_util.FromError(domain.ErrCartItemNotFound)
+	}
+	if cartItem.CartID != cart.ID {
+		return nil, response_util.FromError(domain.ErrAccessDenied)
+	}
+
+	return cartItem, nil
+}
+
+// CreateGuestCart godoc
+//
+//	@Summary		Create guest cart
+//	@Description	Returns a cart token to send in the X-Cart-Token header of cart requests. Sending it along with an access token merges the guest cart into the cart of the user.
+//	@Tags			cart
+//	@Produce		json
+//	@Success		201	{object}	domain.CartControllerResponseCreateGuestCart
+//	@Failure		400	"guest cart is only available to guests"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/cart/guest [post]
+func (b *baseCartController) CreateGuestCart(c echo.Context) error {
+	if user, ok := c.Get("user").(*domain.UserModel); ok && user != nil {
+		return response_util.FromError(domain.ErrGuestCartOnly).WithEcho(c)
+	}
+
+	token, err := b.cartUsecase.CreateGuestCart(c.Request().Context())
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(domain.CartControllerResponseCreateGuestCart{Token: token}).WithEcho(c)
+}
+
+// GetCartByUserID godoc
+//
+//	@Summary	Get cart of current user or guest
+//	@Tags		cart
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		X-Cart-Token	header		string	false	"guest cart token"
+//	@Success	200				{object}	domain.CartControllerResponseGetCart
+//	@Failure	403				"access denied"
+//	@Failure	404				"cart not found"
+//	@Failure	500				"Internal Server Error"
+//	@Router		/cart [get]
+func (b *baseCartController) GetCartByUserID(c echo.Context) error {
+	var cart *domain.CartControllerResponseGetCart
+	var err error
+	user, ok := c.Get("user").(*domain.UserModel)
+	cartToken := c.Request().Header.Get(domain.CartTokenHeader)
+	switch {
+	case ok && user != nil:
+		cart, err = b.cartUsecase.GetCartByUserID(c.Request().Context(), user.ID)
+	case cartToken != "":
+		cart, err = b.cartUsecase.GetGuestCart(c.Request().Context(), cartToken)
+	default:
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if cart == nil {
+		return response_util.FromError(domain.ErrCartNotFound).WithEcho(c)
+	}
+
+	return response_util.FromData(cart).WithEcho(c)
+}
+
+// CreateCartItem godoc
+//
+//	@Summary	Add product to cart
+//	@Tags		cart
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		X-Cart-Token	header	string										false	"guest cart token"
+//	@Param		cart_item		body	domain.CartControllerPayloadCreateCartItem	true	"product uid and quantity"
+//	@Success	201
+//	@Failure	400	"validation error"
+//	@Failure	403	"access denied"
+//	@Failure	404	"cart not found | product not found"
+//	@Failure	409	"product is unavailable | insufficient stock | product price has changed"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/cart/items [post]
+func (b *baseCartController) CreateCartItem(c echo.Context) error {
+	var payload domain.CartControllerPayloadCreateCartItem
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	cart, res := b.getCart(c)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+	product, err := b.cartUsecase.GetProductByUID(c.Request().Context(), payload.ProductUID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if product == nil {
+		return response_util.FromError(domain.ErrProductNotFound).WithEcho(c)
+	}
+
+	UID, err := b.cartUsecase.CreateCartItem(c.Request().Context(), &domain.CartUsecasePayloadCreateCartItem{
+		Cart:     cart,
+		Product:  product,
+		Quantity: payload.Quantity,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(map[string]string{"uid": UID}).WithEcho(c)
+}
+
+// UpdateCartItem godoc
+//
+//	@Summary	Update cart item quantity
+//	@Tags		cart
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		X-Cart-Token	header	string										false	"guest cart token"
+//	@Param		uid				path	string										true	"cart item uid"
+//	@Param		cart_item		body	domain.CartControllerPayloadUpdateCartItem	true	"quantity"
+//	@Success	200
+//	@Failure	400	"validation error"
+//	@Failure	403	"access denied"
+//	@Failure	404	"cart not found | cart item not found"
+//	@Failure	409	"product is unavailable | insufficient stock"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/cart/items/{uid} [patch]
+func (b *baseCartController) UpdateCartItem(c echo.Context) error {
+	var payload domain.CartControllerPayloadUpdateCartItem
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	cart, res := b.getCart(c)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+	cartItem, res := b.getCartItem(c, cart)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+
+	err = b.cartUsecase.UpdateCartItem(c.Request().Context(), &domain.CartUsecasePayloadUpdateCartItem{
+		Cart:     cart,
+		CartItem: cartItem,
+		UID:      cartItem.UID,
+		Quantity: payload.Quantity,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// DeleteCartItemByUID godoc
+//
+//	@Summary	Remove item from cart
+//	@Tags		cart
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		X-Cart-Token	header	string	false	"guest cart token"
+//	@Param		uid				path	string	true	"cart item uid"
+//	@Success	200
+//	@Failure	403	"access denied"
+//	@Failure	404	"cart not found | cart item not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/cart/items/{uid} [delete]
+func (b *baseCartController) DeleteCartItemByUID(c echo.Context) error {
+	cart, res := b.getCart(c)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+	cartItem, res := b.getCartItem(c, cart)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+
+	err := b.cartUsecase.DeleteCartItemByUID(c.Request().Context(), &domain.CartUsecasePayloadDeleteCartItem{
+		Cart:     cart,
+		CartItem: cartItem,
+		UID:      cartItem.UID,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
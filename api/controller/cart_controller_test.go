@@ -0,0 +1,404 @@
+package controller_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type CartControllerSuite struct {
+	suite.Suite
+	ucMock        *mocks.CartUsecaseMock
+	ct            domain.CartController
+	user          *domain.UserModel
+	cart          *domain.CartModel
+	okRes         response_util.Response
+	badRequestRes response_util.Response
+	forbiddenRes  response_util.Response
+	notFoundRes   response_util.Response
+	reqHelper     func(method string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *CartControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	cartUsecaseMock := &mocks.CartUsecaseMock{}
+	ct := controller.NewCartController(env, nil, cartUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = cartUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID()}
+	s.cart = &domain.CartModel{ID: 1, UID: gofakeit.UUID(), UserID: sql.NullInt64{Int64: int64(s.user.ID), Valid: true}}
+	s.okRes = response_util.Response{
+		Code:   http.StatusOK,
+		Status: http.StatusText(http.StatusOK),
+	}
+	s.badRequestRes = response_util.Response{
+		Code:   http.StatusBadRequest,
+		Status: http.StatusText(http.StatusBadRequest),
+	}
+	s.forbiddenRes = response_util.Response{
+		Code:   http.StatusForbidden,
+		Status: http.StatusText(http.StatusForbidden),
+	}
+	s.notFoundRes = response_util.Response{
+		Code:   http.StatusNotFound,
+		Status: http.StatusText(http.StatusNotFound),
+	}
+	s.reqHelper = func(method string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/", body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestCartControllerSuite(t *testing.T) {
+	suite.Run(t, new(CartControllerSuite))
+}
+
+func (s *CartControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *CartControllerSuite) TestGetCartByUserID() {
+	s.Run("Get cart should return OK if successful", func() {
+		c, rec := s.reqHelper(http.MethodGet, nil)
+
+		s.ucMock.GetCartByUserIDReturns(&domain.CartControllerResponseGetCart{UID: s.cart.UID}, nil)
+		if s.NoError(s.ct.GetCartByUserID(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+
+			_, userID := s.ucMock.GetCartByUserIDArgsForCall(s.ucMock.GetCartByUserIDCallCount() - 1)
+			s.Equal(s.user.ID, userID)
+		}
+	})
+
+	s.Run("Get cart should return forbidden error if user is not set", func() {
+		expectedRes := s.forbiddenRes
+		expectedRes.Error = domain.ErrAccessDenied.Error()
+		expectedRes.ErrorCode = domain.ErrAccessDenied.Code
+
+		c, rec := s.reqHelper(http.MethodGet, nil)
+		c.Set("user", nil)
+
+		if s.NoError(s.ct.GetCartByUserID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Get cart should return not found error if cart not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrCartNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrCartNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodGet, nil)
+
+		s.ucMock.GetCartByUserIDReturns(nil, nil)
+		if s.NoError(s.ct.GetCartByUserID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Get cart should return guest cart given cart token without user", func() {
+		token := gofakeit.UUID()
+		c, rec := s.reqHelper(http.MethodGet, nil)
+		c.Set("user", nil)
+		c.Request().Header.Set(domain.CartTokenHeader, token)
+
+		s.ucMock.GetGuestCartReturns(&domain.CartControllerResponseGetCart{UID: s.cart.UID}, nil)
+		if s.NoError(s.ct.GetCartByUserID(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+
+			_, cartToken := s.ucMock.GetGuestCartArgsForCall(s.ucMock.GetGuestCartCallCount() - 1)
+			s.Equal(token, cartToken)
+		}
+	})
+
+	s.Run("Get cart should return not found error given invalid cart token", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrCartNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrCartNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodGet, nil)
+		c.Set("user", nil)
+		c.Request().Header.Set(domain.CartTokenHeader, "invalid")
+
+		s.ucMock.GetGuestCartReturns(nil, nil)
+		if s.NoError(s.ct.GetCartByUserID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *CartControllerSuite) TestCreateGuestCart() {
+	s.Run("Create guest cart should return created with token if successful", func() {
+		token := gofakeit.UUID()
+		expectedRes := response_util.Response{
+			Code:   http.StatusCreated,
+			Status: http.StatusText(http.StatusCreated),
+			Data:   map[string]interface{}{"token": token},
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, nil)
+		c.Set("user", nil)
+
+		s.ucMock.CreateGuestCartReturns(token, nil)
+		if s.NoError(s.ct.CreateGuestCart(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Create guest cart should return bad request error given signed in user", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.Error = domain.ErrGuestCartOnly.Error()
+		expectedRes.ErrorCode = domain.ErrGuestCartOnly.Code
+
+		c, rec := s.reqHelper(http.MethodPost, nil)
+
+		if s.NoError(s.ct.CreateGuestCart(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *CartControllerSuite) TestCreateCartItem() {
+	s.Run("Create cart item should return created with uid if successful", func() {
+		expectedUID := gofakeit.UUID()
+		expectedRes := response_util.Response{
+			Code:   http.StatusCreated,
+			Status: http.StatusText(http.StatusCreated),
+			Data:   map[string]interface{}{"uid": expectedUID},
+		}
+		product := &domain.ProductModel{ID: 1, UID: gofakeit.UUID()}
+
+		reqBody := &domain.CartControllerPayloadCreateCartItem{
+			ProductUID: product.UID,
+			Quantity:   2,
+		}
+		reqBytes, err := json.Marshal(reqBody)
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, bytes.NewBuffer(reqBytes))
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetProductByUIDReturns(product, nil)
+		s.ucMock.CreateCartItemReturns(expectedUID, nil)
+		if s.NoError(s.ct.CreateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, payload := s.ucMock.CreateCartItemArgsForCall(s.ucMock.CreateCartItemCallCount() - 1)
+			s.Equal(s.cart, payload.Cart)
+			s.Equal(product, payload.Product)
+			s.Equal(reqBody.Quantity, payload.Quantity)
+		}
+	})
+
+	s.Run("Create cart item should return bad request error given invalid quantity", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "quantity", Name: "min", Value: "1"},
+		}
+
+		reqBytes, err := json.Marshal(&domain.CartControllerPayloadCreateCartItem{
+			ProductUID: gofakeit.UUID(),
+			Quantity:   -1,
+		})
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, bytes.NewBuffer(reqBytes))
+
+		if s.NoError(s.ct.CreateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Create cart item should return not found error if product not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrProductNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrProductNotFound.Code
+
+		reqBytes, err := json.Marshal(&domain.CartControllerPayloadCreateCartItem{
+			ProductUID: "invalid",
+			Quantity:   1,
+		})
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, bytes.NewBuffer(reqBytes))
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetProductByUIDReturns(nil, nil)
+		if s.NoError(s.ct.CreateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Create cart item should return conflict error given quantity above stock", func() {
+		err := fmt.Errorf("%w: %s", domain.ErrInsufficientStock, "Product Test")
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     err.Error(),
+			ErrorCode: domain.ErrInsufficientStock.Code,
+		}
+		product := &domain.ProductModel{ID: 1, UID: gofakeit.UUID(), Stock: 1}
+
+		reqBytes, err := json.Marshal(&domain.CartControllerPayloadCreateCartItem{
+			ProductUID: product.UID,
+			Quantity:   2,
+		})
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, bytes.NewBuffer(reqBytes))
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetProductByUIDReturns(product, nil)
+		s.ucMock.CreateCartItemReturns("", fmt.Errorf("%w: %s", domain.ErrInsufficientStock, "Product Test"))
+		if s.NoError(s.ct.CreateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *CartControllerSuite) TestUpdateCartItem() {
+	s.Run("Update cart item should return OK if successful", func() {
+		expectedRes := s.okRes
+		cartItem := &domain.CartItemModel{ID: 1, UID: gofakeit.UUID(), CartID: s.cart.ID, Quantity: 1}
+
+		reqBytes, err := json.Marshal(&domain.CartControllerPayloadUpdateCartItem{Quantity: 3})
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPatch, bytes.NewBuffer(reqBytes))
+		c.SetParamNames("uid")
+		c.SetParamValues(cartItem.UID)
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetCartItemByUIDReturns(cartItem, nil)
+		s.ucMock.UpdateCartItemReturns(nil)
+		if s.NoError(s.ct.UpdateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, payload := s.ucMock.UpdateCartItemArgsForCall(s.ucMock.UpdateCartItemCallCount() - 1)
+			s.Equal(cartItem.UID, payload.UID)
+			s.Equal(3, payload.Quantity)
+		}
+	})
+
+	s.Run("Update cart item should return forbidden error if cart item belongs to another cart", func() {
+		expectedRes := s.forbiddenRes
+		expectedRes.Error = domain.ErrAccessDenied.Error()
+		expectedRes.ErrorCode = domain.ErrAccessDenied.Code
+		cartItem := &domain.CartItemModel{ID: 2, UID: gofakeit.UUID(), CartID: s.cart.ID + 1, Quantity: 1}
+
+		reqBytes, err := json.Marshal(&domain.CartControllerPayloadUpdateCartItem{Quantity: 3})
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPatch, bytes.NewBuffer(reqBytes))
+		c.SetParamNames("uid")
+		c.SetParamValues(cartItem.UID)
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetCartItemByUIDReturns(cartItem, nil)
+		if s.NoError(s.ct.UpdateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Update cart item should return conflict error given inactive product", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     domain.ErrProductUnavailable.Error(),
+			ErrorCode: domain.ErrProductUnavailable.Code,
+		}
+		cartItem := &domain.CartItemModel{ID: 1, UID: gofakeit.UUID(), CartID: s.cart.ID, Quantity: 1}
+
+		reqBytes, err := json.Marshal(&domain.CartControllerPayloadUpdateCartItem{Quantity: 3})
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPatch, bytes.NewBuffer(reqBytes))
+		c.SetParamNames("uid")
+		c.SetParamValues(cartItem.UID)
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetCartItemByUIDReturns(cartItem, nil)
+		s.ucMock.UpdateCartItemReturns(domain.ErrProductUnavailable)
+		if s.NoError(s.ct.UpdateCartItem(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *CartControllerSuite) TestDeleteCartItemByUID() {
+	s.Run("Delete cart item should return OK if successful", func() {
+		expectedRes := s.okRes
+		cartItem := &domain.CartItemModel{ID: 1, UID: gofakeit.UUID(), CartID: s.cart.ID, Quantity: 1}
+
+		c, rec := s.reqHelper(http.MethodDelete, nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(cartItem.UID)
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetCartItemByUIDReturns(cartItem, nil)
+		s.ucMock.DeleteCartItemByUIDReturns(nil)
+		if s.NoError(s.ct.DeleteCartItemByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Delete cart item should return not found error if cart item not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrCartItemNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrCartItemNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodDelete, nil)
+		c.SetParamNames("uid")
+		c.SetParamValues("invalid")
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetCartItemByUIDReturns(nil, nil)
+		if s.NoError(s.ct.DeleteCartItemByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Delete cart item should pass unexpected error to the error handler", func() {
+		cartItem := &domain.CartItemModel{ID: 1, UID: gofakeit.UUID(), CartID: s.cart.ID, Quantity: 1}
+
+		c, rec := s.reqHelper(http.MethodDelete, nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(cartItem.UID)
+
+		s.ucMock.GetCartByUserIDMiddlewareReturns(s.cart, nil)
+		s.ucMock.GetCartItemByUIDReturns(cartItem, nil)
+		ucErr := errors.New("")
+		s.ucMock.DeleteCartItemByUIDReturns(ucErr)
+		s.ErrorIs(s.ct.DeleteCartItemByUID(c), ucErr)
+		s.Equal(0, rec.Body.Len())
+	})
+}
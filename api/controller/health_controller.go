@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseHealthController struct {
+	lifecycle     domain.Lifecycle
+	healthUsecase domain.HealthUsecase
+}
+
+func NewHealthController(lifecycle domain.Lifecycle, healthUsecase domain.HealthUsecase) domain.HealthController {
+	return &baseHealthController{
+		lifecycle:     lifecycle,
+		healthUsecase: healthUsecase,
+	}
+}
+
+// Health endpoints are served outside of the API base path for the orchestrator, so they are not documented.
+
+// Liveness only tells that the process is able to serve requests, it does not check any dependency so a
+// dependency outage does not get the instance restarted.
+func (b *baseHealthController) Liveness(c echo.Context) error {
+	return response_util.FromOK().WithEcho(c)
+}
+
+// Readiness tells the orchestrator whether to route traffic to this instance, it is not ready once the
+// instance starts draining on shutdown or while a dependency is down.
+func (b *baseHealthController) Readiness(c echo.Context) error {
+	if !b.lifecycle.Ready() {
+		return response_util.FromServiceUnavailableError(domain.ErrShuttingDown).WithEcho(c)
+	}
+
+	readiness := b.healthUsecase.Readiness(c.Request().Context())
+	if readiness.Status != domain.HealthStatusUp {
+		return response_util.FromServiceUnavailableData(readiness).WithEcho(c)
+	}
+
+	return response_util.FromData(readiness).WithEcho(c)
+}
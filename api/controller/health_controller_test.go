@@ -0,0 +1,99 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthControllerSuite struct {
+	suite.Suite
+	lifecycleMock *mocks.LifecycleMock
+	ucMock        *mocks.HealthUsecaseMock
+	ct            domain.HealthController
+	reqHelper     func() (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *HealthControllerSuite) SetupTest() {
+	lifecycleMock := &mocks.LifecycleMock{}
+	healthUsecaseMock := &mocks.HealthUsecaseMock{}
+	ct := controller.NewHealthController(lifecycleMock, healthUsecaseMock)
+
+	s.ct = ct
+	s.lifecycleMock = lifecycleMock
+	s.ucMock = healthUsecaseMock
+	s.reqHelper = func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+
+		return c, rec
+	}
+}
+
+func TestHealthControllerSuite(t *testing.T) {
+	suite.Run(t, new(HealthControllerSuite))
+}
+
+func (s *HealthControllerSuite) TestLiveness() {
+	s.Run("Liveness should return OK", func() {
+		c, rec := s.reqHelper()
+
+		if s.NoError(s.ct.Liveness(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+		}
+	})
+}
+
+func (s *HealthControllerSuite) TestReadiness() {
+	s.Run("Readiness should return OK given every dependency is up", func() {
+		c, rec := s.reqHelper()
+
+		s.lifecycleMock.ReadyReturns(true)
+		s.ucMock.ReadinessReturns(&domain.HealthControllerResponseReadiness{
+			Status: domain.HealthStatusUp,
+			Dependencies: []*domain.HealthControllerResponseDependency{
+				{Name: domain.HealthDependencyPostgres, Status: domain.HealthStatusUp},
+			},
+		})
+		if s.NoError(s.ct.Readiness(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+			s.Contains(rec.Body.String(), `"name":"postgres","status":"UP"`)
+		}
+	})
+
+	s.Run("Readiness should return service unavailable given a dependency is down", func() {
+		c, rec := s.reqHelper()
+
+		s.lifecycleMock.ReadyReturns(true)
+		s.ucMock.ReadinessReturns(&domain.HealthControllerResponseReadiness{
+			Status: domain.HealthStatusDown,
+			Dependencies: []*domain.HealthControllerResponseDependency{
+				{Name: domain.HealthDependencyPostgres, Status: domain.HealthStatusDown, Error: "connection refused"},
+			},
+		})
+		if s.NoError(s.ct.Readiness(c)) {
+			s.Equal(http.StatusServiceUnavailable, rec.Code)
+			s.Contains(rec.Body.String(), `"error":"connection refused"`)
+		}
+	})
+
+	s.Run("Readiness should return service unavailable given a draining instance", func() {
+		c, rec := s.reqHelper()
+		callCount := s.ucMock.ReadinessCallCount()
+
+		s.lifecycleMock.ReadyReturns(false)
+		if s.NoError(s.ct.Readiness(c)) {
+			s.Equal(http.StatusServiceUnavailable, rec.Code)
+			s.Contains(rec.Body.String(), domain.ErrShuttingDown.Error())
+			s.Equal(callCount, s.ucMock.ReadinessCallCount())
+		}
+	})
+}
@@ -0,0 +1,261 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseOrderController struct {
+	env          *domain.Env
+	loggerUtil   domain.LoggerUtil
+	orderUsecase domain.OrderUsecase
+	validate     *validator.Validate
+}
+
+func NewOrderController(env *domain.Env, loggerUtil domain.LoggerUtil, orderUsecase domain.OrderUsecase, validate *validator.Validate) domain.OrderController {
+	return &baseOrderController{
+		env:          env,
+		loggerUtil:   loggerUtil,
+		orderUsecase: orderUsecase,
+		validate:     validate,
+	}
+}
+
+// bindListQuery binds and validates list query params, applying default limit and page.
+func (b *baseOrderController) bindListQuery(c echo.Context) (*domain.OrderControllerQueryListOrders, *response_util.Response) {
+	var query domain.OrderControllerQueryListOrders
+	err := c.Bind(&query)
+	if err != nil {
+		return nil, response_util.FromBindingError(err)
+	}
+	err = b.validate.Struct(&query)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return nil, response_util.FromValidationErrors(validationErrors)
+		}
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	if query.Page == 0 {
+		query.Page = 1
+	}
+
+	return &query, nil
+}
+
+// Checkout godoc
+//
+//	@Summary	Checkout cart of current user into a new order
+//	@Tags		order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	201
+//	@Failure	400	"cart is empty"
+//	@Failure	403	"access denied"
+//	@Failure	409	"product is unavailable | insufficient stock | product price has changed"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/checkout [post]
+func (b *baseOrderController) Checkout(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	UID, err := b.orderUsecase.Checkout(c.Request().Context(), user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(map[string]string{"uid": UID}).WithEcho(c)
+}
+
+// ListOrders godoc
+//
+//	@Summary	List orders of current user
+//	@Tags		order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		limit	query	int	false	"page size, default 10"
+//	@Param		page	query	int	false	"page number, default 1"
+//	@Success	200	{object}	domain.OrderControllerResponseListOrders
+//	@Failure	400	"validation error"
+//	@Failure	403	"access denied"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/orders [get]
+func (b *baseOrderController) ListOrders(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	query, res := b.bindListQuery(c)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+
+	orders, err := b.orderUsecase.ListOrdersByUserID(c.Request().Context(), user.ID, query.Limit, query.Page)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(orders).WithEcho(c)
+}
+
+// GetOrderByUID godoc
+//
+//	@Summary	Get order of current user by uid
+//	@Tags		order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"order uid"
+//	@Success	200	{object}	domain.OrderControllerResponseGetOrder
+//	@Failure	403	"access denied"
+//	@Failure	404	"order not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/orders/{uid} [get]
+func (b *baseOrderController) GetOrderByUID(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	order, err := b.orderUsecase.GetOrderByUIDAndUserID(c.Request().Context(), c.Param("uid"), user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if order == nil {
+		return response_util.FromError(domain.ErrOrderNotFound).WithEcho(c)
+	}
+
+	return response_util.FromData(order).WithEcho(c)
+}
+
+// CancelOrder godoc
+//
+//	@Summary	Cancel order of current user before it is shipped
+//	@Tags		order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"order uid"
+//	@Success	200
+//	@Failure	403	"access denied"
+//	@Failure	404	"order not found"
+//	@Failure	409	"cannot transition order"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/orders/{uid}/cancel [post]
+func (b *baseOrderController) CancelOrder(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.orderUsecase.CancelOrder(c.Request().Context(), c.Param("uid"), user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// AdminListOrders godoc
+//
+//	@Summary	List orders of all users
+//	@Tags		order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		limit	query	int		false	"page size, default 10"
+//	@Param		page	query	int		false	"page number, default 1"
+//	@Param		status	query	string	false	"filter by order status"
+//	@Success	200	{object}	domain.OrderControllerResponseListOrders
+//	@Failure	400	"validation error"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/orders [get]
+func (b *baseOrderController) AdminListOrders(c echo.Context) error {
+	query, res := b.bindListQuery(c)
+	if res != nil {
+		return res.WithEcho(c)
+	}
+
+	orders, err := b.orderUsecase.ListOrders(c.Request().Context(), query.Limit, query.Page, query.Status)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(orders).WithEcho(c)
+}
+
+// AdminGetOrderByUID godoc
+//
+//	@Summary	Get any order by uid
+//	@Tags		order
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"order uid"
+//	@Success	200	{object}	domain.OrderControllerResponseGetOrder
+//	@Failure	404	"order not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/orders/{uid} [get]
+func (b *baseOrderController) AdminGetOrderByUID(c echo.Context) error {
+	order, err := b.orderUsecase.GetOrderByUID(c.Request().Context(), c.Param("uid"))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if order == nil {
+		return response_util.FromError(domain.ErrOrderNotFound).WithEcho(c)
+	}
+
+	return response_util.FromData(order).WithEcho(c)
+}
+
+// AdminUpdateOrderStatus godoc
+//
+//	@Summary	Transition order to a new status
+//	@Tags		order
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid		path	string									true	"order uid"
+//	@Param		status	body	domain.OrderControllerPayloadUpdateOrderStatus	true	"target status and optional note"
+//	@Success	200
+//	@Failure	400	"validation error"
+//	@Failure	404	"order not found"
+//	@Failure	409	"cannot transition order"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/orders/{uid}/status [patch]
+func (b *baseOrderController) AdminUpdateOrderStatus(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.OrderControllerPayloadUpdateOrderStatus
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.orderUsecase.UpdateOrderStatus(c.Request().Context(), &domain.OrderUsecasePayloadUpdateOrderStatus{
+		UID:             c.Param("uid"),
+		Status:          payload.Status,
+		Note:            payload.Note,
+		ChangedByUserID: user.ID,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
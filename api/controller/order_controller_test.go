@@ -0,0 +1,385 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderControllerSuite struct {
+	suite.Suite
+	ucMock        *mocks.OrderUsecaseMock
+	ct            domain.OrderController
+	user          *domain.UserModel
+	badRequestRes response_util.Response
+	forbiddenRes  response_util.Response
+	notFoundRes   response_util.Response
+	reqHelper     func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *OrderControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	orderUsecaseMock := &mocks.OrderUsecaseMock{}
+	ct := controller.NewOrderController(env, nil, orderUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = orderUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID()}
+	s.badRequestRes = response_util.Response{
+		Code:   http.StatusBadRequest,
+		Status: http.StatusText(http.StatusBadRequest),
+	}
+	s.forbiddenRes = response_util.Response{
+		Code:   http.StatusForbidden,
+		Status: http.StatusText(http.StatusForbidden),
+	}
+	s.notFoundRes = response_util.Response{
+		Code:   http.StatusNotFound,
+		Status: http.StatusText(http.StatusNotFound),
+	}
+	s.reqHelper = func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestOrderControllerSuite(t *testing.T) {
+	suite.Run(t, new(OrderControllerSuite))
+}
+
+func (s *OrderControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *OrderControllerSuite) TestCheckout() {
+	s.Run("Checkout should return created with uid if successful", func() {
+		expectedUID := gofakeit.UUID()
+		expectedRes := response_util.Response{
+			Code:   http.StatusCreated,
+			Status: http.StatusText(http.StatusCreated),
+			Data:   map[string]interface{}{"uid": expectedUID},
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+
+		s.ucMock.CheckoutReturns(expectedUID, nil)
+		if s.NoError(s.ct.Checkout(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, userID := s.ucMock.CheckoutArgsForCall(s.ucMock.CheckoutCallCount() - 1)
+			s.Equal(s.user.ID, userID)
+		}
+	})
+
+	s.Run("Checkout should return bad request error given empty cart", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.Error = domain.ErrCartEmpty.Error()
+		expectedRes.ErrorCode = domain.ErrCartEmpty.Code
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+
+		s.ucMock.CheckoutReturns("", domain.ErrCartEmpty)
+		if s.NoError(s.ct.Checkout(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Checkout should return conflict error given insufficient stock", func() {
+		err := fmt.Errorf("%w: %s", domain.ErrInsufficientStock, "Product Test")
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     err.Error(),
+			ErrorCode: domain.ErrInsufficientStock.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+
+		s.ucMock.CheckoutReturns("", err)
+		if s.NoError(s.ct.Checkout(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Checkout should return forbidden error if user is not set", func() {
+		expectedRes := s.forbiddenRes
+		expectedRes.Error = domain.ErrAccessDenied.Error()
+		expectedRes.ErrorCode = domain.ErrAccessDenied.Code
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+		c.Set("user", nil)
+
+		if s.NoError(s.ct.Checkout(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Checkout should pass unexpected error to the error handler", func() {
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+
+		ucErr := errors.New("")
+		s.ucMock.CheckoutReturns("", ucErr)
+		s.ErrorIs(s.ct.Checkout(c), ucErr)
+		s.Equal(0, rec.Body.Len())
+	})
+}
+
+func (s *OrderControllerSuite) TestListOrders() {
+	s.Run("List orders should use default limit and page", func() {
+		c, rec := s.reqHelper(http.MethodGet, "/", nil)
+
+		s.ucMock.ListOrdersByUserIDReturns(&domain.OrderControllerResponseListOrders{}, nil)
+		if s.NoError(s.ct.ListOrders(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+
+			_, userID, limit, page := s.ucMock.ListOrdersByUserIDArgsForCall(s.ucMock.ListOrdersByUserIDCallCount() - 1)
+			s.Equal(s.user.ID, userID)
+			s.Equal(10, limit)
+			s.Equal(1, page)
+		}
+	})
+
+	s.Run("List orders should return bad request error given invalid page", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "page", Name: "min", Value: "1"},
+		}
+
+		c, rec := s.reqHelper(http.MethodGet, "/?page=-1", nil)
+
+		if s.NoError(s.ct.ListOrders(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *OrderControllerSuite) TestGetOrderByUID() {
+	s.Run("Get order should return OK if successful", func() {
+		order := &domain.OrderControllerResponseGetOrder{UID: gofakeit.UUID()}
+
+		c, rec := s.reqHelper(http.MethodGet, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(order.UID)
+
+		s.ucMock.GetOrderByUIDAndUserIDReturns(order, nil)
+		if s.NoError(s.ct.GetOrderByUID(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+
+			_, UID, userID := s.ucMock.GetOrderByUIDAndUserIDArgsForCall(s.ucMock.GetOrderByUIDAndUserIDCallCount() - 1)
+			s.Equal(order.UID, UID)
+			s.Equal(s.user.ID, userID)
+		}
+	})
+
+	s.Run("Get order should return not found error if order belongs to another user", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrOrderNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrOrderNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodGet, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.GetOrderByUIDAndUserIDReturns(nil, nil)
+		if s.NoError(s.ct.GetOrderByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *OrderControllerSuite) TestAdminListOrders() {
+	s.Run("Admin list orders should pass status filter", func() {
+		c, rec := s.reqHelper(http.MethodGet, "/?status=PAID&limit=5&page=2", nil)
+
+		s.ucMock.ListOrdersReturns(&domain.OrderControllerResponseListOrders{}, nil)
+		if s.NoError(s.ct.AdminListOrders(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+
+			_, limit, page, status := s.ucMock.ListOrdersArgsForCall(s.ucMock.ListOrdersCallCount() - 1)
+			s.Equal(5, limit)
+			s.Equal(2, page)
+			s.Equal(domain.OrderStatusPaid, status)
+		}
+	})
+
+	s.Run("Admin list orders should return bad request error given invalid status", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "status", Name: "oneof", Value: "PENDING_PAYMENT PAID PROCESSING SHIPPED DELIVERED CANCELLED REFUNDED"},
+		}
+
+		c, rec := s.reqHelper(http.MethodGet, "/?status=UNKNOWN", nil)
+
+		if s.NoError(s.ct.AdminListOrders(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *OrderControllerSuite) TestAdminGetOrderByUID() {
+	s.Run("Admin get order should return not found error if order not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrOrderNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrOrderNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodGet, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues("invalid")
+
+		s.ucMock.GetOrderByUIDReturns(nil, nil)
+		if s.NoError(s.ct.AdminGetOrderByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *OrderControllerSuite) TestCancelOrder() {
+	s.Run("Cancel order should return OK if successful", func() {
+		expectedRes := response_util.Response{
+			Code:   http.StatusOK,
+			Status: http.StatusText(http.StatusOK),
+		}
+		orderUID := gofakeit.UUID()
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(orderUID)
+
+		s.ucMock.CancelOrderReturns(nil)
+		if s.NoError(s.ct.CancelOrder(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, UID, userID := s.ucMock.CancelOrderArgsForCall(s.ucMock.CancelOrderCallCount() - 1)
+			s.Equal(orderUID, UID)
+			s.Equal(s.user.ID, userID)
+		}
+	})
+
+	s.Run("Cancel order should return conflict error given shipped order", func() {
+		err := &domain.OrderTransitionError{From: domain.OrderStatusShipped, To: domain.OrderStatusCancelled}
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     err.Error(),
+			ErrorCode: domain.ErrIllegalOrderTransition.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.CancelOrderReturns(err)
+		if s.NoError(s.ct.CancelOrder(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Cancel order should return not found error if order not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrOrderNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrOrderNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodPost, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues("invalid")
+
+		s.ucMock.CancelOrderReturns(domain.ErrOrderNotFound)
+		if s.NoError(s.ct.CancelOrder(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *OrderControllerSuite) TestAdminUpdateOrderStatus() {
+	s.Run("Admin update order status should return OK if successful", func() {
+		expectedRes := response_util.Response{
+			Code:   http.StatusOK,
+			Status: http.StatusText(http.StatusOK),
+		}
+		orderUID := gofakeit.UUID()
+
+		c, rec := s.reqHelper(http.MethodPatch, "/", strings.NewReader(`{"status":"PAID","note":"manual transfer"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(orderUID)
+
+		s.ucMock.UpdateOrderStatusReturns(nil)
+		if s.NoError(s.ct.AdminUpdateOrderStatus(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, payload := s.ucMock.UpdateOrderStatusArgsForCall(s.ucMock.UpdateOrderStatusCallCount() - 1)
+			s.Equal(orderUID, payload.UID)
+			s.Equal(domain.OrderStatusPaid, payload.Status)
+			s.Equal("manual transfer", payload.Note)
+			s.Equal(s.user.ID, payload.ChangedByUserID)
+		}
+	})
+
+	s.Run("Admin update order status should return conflict error given illegal transition", func() {
+		err := &domain.OrderTransitionError{From: domain.OrderStatusPendingPayment, To: domain.OrderStatusDelivered}
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     err.Error(),
+			ErrorCode: domain.ErrIllegalOrderTransition.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPatch, "/", strings.NewReader(`{"status":"DELIVERED"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.UpdateOrderStatusReturns(err)
+		if s.NoError(s.ct.AdminUpdateOrderStatus(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Admin update order status should return bad request error given invalid status", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "status", Name: "oneof", Value: "PENDING_PAYMENT PAID PROCESSING SHIPPED DELIVERED CANCELLED REFUNDED"},
+		}
+
+		c, rec := s.reqHelper(http.MethodPatch, "/", strings.NewReader(`{"status":"LOST"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		if s.NoError(s.ct.AdminUpdateOrderStatus(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"errors"
+	"io"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type basePaymentController struct {
+	env            *domain.Env
+	loggerUtil     domain.LoggerUtil
+	paymentUsecase domain.PaymentUsecase
+	validate       *validator.Validate
+}
+
+func NewPaymentController(env *domain.Env, loggerUtil domain.LoggerUtil, paymentUsecase domain.PaymentUsecase, validate *validator.Validate) domain.PaymentController {
+	return &basePaymentController{
+		env:            env,
+		loggerUtil:     loggerUtil,
+		paymentUsecase: paymentUsecase,
+		validate:       validate,
+	}
+}
+
+// CreatePayment godoc
+//
+//	@Summary		Create payment for an order of current user
+//	@Description	Returns the pending payment instead of creating a new one if the order already has an unexpired one.
+//	@Tags			payment
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			uid		path		string										true	"order uid"
+//	@Param			payment	body		domain.PaymentControllerPayloadCreatePayment	true	"payment method and channel"
+//	@Success		201		{object}	domain.PaymentControllerResponseGetPayment
+//	@Failure		400		"validation error | unsupported payment channel"
+//	@Failure		403		"access denied"
+//	@Failure		404		"order not found"
+//	@Failure		409		"order is not waiting for payment"
+//	@Failure		500		"Internal Server Error"
+//	@Router			/orders/{uid}/payments [post]
+func (b *basePaymentController) CreatePayment(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.PaymentControllerPayloadCreatePayment
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	payment, err := b.paymentUsecase.CreatePayment(c.Request().Context(), &domain.PaymentUsecasePayloadCreatePayment{
+		OrderUID: c.Param("uid"),
+		Method:   payload.Method,
+		Channel:  payload.Channel,
+		User:     user,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(payment).WithEcho(c)
+}
+
+// GetPaymentByOrderUID godoc
+//
+//	@Summary	Get latest pending or paid payment of an order of current user
+//	@Tags		payment
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path		string	true	"order uid"
+//	@Success	200	{object}	domain.PaymentControllerResponseGetPayment
+//	@Failure	403	"access denied"
+//	@Failure	404	"order not found | payment not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/orders/{uid}/payments [get]
+func (b *basePaymentController) GetPaymentByOrderUID(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	payment, err := b.paymentUsecase.GetPaymentByOrderUID(c.Request().Context(), c.Param("uid"), user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(payment).WithEcho(c)
+}
+
+// HandleWebhook godoc
+//
+//	@Summary		Receive payment gateway notification
+//	@Description	Called by the payment gateway. Repeated notifications for the same transaction are ignored.
+//	@Tags			payment
+//	@Accept			json
+//	@Produce		json
+//	@Success		200
+//	@Failure		400	"payment amount does not match"
+//	@Failure		403	"invalid webhook signature"
+//	@Failure		404	"payment not found"
+//	@Failure		409	"payment has been superseded by a newer payment"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/payments/webhook [post]
+func (b *basePaymentController) HandleWebhook(c echo.Context) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+
+	err = b.paymentUsecase.HandleWebhook(c.Request().Context(), c.Request().Header, body)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// SimulatePayment godoc
+//
+//	@Summary		Settle a simulator payment
+//	@Description	Only available when PAYMENT_GATEWAY is simulator outside production. Sends a signed notification through the webhook flow.
+//	@Tags			payment
+//	@Accept			json
+//	@Produce		json
+//	@Param			transaction_id	path	string										true	"gateway transaction id"
+//	@Param			status			body	domain.PaymentControllerPayloadSimulatePayment	true	"payment status to simulate"
+//	@Success		200
+//	@Failure		400	"validation error"
+//	@Failure		403	"payment simulator is not enabled"
+//	@Failure		404	"payment not found"
+//	@Failure		500	"Internal Server Error"
+//	@Router			/payments/simulator/{transaction_id} [post]
+func (b *basePaymentController) SimulatePayment(c echo.Context) error {
+	var payload domain.PaymentControllerPayloadSimulatePayment
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.paymentUsecase.SimulatePayment(c.Request().Context(), c.Param("transaction_id"), payload.Status)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// AdminRefundPayment godoc
+//
+//	@Summary	Refund the paid payment of a cancelled or delivered order
+//	@Tags		payment
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid		path	string									true	"order uid"
+//	@Param		refund	body	domain.PaymentControllerPayloadRefundPayment	true	"refund reason"
+//	@Success	200
+//	@Failure	400	"validation error"
+//	@Failure	404	"order not found"
+//	@Failure	409	"payment is not refundable | cannot transition order"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/orders/{uid}/refund [post]
+func (b *basePaymentController) AdminRefundPayment(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.PaymentControllerPayloadRefundPayment
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.paymentUsecase.RefundPayment(c.Request().Context(), c.Param("uid"), payload.Reason, user.ID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
@@ -0,0 +1,231 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentControllerSuite struct {
+	suite.Suite
+	ucMock        *mocks.PaymentUsecaseMock
+	ct            domain.PaymentController
+	user          *domain.UserModel
+	okRes         response_util.Response
+	badRequestRes response_util.Response
+	forbiddenRes  response_util.Response
+	notFoundRes   response_util.Response
+	conflictRes   response_util.Response
+	reqHelper     func(method string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *PaymentControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	paymentUsecaseMock := &mocks.PaymentUsecaseMock{}
+	ct := controller.NewPaymentController(env, nil, paymentUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = paymentUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID()}
+	s.okRes = response_util.Response{
+		Code:   http.StatusOK,
+		Status: http.StatusText(http.StatusOK),
+	}
+	s.badRequestRes = response_util.Response{
+		Code:   http.StatusBadRequest,
+		Status: http.StatusText(http.StatusBadRequest),
+	}
+	s.forbiddenRes = response_util.Response{
+		Code:   http.StatusForbidden,
+		Status: http.StatusText(http.StatusForbidden),
+	}
+	s.notFoundRes = response_util.Response{
+		Code:   http.StatusNotFound,
+		Status: http.StatusText(http.StatusNotFound),
+	}
+	s.conflictRes = response_util.Response{
+		Code:   http.StatusConflict,
+		Status: http.StatusText(http.StatusConflict),
+	}
+	s.reqHelper = func(method string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/", body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestPaymentControllerSuite(t *testing.T) {
+	suite.Run(t, new(PaymentControllerSuite))
+}
+
+func (s *PaymentControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *PaymentControllerSuite) TestCreatePayment() {
+	s.Run("Create payment should return created with payment if successful", func() {
+		orderUID := gofakeit.UUID()
+
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(`{"method":"VIRTUAL_ACCOUNT","channel":"bca"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(orderUID)
+
+		s.ucMock.CreatePaymentReturns(&domain.PaymentControllerResponseGetPayment{UID: gofakeit.UUID()}, nil)
+		if s.NoError(s.ct.CreatePayment(c)) {
+			s.Equal(http.StatusCreated, rec.Code)
+
+			_, payload := s.ucMock.CreatePaymentArgsForCall(s.ucMock.CreatePaymentCallCount() - 1)
+			s.Equal(orderUID, payload.OrderUID)
+			s.Equal(domain.PaymentMethodVirtualAccount, payload.Method)
+			s.Equal("bca", payload.Channel)
+			s.Equal(s.user, payload.User)
+		}
+	})
+
+	s.Run("Create payment should return bad request error given virtual account without channel", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "channel", Name: "required_unless", Value: "Method QRIS"},
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(`{"method":"VIRTUAL_ACCOUNT"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		if s.NoError(s.ct.CreatePayment(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Create payment should return conflict error if order is not waiting for payment", func() {
+		expectedRes := s.conflictRes
+		expectedRes.Error = domain.ErrOrderNotPayable.Error()
+		expectedRes.ErrorCode = domain.ErrOrderNotPayable.Code
+
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(`{"method":"QRIS"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.CreatePaymentReturns(nil, domain.ErrOrderNotPayable)
+		if s.NoError(s.ct.CreatePayment(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *PaymentControllerSuite) TestGetPaymentByOrderUID() {
+	s.Run("Get payment should return not found error if order has no payment", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrPaymentNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrPaymentNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodGet, nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.GetPaymentByOrderUIDReturns(nil, domain.ErrPaymentNotFound)
+		if s.NoError(s.ct.GetPaymentByOrderUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *PaymentControllerSuite) TestHandleWebhook() {
+	s.Run("Handle webhook should pass raw body and header to usecase", func() {
+		body := `{"transaction_id":"sim-1","status":"PAID","amount":10000}`
+
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(body))
+		c.Request().Header.Set("X-Simulator-Signature", "signature")
+
+		s.ucMock.HandleWebhookReturns(nil)
+		if s.NoError(s.ct.HandleWebhook(c)) {
+			s.ValidateRes(rec, s.okRes)
+
+			_, header, reqBody := s.ucMock.HandleWebhookArgsForCall(s.ucMock.HandleWebhookCallCount() - 1)
+			s.Equal("signature", header.Get("X-Simulator-Signature"))
+			s.Equal(body, string(reqBody))
+		}
+	})
+
+	s.Run("Handle webhook should return forbidden error given invalid signature", func() {
+		expectedRes := s.forbiddenRes
+		expectedRes.Error = domain.ErrInvalidWebhookSignature.Error()
+		expectedRes.ErrorCode = domain.ErrInvalidWebhookSignature.Code
+
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(`{}`))
+
+		s.ucMock.HandleWebhookReturns(domain.ErrInvalidWebhookSignature)
+		if s.NoError(s.ct.HandleWebhook(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *PaymentControllerSuite) TestSimulatePayment() {
+	s.Run("Simulate payment should return OK if successful", func() {
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(`{"status":"PAID"}`))
+		c.SetParamNames("transaction_id")
+		c.SetParamValues("sim-1")
+
+		s.ucMock.SimulatePaymentReturns(nil)
+		if s.NoError(s.ct.SimulatePayment(c)) {
+			s.ValidateRes(rec, s.okRes)
+
+			_, transactionID, status := s.ucMock.SimulatePaymentArgsForCall(s.ucMock.SimulatePaymentCallCount() - 1)
+			s.Equal("sim-1", transactionID)
+			s.Equal(domain.PaymentStatusPaid, status)
+		}
+	})
+}
+
+func (s *PaymentControllerSuite) TestAdminRefundPayment() {
+	s.Run("Admin refund payment should return conflict error if payment is not refundable", func() {
+		expectedRes := s.conflictRes
+		expectedRes.Error = domain.ErrPaymentNotRefundable.Error()
+		expectedRes.ErrorCode = domain.ErrPaymentNotRefundable.Code
+
+		c, rec := s.reqHelper(http.MethodPost, strings.NewReader(`{"reason":"out of stock"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.RefundPaymentReturns(domain.ErrPaymentNotRefundable)
+		if s.NoError(s.ct.AdminRefundPayment(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, _, reason, changedByUserID := s.ucMock.RefundPaymentArgsForCall(s.ucMock.RefundPaymentCallCount() - 1)
+			s.Equal("out of stock", reason)
+			s.Equal(s.user.ID, changedByUserID)
+		}
+	})
+}
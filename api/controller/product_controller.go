@@ -0,0 +1,223 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseProductController struct {
+	env            *domain.Env
+	loggerUtil     domain.LoggerUtil
+	productUsecase domain.ProductUsecase
+	validate       *validator.Validate
+}
+
+func NewProductController(env *domain.Env, loggerUtil domain.LoggerUtil, productUsecase domain.ProductUsecase, validate *validator.Validate) domain.ProductController {
+	return &baseProductController{
+		env:            env,
+		loggerUtil:     loggerUtil,
+		productUsecase: productUsecase,
+		validate:       validate,
+	}
+}
+
+// Create godoc
+//
+//	@Summary	Create product
+//	@Tags		product
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		product body	domain.ProductControllerPayloadCreateProduct true	"product"
+//	@Success	201
+//	@Failure	400	"validation error"
+//	@Failure	403	"access denied"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/products [post]
+func (b *baseProductController) Create(c echo.Context) error {
+	var payload domain.ProductControllerPayloadCreateProduct
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	UID, err := b.productUsecase.Create(c.Request().Context(), &domain.ProductUsecasePayloadCreateProduct{
+		Name:           payload.Name,
+		SKU:            payload.SKU,
+		Description:    payload.Description,
+		Images:         payload.Images,
+		WeightValue:    payload.WeightValue,
+		BasePriceValue: payload.BasePriceValue,
+		Discount:       *payload.Discount,
+		Stock:          *payload.Stock,
+		Status:         payload.Status,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreatedData(map[string]string{"uid": UID}).WithEcho(c)
+}
+
+// List godoc
+//
+//	@Summary	List products
+//	@Tags		product
+//	@Produce	json
+//	@Param		limit		query	int		false	"page size, default 10"
+//	@Param		cursor		query	string	false	"encrypted cursor from prev_cursor or next_cursor"
+//	@Param		direction	query	string	false	"next or prev, required with cursor"
+//	@Success	200	{object}	domain.ProductControllerResponseListProducts
+//	@Failure	400	"validation error | invalid cursor"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/products [get]
+func (b *baseProductController) List(c echo.Context) error {
+	var query domain.ProductControllerQueryListProducts
+	err := c.Bind(&query)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&query)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+
+	res, err := b.productUsecase.List(c.Request().Context(), query.Limit, query.Cursor, query.Direction)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if res == nil {
+		res = &domain.ProductControllerResponseListProducts{
+			Products:    []*domain.ProductControllerResponseGetProductByUID{},
+			IsFirstPage: query.Direction == "",
+			Limit:       query.Limit,
+		}
+	}
+
+	return response_util.FromData(res).WithEcho(c)
+}
+
+// GetByUID godoc
+//
+//	@Summary	Get product by uid
+//	@Tags		product
+//	@Produce	json
+//	@Param		uid	path	string	true	"product uid"
+//	@Success	200	{object}	domain.ProductControllerResponseGetProductByUID
+//	@Failure	404	"product not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/products/{uid} [get]
+func (b *baseProductController) GetByUID(c echo.Context) error {
+	product, err := b.productUsecase.GetByUID(c.Request().Context(), c.Param("uid"))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if product == nil {
+		return response_util.FromError(domain.ErrProductNotFound).WithEcho(c)
+	}
+
+	return response_util.FromData(product).WithEcho(c)
+}
+
+// UpdateByUID godoc
+//
+//	@Summary	Update product by uid
+//	@Tags		product
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid		path	string									true	"product uid"
+//	@Param		product	body	domain.ProductControllerPayloadUpdateProduct	true	"product"
+//	@Success	200
+//	@Failure	400	"validation error"
+//	@Failure	403	"access denied"
+//	@Failure	404	"product not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/products/{uid} [put]
+func (b *baseProductController) UpdateByUID(c echo.Context) error {
+	var payload domain.ProductControllerPayloadUpdateProduct
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	UID := c.Param("uid")
+	product, err := b.productUsecase.GetByUID(c.Request().Context(), UID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if product == nil {
+		return response_util.FromError(domain.ErrProductNotFound).WithEcho(c)
+	}
+
+	err = b.productUsecase.UpdateByUID(c.Request().Context(), UID, &domain.ProductUsecasePayloadUpdateProduct{
+		Name:           payload.Name,
+		SKU:            payload.SKU,
+		Description:    payload.Description,
+		Images:         payload.Images,
+		WeightValue:    payload.WeightValue,
+		BasePriceValue: payload.BasePriceValue,
+		Discount:       *payload.Discount,
+		Stock:          *payload.Stock,
+		Status:         payload.Status,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
+
+// DeleteByUID godoc
+//
+//	@Summary	Delete product by uid
+//	@Tags		product
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"product uid"
+//	@Success	200
+//	@Failure	403	"access denied"
+//	@Failure	404	"product not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/products/{uid} [delete]
+func (b *baseProductController) DeleteByUID(c echo.Context) error {
+	UID := c.Param("uid")
+	product, err := b.productUsecase.GetByUID(c.Request().Context(), UID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	if product == nil {
+		return response_util.FromError(domain.ErrProductNotFound).WithEcho(c)
+	}
+
+	err = b.productUsecase.DeleteByUID(c.Request().Context(), UID)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
@@ -0,0 +1,304 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductControllerSuite struct {
+	suite.Suite
+	ucMock        *mocks.ProductUsecaseMock
+	ct            domain.ProductController
+	okRes         response_util.Response
+	badRequestRes response_util.Response
+	notFoundRes   response_util.Response
+	reqHelper     func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+	validPayload  func() *domain.ProductControllerPayloadCreateProduct
+}
+
+func (s *ProductControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	productUsecaseMock := &mocks.ProductUsecaseMock{}
+	ct := controller.NewProductController(env, nil, productUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = productUsecaseMock
+	s.okRes = response_util.Response{
+		Code:   http.StatusOK,
+		Status: http.StatusText(http.StatusOK),
+	}
+	s.badRequestRes = response_util.Response{
+		Code:   http.StatusBadRequest,
+		Status: http.StatusText(http.StatusBadRequest),
+	}
+	s.notFoundRes = response_util.Response{
+		Code:   http.StatusNotFound,
+		Status: http.StatusText(http.StatusNotFound),
+	}
+	s.reqHelper = func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+
+		return c, rec
+	}
+	s.validPayload = func() *domain.ProductControllerPayloadCreateProduct {
+		discount := 10
+		stock := 20
+
+		return &domain.ProductControllerPayloadCreateProduct{
+			Name:           "Product Test",
+			SKU:            "TEST123",
+			Description:    gofakeit.Sentence(20),
+			Images:         domain.StringSlice{"test.jpg"},
+			WeightValue:    1000,
+			BasePriceValue: 10000,
+			Discount:       &discount,
+			Stock:          &stock,
+			Status:         "ACTIVE",
+		}
+	}
+}
+
+func TestProductControllerSuite(t *testing.T) {
+	suite.Run(t, new(ProductControllerSuite))
+}
+
+func (s *ProductControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *ProductControllerSuite) TestCreate() {
+	s.Run("Create product should return created with uid if successful", func() {
+		expectedUID := gofakeit.UUID()
+		expectedRes := response_util.Response{
+			Code:   http.StatusCreated,
+			Status: http.StatusText(http.StatusCreated),
+			Data:   map[string]interface{}{"uid": expectedUID},
+		}
+
+		reqBytes, err := json.Marshal(s.validPayload())
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, "/", bytes.NewBuffer(reqBytes))
+
+		s.ucMock.CreateReturns(expectedUID, nil)
+		if s.NoError(s.ct.Create(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Create product should return bad request error given invalid payload", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "name", Name: "min", Value: "5"},
+			{Field: "discount", Name: "max", Value: "100"},
+		}
+
+		reqBody := s.validPayload()
+		reqBody.Name = "Test"
+		discount := 101
+		reqBody.Discount = &discount
+		reqBytes, err := json.Marshal(reqBody)
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, "/", bytes.NewBuffer(reqBytes))
+
+		if s.NoError(s.ct.Create(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Create product should pass unexpected error to the error handler", func() {
+
+		reqBytes, err := json.Marshal(s.validPayload())
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPost, "/", bytes.NewBuffer(reqBytes))
+
+		ucErr := errors.New("")
+		s.ucMock.CreateReturns("", ucErr)
+		s.ErrorIs(s.ct.Create(c), ucErr)
+		s.Equal(0, rec.Body.Len())
+	})
+}
+
+func (s *ProductControllerSuite) TestList() {
+	s.Run("List products should return OK if successful", func() {
+		expectedData := &domain.ProductControllerResponseListProducts{
+			Products:    []*domain.ProductControllerResponseGetProductByUID{},
+			IsFirstPage: true,
+			Limit:       5,
+			NextCursor:  gofakeit.UUID(),
+		}
+		expectedRes := s.okRes
+		expectedRes.Data = map[string]interface{}{
+			"products":      []interface{}{},
+			"is_first_page": true,
+			"limit":         float64(5),
+			"prev_cursor":   "",
+			"next_cursor":   expectedData.NextCursor,
+		}
+
+		c, rec := s.reqHelper(http.MethodGet, "/?limit=5", nil)
+
+		s.ucMock.ListReturns(expectedData, nil)
+		if s.NoError(s.ct.List(c)) {
+			s.ValidateRes(rec, expectedRes)
+
+			_, limit, cursor, direction := s.ucMock.ListArgsForCall(s.ucMock.ListCallCount() - 1)
+			s.Equal(5, limit)
+			s.Empty(cursor)
+			s.Empty(direction)
+		}
+	})
+
+	s.Run("List products should return bad request error given direction without cursor", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.ValidationErrors = []response_util.ValidationError{
+			{Field: "cursor", Name: "required_with", Value: "Direction"},
+		}
+
+		c, rec := s.reqHelper(http.MethodGet, "/?direction=next", nil)
+
+		if s.NoError(s.ct.List(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("List products should return bad request error given invalid cursor", func() {
+		expectedRes := s.badRequestRes
+		expectedRes.Error = domain.ErrInvalidCursor.Error()
+		expectedRes.ErrorCode = domain.ErrInvalidCursor.Code
+
+		c, rec := s.reqHelper(http.MethodGet, "/?cursor=invalid&direction=next", nil)
+
+		s.ucMock.ListReturns(nil, domain.ErrInvalidCursor)
+		if s.NoError(s.ct.List(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *ProductControllerSuite) TestGetByUID() {
+	s.Run("Get product should return OK if successful", func() {
+		expectedData := &domain.ProductControllerResponseGetProductByUID{UID: gofakeit.UUID()}
+		c, rec := s.reqHelper(http.MethodGet, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(expectedData.UID)
+
+		s.ucMock.GetByUIDReturns(expectedData, nil)
+		if s.NoError(s.ct.GetByUID(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+
+			_, UID := s.ucMock.GetByUIDArgsForCall(s.ucMock.GetByUIDCallCount() - 1)
+			s.Equal(expectedData.UID, UID)
+		}
+	})
+
+	s.Run("Get product should return not found error if product not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrProductNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrProductNotFound.Code
+
+		c, rec := s.reqHelper(http.MethodGet, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues("invalid")
+
+		s.ucMock.GetByUIDReturns(nil, nil)
+		if s.NoError(s.ct.GetByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *ProductControllerSuite) TestUpdateByUID() {
+	s.Run("Update product should return OK if successful", func() {
+		expectedRes := s.okRes
+
+		reqBytes, err := json.Marshal(s.validPayload())
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPut, "/", bytes.NewBuffer(reqBytes))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.GetByUIDReturns(&domain.ProductControllerResponseGetProductByUID{}, nil)
+		s.ucMock.UpdateByUIDReturns(nil)
+		if s.NoError(s.ct.UpdateByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Update product should return not found error if product not found", func() {
+		expectedRes := s.notFoundRes
+		expectedRes.Error = domain.ErrProductNotFound.Error()
+		expectedRes.ErrorCode = domain.ErrProductNotFound.Code
+
+		reqBytes, err := json.Marshal(s.validPayload())
+		s.NoError(err)
+		c, rec := s.reqHelper(http.MethodPut, "/", bytes.NewBuffer(reqBytes))
+		c.SetParamNames("uid")
+		c.SetParamValues("invalid")
+
+		s.ucMock.GetByUIDReturns(nil, nil)
+		if s.NoError(s.ct.UpdateByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *ProductControllerSuite) TestDeleteByUID() {
+	s.Run("Delete product should return OK if successful", func() {
+		expectedRes := s.okRes
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.GetByUIDReturns(&domain.ProductControllerResponseGetProductByUID{}, nil)
+		s.ucMock.DeleteByUIDReturns(nil)
+		if s.NoError(s.ct.DeleteByUID(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Delete product should pass unexpected error to the error handler", func() {
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.GetByUIDReturns(&domain.ProductControllerResponseGetProductByUID{}, nil)
+		ucErr := errors.New("")
+		s.ucMock.DeleteByUIDReturns(ucErr)
+		s.ErrorIs(s.ct.DeleteByUID(c), ucErr)
+		s.Equal(0, rec.Body.Len())
+	})
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseRoleController struct {
+	env         *domain.Env
+	loggerUtil  domain.LoggerUtil
+	roleUsecase domain.RoleUsecase
+	validate    *validator.Validate
+}
+
+func NewRoleController(env *domain.Env, loggerUtil domain.LoggerUtil, roleUsecase domain.RoleUsecase, validate *validator.Validate) domain.RoleController {
+	return &baseRoleController{
+		env:         env,
+		loggerUtil:  loggerUtil,
+		roleUsecase: roleUsecase,
+		validate:    validate,
+	}
+}
+
+// ListRoles godoc
+//
+//	@Summary	List roles with their permissions
+//	@Tags		role
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{array}	domain.RoleControllerResponseRole
+//	@Failure	403	"access denied"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/roles [get]
+func (b *baseRoleController) ListRoles(c echo.Context) error {
+	roles, err := b.roleUsecase.ListRoles(c.Request().Context())
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(roles).WithEcho(c)
+}
+
+// ListUserRoles godoc
+//
+//	@Summary	List roles of a user
+//	@Tags		role
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid	path	string	true	"user uid"
+//	@Success	200	{array}	domain.RoleControllerResponseRole
+//	@Failure	403	"access denied"
+//	@Failure	404	"user not found"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/users/{uid}/roles [get]
+func (b *baseRoleController) ListUserRoles(c echo.Context) error {
+	roles, err := b.roleUsecase.ListUserRoles(c.Request().Context(), c.Param("uid"))
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(roles).WithEcho(c)
+}
+
+// AssignRole godoc
+//
+//	@Summary	Assign a role to a user
+//	@Tags		role
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid		path	string									true	"user uid"
+//	@Param		payload	body	domain.RoleControllerPayloadAssignRole	true	"role name"
+//	@Success	201
+//	@Failure	400	"validation error | super_admin role is managed through the admin API"
+//	@Failure	403	"access denied"
+//	@Failure	404	"user not found | role not found"
+//	@Failure	409	"user already has the role"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/users/{uid}/roles [post]
+func (b *baseRoleController) AssignRole(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.RoleControllerPayloadAssignRole
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	err = b.roleUsecase.AssignRole(c.Request().Context(), &domain.RoleUsecasePayloadUpdateUserRole{
+		UserUID:     c.Param("uid"),
+		Role:        payload.Role,
+		ActorUserID: user.ID,
+		IPAddress:   c.RealIP(),
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromCreated().WithEcho(c)
+}
+
+// RemoveRole godoc
+//
+//	@Summary	Remove a role from a user
+//	@Tags		role
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		uid		path	string	true	"user uid"
+//	@Param		role	path	string	true	"role name"
+//	@Success	200
+//	@Failure	400	"super_admin role is managed through the admin API"
+//	@Failure	403	"access denied"
+//	@Failure	404	"user not found | role not found | user does not have the role"
+//	@Failure	500	"Internal Server Error"
+//	@Router		/admin/users/{uid}/roles/{role} [delete]
+func (b *baseRoleController) RemoveRole(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	err := b.roleUsecase.RemoveRole(c.Request().Context(), &domain.RoleUsecasePayloadUpdateUserRole{
+		UserUID:     c.Param("uid"),
+		Role:        c.Param("role"),
+		ActorUserID: user.ID,
+		IPAddress:   c.RealIP(),
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromOK().WithEcho(c)
+}
@@ -0,0 +1,148 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleControllerSuite struct {
+	suite.Suite
+	ucMock    *mocks.RoleUsecaseMock
+	ct        domain.RoleController
+	user      *domain.UserModel
+	reqHelper func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *RoleControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	roleUsecaseMock := &mocks.RoleUsecaseMock{}
+	ct := controller.NewRoleController(env, nil, roleUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = roleUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID()}
+	s.reqHelper = func(method, target string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, body)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestRoleControllerSuite(t *testing.T) {
+	suite.Run(t, new(RoleControllerSuite))
+}
+
+func (s *RoleControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *RoleControllerSuite) TestAssignRole() {
+	s.Run("Assign role should return created with the user of the path", func() {
+		expectedRes := response_util.Response{
+			Code:   http.StatusCreated,
+			Status: http.StatusText(http.StatusCreated),
+		}
+		userUID := gofakeit.UUID()
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(`{"role":"catalog_manager"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(userUID)
+
+		s.ucMock.AssignRoleReturns(nil)
+		if s.NoError(s.ct.AssignRole(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, payload := s.ucMock.AssignRoleArgsForCall(0)
+			s.Equal(userUID, payload.UserUID)
+			s.Equal("catalog_manager", payload.Role)
+			s.Equal(s.user.ID, payload.ActorUserID)
+		}
+	})
+
+	s.Run("Assign role should return conflict error given existing role", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     domain.ErrRoleAlreadyExist.Error(),
+			ErrorCode: domain.ErrRoleAlreadyExist.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(`{"role":"catalog_manager"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.AssignRoleReturns(domain.ErrRoleAlreadyExist)
+		if s.NoError(s.ct.AssignRole(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Assign role should return bad request error given super admin role", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrRoleManagedByAdmin.Error(),
+			ErrorCode: domain.ErrRoleManagedByAdmin.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPost, "/", strings.NewReader(`{"role":"super_admin"}`))
+		c.SetParamNames("uid")
+		c.SetParamValues(gofakeit.UUID())
+
+		s.ucMock.AssignRoleReturns(domain.ErrRoleManagedByAdmin)
+		if s.NoError(s.ct.AssignRole(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
+
+func (s *RoleControllerSuite) TestRemoveRole() {
+	s.Run("Remove role should return not found error given role the user does not have", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusNotFound,
+			Status:    http.StatusText(http.StatusNotFound),
+			Error:     domain.ErrUserRoleNotFound.Error(),
+			ErrorCode: domain.ErrUserRoleNotFound.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodDelete, "/", nil)
+		c.SetParamNames("uid", "role")
+		c.SetParamValues(gofakeit.UUID(), "catalog_manager")
+
+		s.ucMock.RemoveRoleReturns(domain.ErrUserRoleNotFound)
+		if s.NoError(s.ct.RemoveRole(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+type baseUserController struct {
+	env         *domain.Env
+	loggerUtil  domain.LoggerUtil
+	userUsecase domain.UserUsecase
+	validate    *validator.Validate
+}
+
+func NewUserController(env *domain.Env, loggerUtil domain.LoggerUtil, userUsecase domain.UserUsecase, validate *validator.Validate) domain.UserController {
+	return &baseUserController{
+		env:         env,
+		loggerUtil:  loggerUtil,
+		userUsecase: userUsecase,
+		validate:    validate,
+	}
+}
+
+// GetProfile godoc
+//
+//	@Summary	Get profile of the current user
+//	@Tags		user
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Success	200	{object}	domain.UserControllerResponseProfile
+//	@Failure	403	"access denied"
+//	@Router		/me [get]
+func (b *baseUserController) GetProfile(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	return response_util.FromData(b.userUsecase.GetProfile(c.Request().Context(), user)).WithEcho(c)
+}
+
+// UpdateProfile godoc
+//
+//	@Summary		Update profile of the current user
+//	@Description	Only the fields that are sent are updated. Phone must be an Indonesian number and is stored as +62.
+//	@Description	Changing the email requires current_password, the new email has to be verified again.
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			payload	body		domain.UserControllerPayloadUpdateProfile	true	"profile fields"
+//	@Success		200		{object}	domain.UserControllerResponseProfile
+//	@Failure		400		"validation error | invalid phone | current password is required to change the email"
+//	@Failure		403		"access denied | current password is invalid"
+//	@Failure		409		"email already exist"
+//	@Failure		500		"Internal Server Error"
+//	@Router			/me [patch]
+func (b *baseUserController) UpdateProfile(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	var payload domain.UserControllerPayloadUpdateProfile
+	err := c.Bind(&payload)
+	if err != nil {
+		return response_util.FromBindingError(err).WithEcho(c)
+	}
+	err = b.validate.Struct(&payload)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return response_util.FromValidationErrors(validationErrors).WithEcho(c)
+		}
+	}
+
+	profile, err := b.userUsecase.UpdateProfile(c.Request().Context(), user, &domain.UserUsecasePayloadUpdateProfile{
+		Name:            payload.Name,
+		Phone:           payload.Phone,
+		Email:           payload.Email,
+		CurrentPassword: payload.CurrentPassword,
+	})
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(profile).WithEcho(c)
+}
+
+// UploadProfileImage godoc
+//
+//	@Summary		Upload profile image of the current user
+//	@Description	Accepts a JPEG, PNG or WebP image up to 2 MB.
+//	@Tags			user
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			image	formData	file	true	"profile image"
+//	@Success		200		{object}	domain.UserControllerResponseProfile
+//	@Failure		400		"image is required | profile image must not be larger than 2 MB | unsupported image type"
+//	@Failure		403		"access denied"
+//	@Failure		500		"Internal Server Error"
+//	@Router			/me/profile-image [put]
+func (b *baseUserController) UploadProfileImage(c echo.Context) error {
+	user, ok := c.Get("user").(*domain.UserModel)
+	if !ok || user == nil {
+		return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+	}
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return response_util.FromError(domain.ErrProfileImageRequired).WithEcho(c)
+	}
+	if fileHeader.Size > domain.MaxProfileImageSize {
+		return response_util.FromError(domain.ErrProfileImageTooLarge).WithEcho(c)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+	defer file.Close()
+
+	profile, err := b.userUsecase.UploadProfileImage(c.Request().Context(), user, file)
+	if err != nil {
+		return response_util.FromError(err).WithEcho(c)
+	}
+
+	return response_util.FromData(profile).WithEcho(c)
+}
@@ -0,0 +1,186 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserControllerSuite struct {
+	suite.Suite
+	ucMock    *mocks.UserUsecaseMock
+	ct        domain.UserController
+	user      *domain.UserModel
+	reqHelper func(method, target, contentType string, body io.Reader) (echo.Context, *httptest.ResponseRecorder)
+}
+
+func (s *UserControllerSuite) SetupTest() {
+	env := utils.LoadConfig("../../.env")
+	validate := validator.New()
+	userUsecaseMock := &mocks.UserUsecaseMock{}
+	ct := controller.NewUserController(env, nil, userUsecaseMock, validate)
+
+	s.ct = ct
+	s.ucMock = userUsecaseMock
+	s.user = &domain.UserModel{ID: 1, UID: gofakeit.UUID(), Email: gofakeit.Email()}
+	s.reqHelper = func(method, target, contentType string, body io.Reader) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, target, body)
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		rec := httptest.NewRecorder()
+		e := echo.New()
+		c := e.NewContext(req, rec)
+		c.Set("user", s.user)
+
+		return c, rec
+	}
+}
+
+func TestUserControllerSuite(t *testing.T) {
+	suite.Run(t, new(UserControllerSuite))
+}
+
+func (s *UserControllerSuite) ValidateRes(rec *httptest.ResponseRecorder, expectedRes response_util.Response) {
+	_res := rec.Result()
+	defer _res.Body.Close()
+
+	data, err := io.ReadAll(_res.Body)
+	s.NoError(err)
+	s.NotNil(data)
+
+	var res response_util.Response
+	err = json.Unmarshal(data, &res)
+	s.NoError(err)
+	s.Equal(expectedRes, res)
+}
+
+func (s *UserControllerSuite) TestUpdateProfile() {
+	s.Run("Update profile should pass only sent fields to usecase", func() {
+		c, rec := s.reqHelper(http.MethodPatch, "/", echo.MIMEApplicationJSON, strings.NewReader(`{"phone":"081234567890"}`))
+
+		s.ucMock.UpdateProfileReturns(&domain.UserControllerResponseProfile{UID: s.user.UID, Phone: "+6281234567890"}, nil)
+		if s.NoError(s.ct.UpdateProfile(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+			_, user, payload := s.ucMock.UpdateProfileArgsForCall(0)
+			s.Equal(s.user, user)
+			s.Nil(payload.Name)
+			s.Nil(payload.Email)
+			s.Equal("081234567890", *payload.Phone)
+		}
+	})
+
+	s.Run("Update profile should return bad request error given invalid phone", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrInvalidPhone.Error(),
+			ErrorCode: domain.ErrInvalidPhone.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPatch, "/", echo.MIMEApplicationJSON, strings.NewReader(`{"phone":"+14155552671"}`))
+
+		s.ucMock.UpdateProfileReturns(nil, domain.ErrInvalidPhone)
+		if s.NoError(s.ct.UpdateProfile(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Update profile should return conflict error given email of another user", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusConflict,
+			Status:    http.StatusText(http.StatusConflict),
+			Error:     domain.ErrEmailAlreadyExist.Error(),
+			ErrorCode: domain.ErrEmailAlreadyExist.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPatch, "/", echo.MIMEApplicationJSON, strings.NewReader(`{"email":"`+gofakeit.Email()+`"}`))
+
+		s.ucMock.UpdateProfileReturns(nil, domain.ErrEmailAlreadyExist)
+		if s.NoError(s.ct.UpdateProfile(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+
+	s.Run("Update profile should return forbidden error given invalid current password", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusForbidden,
+			Status:    http.StatusText(http.StatusForbidden),
+			Error:     domain.ErrInvalidCurrentPassword.Error(),
+			ErrorCode: domain.ErrInvalidCurrentPassword.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPatch, "/", echo.MIMEApplicationJSON, strings.NewReader(`{"email":"`+gofakeit.Email()+`","current_password":"wrong"}`))
+
+		s.ucMock.UpdateProfileReturns(nil, domain.ErrInvalidCurrentPassword)
+		if s.NoError(s.ct.UpdateProfile(c)) {
+			s.ValidateRes(rec, expectedRes)
+			_, _, payload := s.ucMock.UpdateProfileArgsForCall(s.ucMock.UpdateProfileCallCount() - 1)
+			s.Equal("wrong", *payload.CurrentPassword)
+		}
+	})
+
+	s.Run("Update profile should return validation error given invalid email", func() {
+		callCount := s.ucMock.UpdateProfileCallCount()
+		c, rec := s.reqHelper(http.MethodPatch, "/", echo.MIMEApplicationJSON, strings.NewReader(`{"email":"invalid"}`))
+
+		if s.NoError(s.ct.UpdateProfile(c)) {
+			s.Equal(http.StatusBadRequest, rec.Code)
+			s.Equal(callCount, s.ucMock.UpdateProfileCallCount())
+		}
+	})
+}
+
+func (s *UserControllerSuite) TestUploadProfileImage() {
+	s.Run("Upload profile image should pass the file to usecase", func() {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("image", "avatar.png")
+		s.NoError(err)
+		_, err = part.Write([]byte("\x89PNG\r\n\x1a\n0000"))
+		s.NoError(err)
+		s.NoError(writer.Close())
+
+		c, rec := s.reqHelper(http.MethodPut, "/", writer.FormDataContentType(), body)
+
+		s.ucMock.UploadProfileImageReturns(&domain.UserControllerResponseProfile{UID: s.user.UID, ProfileImage: "/uploads/avatar.png"}, nil)
+		if s.NoError(s.ct.UploadProfileImage(c)) {
+			s.Equal(http.StatusOK, rec.Code)
+			_, user, image := s.ucMock.UploadProfileImageArgsForCall(0)
+			s.Equal(s.user, user)
+			data, err := io.ReadAll(image)
+			s.NoError(err)
+			s.Equal([]byte("\x89PNG\r\n\x1a\n0000"), data)
+		}
+	})
+
+	s.Run("Upload profile image should return bad request error given no image", func() {
+		expectedRes := response_util.Response{
+			Code:      http.StatusBadRequest,
+			Status:    http.StatusText(http.StatusBadRequest),
+			Error:     domain.ErrProfileImageRequired.Error(),
+			ErrorCode: domain.ErrProfileImageRequired.Code,
+		}
+
+		c, rec := s.reqHelper(http.MethodPut, "/", "", nil)
+
+		if s.NoError(s.ct.UploadProfileImage(c)) {
+			s.ValidateRes(rec, expectedRes)
+		}
+	})
+}
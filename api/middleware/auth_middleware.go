@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
@@ -10,38 +12,112 @@ import (
 )
 
 type baseAuthMiddleware struct {
+	env         *domain.Env
 	userUsecase domain.UserUsecase
+	cartUsecase domain.CartUsecase
+	roleUsecase domain.RoleUsecase
 	authUtil    domain.AuthUtil
+	jwtUtil     domain.JWTUtil
 }
 
-func NewAuthMiddleware(userUsecase domain.UserUsecase, authUtil domain.AuthUtil) domain.AuthMiddleware {
+func NewAuthMiddleware(env *domain.Env, userUsecase domain.UserUsecase, cartUsecase domain.CartUsecase, roleUsecase domain.RoleUsecase, authUtil domain.AuthUtil, jwtUtil domain.JWTUtil) domain.AuthMiddleware {
 	return &baseAuthMiddleware{
+		env:         env,
 		userUsecase: userUsecase,
+		cartUsecase: cartUsecase,
+		roleUsecase: roleUsecase,
 		authUtil:    authUtil,
+		jwtUtil:     jwtUtil,
 	}
 }
 
+// verifyToken only accepts access tokens of the auth provider in use, tokens signed with ACCESS_TOKEN_SECRET are
+// rejected unless the provider is local. The issue time is only returned for our own tokens, Firebase checks
+// revocation of its ID tokens itself.
+func (b *baseAuthMiddleware) verifyToken(ctx context.Context, token string) (string, time.Time, error) {
+	if b.env.AuthProvider != domain.AuthProviderLocal {
+		authUID, err := b.authUtil.VerifyToken(ctx, token)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		return authUID, time.Time{}, nil
+	}
+
+	claims, err := b.jwtUtil.ParseClaims(token, true)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	// Without an issue time a token can't be checked against the revocation time
+	if claims.IssuedAt == nil {
+		return "", time.Time{}, errors.New("token has no issue time")
+	}
+
+	return claims.UserUID, claims.IssuedAt.Time, nil
+}
+
+// authenticate resolves the user of the bearer token, sets it in context and merges the guest cart
+// sent along with it into the cart of the user.
+func (b *baseAuthMiddleware) authenticate(c echo.Context) *response_util.Response {
+	bearerToken := c.Request().Header.Get("authorization")
+	if !strings.HasPrefix(bearerToken, "Bearer ") {
+		return response_util.FromError(domain.ErrInvalidAccessToken)
+	}
+
+	token := strings.Split(bearerToken, " ")[1]
+	firebaseUID, issuedAt, err := b.verifyToken(c.Request().Context(), token)
+	if err != nil {
+		return response_util.FromError(domain.ErrInvalidAccessToken)
+	}
+	user, err := b.userUsecase.GetUserByFirebaseUID(c.Request().Context(), firebaseUID)
+	if err != nil {
+		return response_util.FromInternalServerError()
+	}
+	if user == nil {
+		return response_util.FromError(domain.ErrAccessDenied)
+	}
+	// The issue time is truncated to seconds, so tokens issued in the same second as the revocation are rejected too
+	if b.env.AuthProvider == domain.AuthProviderLocal && user.TokensRevokedAt.Valid && !issuedAt.After(user.TokensRevokedAt.Time) {
+		return response_util.FromError(domain.ErrAccessTokenRevoked)
+	}
+	c.Set("user", user)
+
+	cartToken := c.Request().Header.Get(domain.CartTokenHeader)
+	if cartToken != "" {
+		err = b.cartUsecase.MergeGuestCart(c.Request().Context(), cartToken, user.ID)
+		if err != nil {
+			return response_util.FromInternalServerError()
+		}
+	}
+
+	return nil
+}
+
 func (b *baseAuthMiddleware) ValidateUser() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			bearerToken := c.Request().Header.Get("authorization")
-			if !strings.HasPrefix(bearerToken, "Bearer ") {
-				return response_util.FromForbiddenError(errors.New("invalid access token")).WithEcho(c)
+			res := b.authenticate(c)
+			if res != nil {
+				return res.WithEcho(c)
 			}
 
-			token := strings.Split(bearerToken, " ")[1]
-			firebaseUID, err := b.authUtil.VerifyToken(token)
-			if err != nil {
-				return response_util.FromForbiddenError(err).WithEcho(c)
-			}
-			user, err := b.userUsecase.GetUserByFirebaseUID(c.Request().Context(), firebaseUID)
-			if user == nil {
-				return response_util.FromForbiddenError(errors.New("access denied")).WithEcho(c)
+			return next(c)
+		}
+	}
+}
+
+// ValidateOptionalUser lets requests without an access token through as guests.
+func (b *baseAuthMiddleware) ValidateOptionalUser() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get("authorization") == "" {
+				return next(c)
 			}
-			if err != nil {
-				return response_util.FromInternalServerError().WithEcho(c)
+
+			res := b.authenticate(c)
+			if res != nil {
+				return res.WithEcho(c)
 			}
-			c.Set("user", user)
 
 			return next(c)
 		}
@@ -53,12 +129,12 @@ func (b *baseAuthMiddleware) ValidateAdmin() echo.MiddlewareFunc {
 		return func(c echo.Context) error {
 			user, ok := c.Get("user").(*domain.UserModel)
 			if !ok || user == nil {
-				return response_util.FromForbiddenError(errors.New("access denied")).WithEcho(c)
+				return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
 			}
 
 			admin, err := b.userUsecase.GetAdminByUserID(c.Request().Context(), user.ID)
 			if admin == nil {
-				return response_util.FromForbiddenError(errors.New("access denied")).WithEcho(c)
+				return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
 			}
 			if err != nil {
 				return response_util.FromInternalServerError().WithEcho(c)
@@ -69,3 +145,25 @@ func (b *baseAuthMiddleware) ValidateAdmin() echo.MiddlewareFunc {
 		}
 	}
 }
+
+// RequirePermission must run after ValidateUser, it allows the request if any role of the user grants the permission.
+func (b *baseAuthMiddleware) RequirePermission(permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := c.Get("user").(*domain.UserModel)
+			if !ok || user == nil {
+				return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+			}
+
+			hasPermission, err := b.roleUsecase.HasPermission(c.Request().Context(), user.ID, permission)
+			if err != nil {
+				return response_util.FromInternalServerError().WithEcho(c)
+			}
+			if !hasPermission {
+				return response_util.FromError(domain.ErrAccessDenied).WithEcho(c)
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils/response_util"
+)
+
+// NewHTTPErrorHandler renders errors returned by handlers and middlewares as a response_util.Response.
+// Server errors are logged, their message is replaced by the status text in production.
+func NewHTTPErrorHandler(env *domain.Env, loggerUtil domain.LoggerUtil) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		var res *response_util.Response
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			res = response_util.FromHTTPError(httpErr)
+		} else {
+			res = response_util.FromError(err)
+		}
+		if res.Code >= http.StatusInternalServerError {
+			loggerUtil.Errorf("%s %s: %v", c.Request().Method, c.Request().URL.Path, err)
+			if env.IsProduction() {
+				res.Error = http.StatusText(res.Code)
+			}
+		}
+
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(res.Code)
+		} else {
+			err = c.JSON(res.Code, res)
+		}
+		if err != nil {
+			loggerUtil.Errorf("failed to write error response: %v", err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewAccountRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, accountUsecase domain.AccountUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewAccountController(env, loggerUtil, accountUsecase)
+
+	meGroup := rootGroup.Group("/v1/me")
+	meGroup.Use(authMiddleware.ValidateUser())
+	meGroup.DELETE("", ct.DeleteAccount)
+	meGroup.GET("/export", ct.ExportData)
+}
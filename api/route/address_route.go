@@ -0,0 +1,21 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewAddressRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, addressUsecase domain.AddressUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewAddressController(env, loggerUtil, addressUsecase, validate)
+
+	addressGroup := rootGroup.Group("/v1/me/addresses")
+	addressGroup.Use(authMiddleware.ValidateUser())
+	addressGroup.GET("", ct.ListAddresses)
+	addressGroup.POST("", ct.CreateAddress)
+	addressGroup.GET("/:uid", ct.GetAddress)
+	addressGroup.PUT("/:uid", ct.UpdateAddress)
+	addressGroup.PUT("/:uid/default", ct.SetDefaultAddress)
+	addressGroup.DELETE("/:uid", ct.DeleteAddress)
+}
@@ -0,0 +1,18 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewAdminRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, adminUsecase domain.AdminUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewAdminController(env, loggerUtil, adminUsecase, validate)
+
+	adminGroup := rootGroup.Group("/v1/admin/admins")
+	adminGroup.Use(authMiddleware.ValidateUser(), authMiddleware.RequirePermission(domain.PermissionAdminManage))
+	adminGroup.GET("", ct.ListAdmins)
+	adminGroup.POST("", ct.GrantAdmin)
+	adminGroup.DELETE("/:uid", ct.RevokeAdmin)
+}
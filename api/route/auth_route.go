@@ -16,4 +16,12 @@ func NewAuthRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *ech
 
 	publicGroup.POST("/signup", ct.SignUp)
 	publicGroup.POST("/token", ct.GetAccessToken)
+	publicGroup.POST("/refresh", ct.RefreshToken)
+	publicGroup.POST("/password/forgot", ct.ForgotPassword)
+	publicGroup.POST("/password/reset", ct.ResetPassword)
+	publicGroup.POST("/email/verify", ct.VerifyEmail)
+	privateGroup.POST("/logout", ct.Logout)
+	privateGroup.POST("/logout-all", ct.LogoutAll)
+	privateGroup.GET("/sessions", ct.ListSessions)
+	privateGroup.POST("/email/verification", ct.SendEmailVerification)
 }
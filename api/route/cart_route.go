@@ -0,0 +1,22 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewCartRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, cartUsecase domain.CartUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewCartController(env, loggerUtil, cartUsecase, validate)
+
+	// Guests use the cart through the cart token header, users through their access token.
+	publicGroup := rootGroup.Group("/v1/cart")
+	publicGroup.Use(authMiddleware.ValidateOptionalUser())
+
+	publicGroup.POST("/guest", ct.CreateGuestCart)
+	publicGroup.GET("", ct.GetCartByUserID)
+	publicGroup.POST("/items", ct.CreateCartItem)
+	publicGroup.PATCH("/items/:uid", ct.UpdateCartItem)
+	publicGroup.DELETE("/items/:uid", ct.DeleteCartItemByUID)
+}
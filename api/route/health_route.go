@@ -0,0 +1,14 @@
+package route
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewHealthRouter(e *echo.Echo, lifecycle domain.Lifecycle, healthUsecase domain.HealthUsecase) {
+	ct := controller.NewHealthController(lifecycle, healthUsecase)
+
+	e.GET("/healthz", ct.Liveness)
+	e.GET("/readyz", ct.Readiness)
+}
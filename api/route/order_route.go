@@ -0,0 +1,28 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewOrderRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, orderUsecase domain.OrderUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewOrderController(env, loggerUtil, orderUsecase, validate)
+
+	checkoutGroup := rootGroup.Group("/v1/checkout")
+	checkoutGroup.Use(authMiddleware.ValidateUser())
+	checkoutGroup.POST("", ct.Checkout)
+
+	privateGroup := rootGroup.Group("/v1/orders")
+	privateGroup.Use(authMiddleware.ValidateUser())
+	privateGroup.GET("", ct.ListOrders)
+	privateGroup.GET("/:uid", ct.GetOrderByUID)
+	privateGroup.POST("/:uid/cancel", ct.CancelOrder)
+
+	adminGroup := rootGroup.Group("/v1/admin/orders")
+	adminGroup.Use(authMiddleware.ValidateUser())
+	adminGroup.GET("", ct.AdminListOrders, authMiddleware.RequirePermission(domain.PermissionOrderRead))
+	adminGroup.GET("/:uid", ct.AdminGetOrderByUID, authMiddleware.RequirePermission(domain.PermissionOrderRead))
+	adminGroup.PATCH("/:uid/status", ct.AdminUpdateOrderStatus, authMiddleware.RequirePermission(domain.PermissionOrderWrite))
+}
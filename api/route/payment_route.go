@@ -0,0 +1,27 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewPaymentRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, paymentUsecase domain.PaymentUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewPaymentController(env, loggerUtil, paymentUsecase, validate)
+
+	publicGroup := rootGroup.Group("/v1/payments")
+	publicGroup.POST("/webhook", ct.HandleWebhook)
+	if env.PaymentGateway == domain.PaymentGatewaySimulator && !env.IsProduction() {
+		publicGroup.POST("/simulator/:transaction_id", ct.SimulatePayment)
+	}
+
+	privateGroup := rootGroup.Group("/v1/orders")
+	privateGroup.Use(authMiddleware.ValidateUser())
+	privateGroup.POST("/:uid/payments", ct.CreatePayment)
+	privateGroup.GET("/:uid/payments", ct.GetPaymentByOrderUID)
+
+	adminGroup := rootGroup.Group("/v1/admin/orders")
+	adminGroup.Use(authMiddleware.ValidateUser(), authMiddleware.RequirePermission(domain.PermissionPaymentRefund))
+	adminGroup.POST("/:uid/refund", ct.AdminRefundPayment)
+}
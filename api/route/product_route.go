@@ -0,0 +1,22 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewProductRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, productUsecase domain.ProductUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewProductController(env, loggerUtil, productUsecase, validate)
+
+	publicGroup := rootGroup.Group("/v1/products")
+	adminGroup := rootGroup.Group("/v1/products")
+	adminGroup.Use(authMiddleware.ValidateUser(), authMiddleware.RequirePermission(domain.PermissionProductWrite))
+
+	publicGroup.GET("", ct.List)
+	publicGroup.GET("/:uid", ct.GetByUID)
+	adminGroup.POST("", ct.Create)
+	adminGroup.PUT("/:uid", ct.UpdateByUID)
+	adminGroup.DELETE("/:uid", ct.DeleteByUID)
+}
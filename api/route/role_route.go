@@ -0,0 +1,22 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewRoleRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, roleUsecase domain.RoleUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewRoleController(env, loggerUtil, roleUsecase, validate)
+
+	roleGroup := rootGroup.Group("/v1/admin/roles")
+	roleGroup.Use(authMiddleware.ValidateUser(), authMiddleware.RequirePermission(domain.PermissionRoleManage))
+	roleGroup.GET("", ct.ListRoles)
+
+	userRoleGroup := rootGroup.Group("/v1/admin/users/:uid/roles")
+	userRoleGroup.Use(authMiddleware.ValidateUser(), authMiddleware.RequirePermission(domain.PermissionRoleManage))
+	userRoleGroup.GET("", ct.ListUserRoles)
+	userRoleGroup.POST("", ct.AssignRole)
+	userRoleGroup.DELETE("/:role", ct.RemoveRole)
+}
@@ -1,11 +1,14 @@
 package route
 
 import (
+	"time"
+
 	"firebase.google.com/go/v4/auth"
 	"github.com/go-playground/validator/v10"
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
 	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/worker"
 
 	"github.com/rizkyzhang/ayobeli-backend-golang/api/middleware"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
@@ -13,15 +16,81 @@ import (
 	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
 )
 
-func Setup(env *domain.Env, loggerUtil domain.LoggerUtil, db *sqlx.DB, firebaseAuth *auth.Client, e *echo.Echo) {
-	authUtil := utils.NewAuthUtil(env, firebaseAuth)
+func Setup(env *domain.Env, loggerUtil domain.LoggerUtil, db *sqlx.DB, firebaseAuth *auth.Client, lifecycle domain.Lifecycle, e *echo.Echo) {
+	hashUtil := utils.NewHashUtil()
+	jwtUtil := utils.NewJWTUtil([]byte(env.AccessTokenSecret), []byte(env.RefreshTokenSecret), env.AccessTokenExpiryHour, env.RefreshTokenExpiryHour)
+	aesEncryptUtil := utils.NewAesEncrypt(env.AesSecret)
+	productUtil := utils.NewProductUtil()
+	paymentGateway := utils.NewPaymentGateway(env)
+	cartUtil := utils.NewCartUtil(productUtil)
+	mailerUtil := utils.NewMailerUtil(env, loggerUtil)
+	storageDir := env.StorageDir
+	if storageDir == "" {
+		storageDir = "uploads"
+	}
+	storageBaseURL := env.StorageBaseURL
+	if storageBaseURL == "" {
+		storageBaseURL = "/uploads"
+	}
+	storageUtil := utils.NewLocalStorageUtil(storageDir, storageBaseURL)
+	migrationsDir := env.MigrationsDir
+	if migrationsDir == "" {
+		migrationsDir = "migrations"
+	}
+	txManager := repository.NewTxManager(db)
 	userRepo := repository.NewUserRepository(db)
-	authUsecase := usecase.NewAuthUsecase(env, userRepo, authUtil)
-	userUsecase := usecase.NewUserUsecase(env, userRepo)
-	authMiddleware := middleware.NewAuthMiddleware(userUsecase, authUtil)
+	credentialRepo := repository.NewCredentialRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	actionTokenRepo := repository.NewActionTokenRepository(db)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(env, db)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	addressRepo := repository.NewAddressRepository(db)
+	productRepo := repository.NewProductRepository(db, productUtil)
+	cartRepo := repository.NewCartRepository(db, productUtil)
+	orderRepo := repository.NewOrderRepository(db, productUtil)
+	paymentRepo := repository.NewPaymentRepository(db)
+	dataExportRepo := repository.NewDataExportRepository(db)
+	healthRepo := repository.NewHealthRepository(db, migrationsDir)
+	// firebaseAuth is nil unless AUTH_PROVIDER is firebase, see bootstrap.App.
+	authUtil := utils.NewAuthUtil(env, firebaseAuth, credentialRepo, actionTokenRepo, hashUtil, jwtUtil)
+	authUsecase := usecase.NewAuthUsecase(env, loggerUtil, txManager, userRepo, cartRepo, refreshTokenRepo, loginAttemptRepo, authUtil, hashUtil, jwtUtil, mailerUtil)
+	userUsecase := usecase.NewUserUsecase(env, loggerUtil, userRepo, authUtil, storageUtil)
+	addressUsecase := usecase.NewAddressUsecase(addressRepo)
+	adminUsecase := usecase.NewAdminUsecase(txManager, userRepo, roleRepo, auditLogRepo)
+	roleUsecase := usecase.NewRoleUsecase(txManager, roleRepo, userRepo, auditLogRepo)
+	productUsecase := usecase.NewProductUsecase(productRepo, aesEncryptUtil, productUtil)
+	cartUsecase := usecase.NewCartUsecase(env, cartRepo, cartUtil, aesEncryptUtil)
+	orderUsecase := usecase.NewOrderUsecase(orderRepo, cartRepo)
+	paymentUsecase := usecase.NewPaymentUsecase(txManager, paymentRepo, orderRepo, paymentGateway)
+	accountUsecase := usecase.NewAccountUsecase(loggerUtil, txManager, userRepo, addressRepo, cartRepo, orderRepo, dataExportRepo, auditLogRepo, authUtil)
+	healthUsecase := usecase.NewHealthUsecase(env, loggerUtil, healthRepo, authUtil)
+	authMiddleware := middleware.NewAuthMiddleware(env, userUsecase, cartUsecase, roleUsecase, authUtil, jwtUtil)
 	validate := validator.New()
 
+	// Uploaded files are served by the API itself unless STORAGE_BASE_URL points elsewhere
+	if env.StorageBaseURL == "" {
+		e.Static("/uploads", storageDir)
+	}
+
+	// Exports and auth provider deletions of deleted accounts are finished in the background
+	lifecycle.Register(
+		worker.NewDataExportBuilder(accountUsecase, loggerUtil, 5*time.Second),
+		worker.NewAuthUserDeleter(accountUsecase, loggerUtil, time.Minute),
+	)
+
+	NewHealthRouter(e, lifecycle, healthUsecase)
+
 	rootGroup := e.Group("/api")
 
 	NewAuthRouter(env, loggerUtil, rootGroup, authUsecase, authMiddleware, validate)
+	NewUserRouter(env, loggerUtil, rootGroup, userUsecase, authMiddleware, validate)
+	NewAccountRouter(env, loggerUtil, rootGroup, accountUsecase, authMiddleware, validate)
+	NewAddressRouter(env, loggerUtil, rootGroup, addressUsecase, authMiddleware, validate)
+	NewAdminRouter(env, loggerUtil, rootGroup, adminUsecase, authMiddleware, validate)
+	NewRoleRouter(env, loggerUtil, rootGroup, roleUsecase, authMiddleware, validate)
+	NewProductRouter(env, loggerUtil, rootGroup, productUsecase, authMiddleware, validate)
+	NewCartRouter(env, loggerUtil, rootGroup, cartUsecase, authMiddleware, validate)
+	NewOrderRouter(env, loggerUtil, rootGroup, orderUsecase, authMiddleware, validate)
+	NewPaymentRouter(env, loggerUtil, rootGroup, paymentUsecase, authMiddleware, validate)
 }
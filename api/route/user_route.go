@@ -0,0 +1,18 @@
+package route
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/api/controller"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+func NewUserRouter(env *domain.Env, loggerUtil domain.LoggerUtil, rootGroup *echo.Group, userUsecase domain.UserUsecase, authMiddleware domain.AuthMiddleware, validate *validator.Validate) {
+	ct := controller.NewUserController(env, loggerUtil, userUsecase, validate)
+
+	meGroup := rootGroup.Group("/v1/me")
+	meGroup.Use(authMiddleware.ValidateUser())
+	meGroup.GET("", ct.GetProfile)
+	meGroup.PATCH("", ct.UpdateProfile)
+	meGroup.PUT("/profile-image", ct.UploadProfileImage)
+}
@@ -11,13 +11,17 @@ type Application struct {
 	Env          *domain.Env
 	DB           *sqlx.DB
 	FirebaseAuth *auth.Client
+	Lifecycle    domain.Lifecycle
 }
 
 func App() Application {
 	app := &Application{}
 	app.Env = utils.LoadConfig(".env")
 	app.DB = NewPostgresDB(app.Env)
-	app.FirebaseAuth = NewFirebaseAuth(app.Env)
+	if app.Env.AuthProvider != domain.AuthProviderLocal {
+		app.FirebaseAuth = NewFirebaseAuth(app.Env)
+	}
+	app.Lifecycle = NewLifecycle()
 	return *app
 }
 
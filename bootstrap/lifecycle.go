@@ -0,0 +1,84 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type runningWorker struct {
+	worker domain.Worker
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type baseLifecycle struct {
+	mu       sync.Mutex
+	workers  []domain.Worker
+	running  []*runningWorker
+	draining atomic.Bool
+}
+
+func NewLifecycle() domain.Lifecycle {
+	return &baseLifecycle{}
+}
+
+func (b *baseLifecycle) Register(workers ...domain.Worker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.workers = append(b.workers, workers...)
+}
+
+// Start runs each registered worker in its own goroutine, it must be called once.
+func (b *baseLifecycle) Start(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, worker := range b.workers {
+		workerCtx, cancel := context.WithCancel(ctx)
+		running := &runningWorker{worker: worker, cancel: cancel, done: make(chan struct{})}
+		go func() {
+			defer close(running.done)
+			running.worker.Run(workerCtx)
+		}()
+
+		b.running = append(b.running, running)
+		log.Printf("Worker %s started", worker.Name())
+	}
+}
+
+func (b *baseLifecycle) Drain() {
+	b.draining.Store(true)
+}
+
+func (b *baseLifecycle) Stop(ctx context.Context) error {
+	b.Drain()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Workers are stopped in reverse order so a worker never outlives the ones started before it.
+	for len(b.running) > 0 {
+		running := b.running[len(b.running)-1]
+		running.cancel()
+		select {
+		case <-running.done:
+			log.Printf("Worker %s stopped", running.worker.Name())
+		case <-ctx.Done():
+			return fmt.Errorf("worker %s did not stop: %w", running.worker.Name(), ctx.Err())
+		}
+
+		b.running = b.running[:len(b.running)-1]
+	}
+
+	return nil
+}
+
+func (b *baseLifecycle) Ready() bool {
+	return !b.draining.Load()
+}
@@ -0,0 +1,36 @@
+// Command create-admin grants the admin role to an existing user. It only works while there is no admin
+// yet, further admins are granted through POST /api/v1/admin/admins.
+//
+//	go run ./cmd/create-admin -email admin@example.com
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/bootstrap"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+)
+
+func main() {
+	email := flag.String("email", "", "email of the user, sign up through POST /api/v1/auth/signup first")
+	flag.Parse()
+	if *email == "" {
+		log.Fatal("-email is required")
+	}
+
+	env := utils.LoadConfig(".env")
+	db := bootstrap.NewPostgresDB(env)
+	defer bootstrap.ClosePostgresDBConnection(db)
+
+	adminUsecase := usecase.NewAdminUsecase(repository.NewTxManager(db), repository.NewUserRepository(db), repository.NewRoleRepository(db), repository.NewAuditLogRepository(db))
+	UID, err := adminUsecase.CreateFirstAdmin(context.Background(), *email)
+	if err != nil {
+		log.Fatalf("Failed to create admin: %v", err)
+	}
+
+	log.Printf("Created admin %s for %s", UID, *email)
+}
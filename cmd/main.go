@@ -1,13 +1,24 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	apimiddleware "github.com/rizkyzhang/ayobeli-backend-golang/api/middleware"
 	route "github.com/rizkyzhang/ayobeli-backend-golang/api/route"
 	"github.com/rizkyzhang/ayobeli-backend-golang/bootstrap"
 	docs "github.com/rizkyzhang/ayobeli-backend-golang/docs"
 	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/worker"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
 	echoSwagger "github.com/swaggo/echo-swagger"
 )
 
@@ -23,7 +34,7 @@ import (
 //	@securityDefinitions.apikey	ApiKeyAuth
 //	@in							header
 //	@name						Authorization
-//	@description				Firebase auth access token, get it from POST /auth/access-token
+//	@description				Access token, get it from POST /auth/token
 
 func main() {
 	app := bootstrap.App()
@@ -35,6 +46,8 @@ func main() {
 	docs.SwaggerInfo.Host = env.Host
 
 	e := echo.New()
+	e.HTTPErrorHandler = apimiddleware.NewHTTPErrorHandler(env, loggerUtil)
+	e.IPExtractor = utils.NewIPExtractor(env)
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
 		LogURI:        true,
 		LogError:      true,
@@ -42,10 +55,59 @@ func main() {
 		LogStatus:     true,
 		LogValuesFunc: loggerUtil.EchoMiddlewareFunc(),
 	}))
+	// Queries of a request are cancelled once CONTEXT_TIMEOUT seconds have passed or the client goes away
+	if env.ContextTimeout > 0 {
+		e.Use(middleware.ContextTimeout(time.Duration(env.ContextTimeout) * time.Second))
+	}
+
+	route.Setup(env, loggerUtil, db, firebaseAuth, app.Lifecycle, e)
 
-	route.Setup(env, loggerUtil, db, firebaseAuth, e)
+	// Expired reservations are released by the sweeper, so it only runs when reservation is enabled.
+	cartRepo := repository.NewCartRepository(db, utils.NewProductUtil())
+	if env.CartReservationTTLMinutes > 0 {
+		app.Lifecycle.Register(worker.NewReservationSweeper(cartRepo, loggerUtil, time.Minute))
+	}
+	if env.GuestCartMaxAgeHours > 0 {
+		app.Lifecycle.Register(worker.NewGuestCartPurger(cartRepo, loggerUtil, time.Duration(env.GuestCartMaxAgeHours)*time.Hour, time.Hour))
+	}
 
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
-	e.Logger.Fatal(e.Start(env.Port))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Workers must not see the shutdown signal, they keep running until Lifecycle.Stop cancels them in order.
+	app.Lifecycle.Start(context.Background())
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- e.Start(env.Port)
+	}()
+
+	select {
+	case <-ctx.Done():
+		loggerUtil.Infof("shutdown signal received")
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			loggerUtil.Errorf("server stopped: %v", err)
+		}
+	}
+	stop()
+
+	// The instance stops being ready first, SHUTDOWN_DELAY gives the orchestrator time to notice before the
+	// listener closes. In-flight requests and workers then get SHUTDOWN_TIMEOUT seconds to finish.
+	app.Lifecycle.Drain()
+	time.Sleep(time.Duration(env.ShutdownDelay) * time.Second)
+
+	shutdownTimeout := 10 * time.Second
+	if env.ShutdownTimeout > 0 {
+		shutdownTimeout = time.Duration(env.ShutdownTimeout) * time.Second
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		loggerUtil.Errorf("failed to drain in-flight requests: %v", err)
+	}
+	if err := app.Lifecycle.Stop(shutdownCtx); err != nil {
+		loggerUtil.Errorf("failed to stop workers: %v", err)
+	}
 }
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/account_usecase_mock.go --fake-name AccountUsecaseMock . AccountUsecase
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/data_export_repository_mock.go --fake-name DataExportRepositoryMock . DataExportRepository
+
+const (
+	DataExportStatusPending = "PENDING"
+	DataExportStatusReady   = "READY"
+	DataExportStatusFailed  = "FAILED"
+
+	// A ready export is served until it is DataExportTTL old, a new one is started afterwards.
+	DataExportTTL = 24 * time.Hour
+	// A pending export older than DataExportMaxPendingAge was abandoned by its builder, it is marked as failed
+	// and a new one is started on the next request.
+	DataExportMaxPendingAge = 30 * time.Minute
+)
+
+// Controller
+type AccountController interface {
+	DeleteAccount(c echo.Context) error
+	ExportData(c echo.Context) error
+}
+
+// AccountControllerResponseDataExport only has an Archive once Status is READY.
+type AccountControllerResponseDataExport struct {
+	UID         string          `json:"uid"`
+	Status      string          `json:"status"`
+	Archive     json.RawMessage `json:"archive,omitempty" swaggertype:"object"`
+	CreatedAt   time.Time       `json:"created_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+}
+
+// AccountDataArchive is the personal data of a user, it is stored as the archive of a data export.
+type AccountDataArchive struct {
+	Profile   *UserModel      `json:"profile"`
+	Addresses []*AddressModel `json:"addresses"`
+	Cart      *CartModel      `json:"cart"`
+	Orders    []*OrderModel   `json:"orders"`
+
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// Usecase
+type AccountUsecase interface {
+	// DeleteAccount deletes the user from the auth provider and anonymises users, orders are kept.
+	DeleteAccount(ctx context.Context, user *UserModel, ipAddress string) error
+	// DeleteQueuedAuthUsers retries the auth provider deletions DeleteAccount could not finish, it is run by the
+	// auth user deleter.
+	DeleteQueuedAuthUsers(ctx context.Context) error
+	// ExportData returns the latest data export of the user, a new one is queued for BuildPendingDataExports
+	// when there is none, it failed or it expired.
+	ExportData(ctx context.Context, user *UserModel, ipAddress string) (*AccountControllerResponseDataExport, error)
+	// BuildPendingDataExports builds the queued data exports one by one, it is run by the data export builder.
+	BuildPendingDataExports(ctx context.Context) error
+}
+
+// Repository
+type DataExportModel struct {
+	ID          int          `db:"id" json:"id"`
+	UID         string       `db:"uid" json:"uid"`
+	UserID      int          `db:"user_id" json:"user_id"`
+	Status      string       `db:"status" json:"status"`
+	Archive     []byte       `db:"archive" json:"archive"`
+	Error       string       `db:"error" json:"error"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	CompletedAt sql.NullTime `db:"completed_at" json:"completed_at"`
+}
+
+type DataExportRepository interface {
+	CreateDataExport(ctx context.Context, dataExportPayload *DataExportRepositoryPayloadCreateDataExport) error
+	GetLatestDataExportByUserID(ctx context.Context, userID int) (*DataExportModel, error)
+	// ListPendingDataExports returns the pending exports created after createdAfter, oldest first.
+	ListPendingDataExports(ctx context.Context, createdAfter time.Time) ([]*DataExportModel, error)
+	// FailPendingDataExports marks the pending exports created before createdBefore as failed.
+	FailPendingDataExports(ctx context.Context, createdBefore time.Time, errMessage string, completedAt time.Time) (int64, error)
+	CompleteDataExport(ctx context.Context, UID string, archive []byte, completedAt time.Time) error
+	FailDataExport(ctx context.Context, UID, errMessage string, completedAt time.Time) error
+}
+
+type DataExportRepositoryPayloadCreateDataExport struct {
+	UID       string    `db:"uid" json:"uid"`
+	UserID    int       `db:"user_id" json:"user_id"`
+	Status    string    `db:"status" json:"status"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
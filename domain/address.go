@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/address_usecase_mock.go --fake-name AddressUsecaseMock . AddressUsecase
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/address_repository_mock.go --fake-name AddressRepositoryMock . AddressRepository
+
+var ErrAddressNotFound = NewNotFoundError("ADDRESS_NOT_FOUND", "address not found")
+
+// Controller
+type AddressController interface {
+	ListAddresses(c echo.Context) error
+	GetAddress(c echo.Context) error
+	CreateAddress(c echo.Context) error
+	UpdateAddress(c echo.Context) error
+	SetDefaultAddress(c echo.Context) error
+	DeleteAddress(c echo.Context) error
+}
+
+// AddressControllerPayloadCreateAddress uses the Indonesian administrative divisions,
+// City is either a kota or a kabupaten, District is a kecamatan and SubDistrict a kelurahan or desa.
+type AddressControllerPayloadCreateAddress struct {
+	Label         string `json:"label" validate:"max=50"`
+	RecipientName string `json:"recipient_name" validate:"required,max=100"`
+	Phone         string `json:"phone" validate:"required"`
+	Street        string `json:"street" validate:"required,max=255"`
+	Province      string `json:"province" validate:"required,max=100"`
+	City          string `json:"city" validate:"required,max=100"`
+	District      string `json:"district" validate:"required,max=100"`
+	SubDistrict   string `json:"sub_district" validate:"required,max=100"`
+	PostalCode    string `json:"postal_code" validate:"required,numeric,len=5"`
+	IsDefault     bool   `json:"is_default"`
+}
+
+type AddressControllerPayloadUpdateAddress struct {
+	Label         string `json:"label" validate:"max=50"`
+	RecipientName string `json:"recipient_name" validate:"required,max=100"`
+	Phone         string `json:"phone" validate:"required"`
+	Street        string `json:"street" validate:"required,max=255"`
+	Province      string `json:"province" validate:"required,max=100"`
+	City          string `json:"city" validate:"required,max=100"`
+	District      string `json:"district" validate:"required,max=100"`
+	SubDistrict   string `json:"sub_district" validate:"required,max=100"`
+	PostalCode    string `json:"postal_code" validate:"required,numeric,len=5"`
+}
+
+type AddressControllerResponseAddress struct {
+	UID           string `json:"uid"`
+	Label         string `json:"label"`
+	RecipientName string `json:"recipient_name"`
+	Phone         string `json:"phone"`
+	Street        string `json:"street"`
+	Province      string `json:"province"`
+	City          string `json:"city"`
+	District      string `json:"district"`
+	SubDistrict   string `json:"sub_district"`
+	PostalCode    string `json:"postal_code"`
+	IsDefault     bool   `json:"is_default"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Usecase
+// Every method is scoped to userID, addresses of other users are reported as ErrAddressNotFound.
+type AddressUsecase interface {
+	ListAddresses(ctx context.Context, userID int) ([]*AddressControllerResponseAddress, error)
+	GetAddress(ctx context.Context, userID int, UID string) (*AddressControllerResponseAddress, error)
+	CreateAddress(ctx context.Context, userID int, payload *AddressControllerPayloadCreateAddress) (*AddressControllerResponseAddress, error)
+	UpdateAddress(ctx context.Context, userID int, UID string, payload *AddressControllerPayloadUpdateAddress) (*AddressControllerResponseAddress, error)
+	SetDefaultAddress(ctx context.Context, userID int, UID string) error
+	DeleteAddress(ctx context.Context, userID int, UID string) error
+}
+
+// Repository
+type AddressModel struct {
+	ID            int    `db:"id" json:"id"`
+	UID           string `db:"uid" json:"uid"`
+	UserID        int    `db:"user_id" json:"user_id"`
+	Label         string `db:"label" json:"label"`
+	RecipientName string `db:"recipient_name" json:"recipient_name"`
+	Phone         string `db:"phone" json:"phone"`
+	Street        string `db:"street" json:"street"`
+	Province      string `db:"province" json:"province"`
+	City          string `db:"city" json:"city"`
+	District      string `db:"district" json:"district"`
+	SubDistrict   string `db:"sub_district" json:"sub_district"`
+	PostalCode    string `db:"postal_code" json:"postal_code"`
+	IsDefault     bool   `db:"is_default" json:"is_default"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type AddressRepository interface {
+	CreateAddress(ctx context.Context, addressPayload *AddressRepositoryPayloadCreateAddress) error
+	ListAddressesByUserID(ctx context.Context, userID int) ([]*AddressModel, error)
+	GetAddressByUID(ctx context.Context, userID int, UID string) (*AddressModel, error)
+	UpdateAddress(ctx context.Context, addressPayload *AddressRepositoryPayloadUpdateAddress) error
+	SetDefaultAddress(ctx context.Context, userID int, UID string, updatedAt time.Time) error
+	DeleteAddress(ctx context.Context, userID int, UID string, updatedAt time.Time) error
+}
+
+// AddressRepositoryPayloadCreateAddress clears the previous default address of the user when IsDefault is set.
+type AddressRepositoryPayloadCreateAddress struct {
+	UID           string `db:"uid" json:"uid"`
+	UserID        int    `db:"user_id" json:"user_id"`
+	Label         string `db:"label" json:"label"`
+	RecipientName string `db:"recipient_name" json:"recipient_name"`
+	Phone         string `db:"phone" json:"phone"`
+	Street        string `db:"street" json:"street"`
+	Province      string `db:"province" json:"province"`
+	City          string `db:"city" json:"city"`
+	District      string `db:"district" json:"district"`
+	SubDistrict   string `db:"sub_district" json:"sub_district"`
+	PostalCode    string `db:"postal_code" json:"postal_code"`
+	IsDefault     bool   `db:"is_default" json:"is_default"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type AddressRepositoryPayloadUpdateAddress struct {
+	UID           string `db:"uid" json:"uid"`
+	UserID        int    `db:"user_id" json:"user_id"`
+	Label         string `db:"label" json:"label"`
+	RecipientName string `db:"recipient_name" json:"recipient_name"`
+	Phone         string `db:"phone" json:"phone"`
+	Street        string `db:"street" json:"street"`
+	Province      string `db:"province" json:"province"`
+	City          string `db:"city" json:"city"`
+	District      string `db:"district" json:"district"`
+	SubDistrict   string `db:"sub_district" json:"sub_district"`
+	PostalCode    string `db:"postal_code" json:"postal_code"`
+
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
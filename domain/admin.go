@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/admin_usecase_mock.go --fake-name AdminUsecaseMock . AdminUsecase
+
+var (
+	ErrUserNotFound      = NewNotFoundError("USER_NOT_FOUND", "user not found")
+	ErrAdminNotFound     = NewNotFoundError("ADMIN_NOT_FOUND", "admin not found")
+	ErrAdminAlreadyExist = NewConflictError("ADMIN_ALREADY_EXIST", "admin already exist")
+	ErrLastAdmin         = NewConflictError("LAST_ADMIN", "cannot revoke the last admin")
+	ErrFirstAdminCreated = NewConflictError("FIRST_ADMIN_CREATED", "first admin has already been created")
+)
+
+// Controller
+type AdminController interface {
+	ListAdmins(c echo.Context) error
+	GrantAdmin(c echo.Context) error
+	RevokeAdmin(c echo.Context) error
+}
+
+type AdminControllerPayloadGrantAdmin struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type AdminControllerResponseAdmin struct {
+	UID   string `json:"uid"`
+	Email string `json:"email"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Usecase
+type AdminUsecase interface {
+	ListAdmins(ctx context.Context) ([]*AdminControllerResponseAdmin, error)
+	GrantAdmin(ctx context.Context, payload *AdminUsecasePayloadGrantAdmin) (string, error)
+	RevokeAdmin(ctx context.Context, payload *AdminUsecasePayloadRevokeAdmin) error
+	CreateFirstAdmin(ctx context.Context, email string) (string, error)
+}
+
+// ActorUserID is the user granting the admin role, IPAddress is recorded in the audit log.
+type AdminUsecasePayloadGrantAdmin struct {
+	Email       string
+	ActorUserID int
+	IPAddress   string
+}
+
+type AdminUsecasePayloadRevokeAdmin struct {
+	UID         string
+	ActorUserID int
+	IPAddress   string
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/audit_log_repository_mock.go --fake-name AuditLogRepositoryMock . AuditLogRepository
+
+const (
+	AuditActionAdminGrant    = "admin.grant"
+	AuditActionAdminRevoke   = "admin.revoke"
+	AuditActionRoleAssign    = "role.assign"
+	AuditActionRoleRemove    = "role.remove"
+	AuditActionAccountDelete = "account.delete"
+	AuditActionAccountExport = "account.export"
+)
+
+// Repository
+// AuditLogModel records who did what to whom, ActorUserID is null for actions run from the CLI.
+type AuditLogModel struct {
+	ID           int           `db:"id" json:"id"`
+	UID          string        `db:"uid" json:"uid"`
+	Action       string        `db:"action" json:"action"`
+	ActorUserID  sql.NullInt64 `db:"actor_user_id" json:"actor_user_id"`
+	TargetUserID sql.NullInt64 `db:"target_user_id" json:"target_user_id"`
+	IPAddress    string        `db:"ip_address" json:"ip_address"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+type AuditLogRepository interface {
+	CreateAuditLog(ctx context.Context, auditLogPayload *AuditLogRepositoryPayloadCreateAuditLog) error
+	ListAuditLogsByTargetUserID(ctx context.Context, targetUserID int) ([]*AuditLogModel, error)
+}
+
+type AuditLogRepositoryPayloadCreateAuditLog struct {
+	UID          string        `db:"uid" json:"uid"`
+	Action       string        `db:"action" json:"action"`
+	ActorUserID  sql.NullInt64 `db:"actor_user_id" json:"actor_user_id"`
+	TargetUserID sql.NullInt64 `db:"target_user_id" json:"target_user_id"`
+	IPAddress    string        `db:"ip_address" json:"ip_address"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
@@ -2,27 +2,60 @@ package domain
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/auth_usecase_mock.go --fake-name AuthUsecaseMock . AuthUsecase
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/credential_repository_mock.go --fake-name CredentialRepositoryMock . CredentialRepository
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/refresh_token_repository_mock.go --fake-name RefreshTokenRepositoryMock . RefreshTokenRepository
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/action_token_repository_mock.go --fake-name ActionTokenRepositoryMock . ActionTokenRepository
+
+const (
+	AuthProviderFirebase = "firebase"
+	AuthProviderLocal    = "local"
+
+	// Action modes follow the mode query parameter of Firebase action links, the local provider uses the same format.
+	ActionModeResetPassword = "resetPassword"
+	ActionModeVerifyEmail   = "verifyEmail"
+)
+
+var (
+	ErrAccessDenied         = NewForbiddenError("ACCESS_DENIED", "access denied")
+	ErrInvalidAccessToken   = NewForbiddenError("INVALID_ACCESS_TOKEN", "invalid access token")
+	ErrAccessTokenRevoked   = NewForbiddenError("ACCESS_TOKEN_REVOKED", "token has been revoked")
+	ErrInvalidRefreshToken  = NewUnauthenticatedError("INVALID_REFRESH_TOKEN", "invalid refresh token")
+	ErrRefreshTokenReused   = NewUnauthenticatedError("REFRESH_TOKEN_REUSED", "refresh token has already been used")
+	ErrInvalidActionCode    = NewValidationError("INVALID_ACTION_CODE", "invalid or expired code")
+	ErrEmailAlreadyVerified = NewValidationError("EMAIL_ALREADY_VERIFIED", "email already verified")
+)
 
 // Controller
 type AuthMiddleware interface {
 	ValidateUser() echo.MiddlewareFunc
+	ValidateOptionalUser() echo.MiddlewareFunc
 	ValidateAdmin() echo.MiddlewareFunc
+	RequirePermission(permission string) echo.MiddlewareFunc
 }
 
 type AuthController interface {
 	GetAccessToken(c echo.Context) error
 	SignUp(c echo.Context) error
+	RefreshToken(c echo.Context) error
+	Logout(c echo.Context) error
+	LogoutAll(c echo.Context) error
+	ListSessions(c echo.Context) error
+	ForgotPassword(c echo.Context) error
+	ResetPassword(c echo.Context) error
+	VerifyEmail(c echo.Context) error
+	SendEmailVerification(c echo.Context) error
 }
 
 type AuthControllerPayloadSignUp struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8"`
-	IsAdmin  bool   `json:"is_admin" validate:"required"`
 }
 
 type AuthControllerPayloadGetAccessToken struct {
@@ -30,8 +63,167 @@ type AuthControllerPayloadGetAccessToken struct {
 	Password string `json:"password"  validate:"required,min=8"`
 }
 
+type AuthControllerPayloadRefreshToken struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type AuthControllerPayloadLogout struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type AuthControllerPayloadForgotPassword struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// AuthControllerPayloadResetPassword takes the oobCode query parameter of the emailed link as Code.
+type AuthControllerPayloadResetPassword struct {
+	Code        string `json:"code" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8"`
+}
+
+type AuthControllerPayloadVerifyEmail struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type AuthControllerResponseTokenPair struct {
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type AuthControllerResponseSession struct {
+	UID        string    `json:"uid"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
 // Usecase
 type AuthUsecase interface {
-	SignUp(ctx context.Context, email, password string, isAdmin bool) error
-	GetAccessToken(ctx context.Context, email, password string) (string, error)
+	SignUp(ctx context.Context, email, password string) error
+	GetAccessToken(ctx context.Context, email, password string, client *AuthUsecasePropertyClient) (*AuthControllerResponseTokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string, client *AuthUsecasePropertyClient) (*AuthControllerResponseTokenPair, error)
+	Logout(ctx context.Context, userID int, refreshToken string) error
+	LogoutAll(ctx context.Context, user *UserModel) error
+	ListSessions(ctx context.Context, userID int) ([]*AuthControllerResponseSession, error)
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, code, newPassword string) error
+	VerifyEmail(ctx context.Context, code string) error
+	SendEmailVerification(ctx context.Context, user *UserModel) error
+}
+
+// AuthUsecasePropertyClient describes the device a session is started from.
+type AuthUsecasePropertyClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Repository
+// CredentialModel is only used by the local auth provider, its UID is stored as the user's firebase_uid.
+type CredentialModel struct {
+	ID           int    `db:"id" json:"id"`
+	UID          string `db:"uid" json:"uid"`
+	Email        string `db:"email" json:"email"`
+	PasswordHash string `db:"password_hash" json:"-"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type CredentialRepository interface {
+	CreateCredential(ctx context.Context, credentialPayload *CredentialRepositoryPayloadCreateCredential) error
+	GetCredentialByEmail(ctx context.Context, email string) (*CredentialModel, error)
+	GetCredentialByUID(ctx context.Context, UID string) (*CredentialModel, error)
+	UpdateEmailByUID(ctx context.Context, UID, email string, updatedAt time.Time) error
+	UpdatePasswordByUID(ctx context.Context, UID, passwordHash string, updatedAt time.Time) error
+	DeleteCredentialByUID(ctx context.Context, UID string) error
+}
+
+type CredentialRepositoryPayloadCreateCredential struct {
+	UID          string `db:"uid" json:"uid"`
+	Email        string `db:"email" json:"email"`
+	PasswordHash string `db:"password_hash" json:"-"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// ActionTokenModel tracks the signed reset password and verify email tokens of the local provider,
+// a token can only be used once.
+type ActionTokenModel struct {
+	ID            int          `db:"id" json:"id"`
+	UID           string       `db:"uid" json:"uid"`
+	CredentialUID string       `db:"credential_uid" json:"credential_uid"`
+	Mode          string       `db:"mode" json:"mode"`
+	ExpiresAt     time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt        sql.NullTime `db:"used_at" json:"used_at"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+type ActionTokenRepository interface {
+	CreateActionToken(ctx context.Context, actionTokenPayload *ActionTokenRepositoryPayloadCreateActionToken) error
+	// UseActionToken returns ErrInvalidActionCode given a token that is unknown, expired or already used.
+	UseActionToken(ctx context.Context, UID, mode string, usedAt time.Time) error
+}
+
+type ActionTokenRepositoryPayloadCreateActionToken struct {
+	UID           string    `db:"uid" json:"uid"`
+	CredentialUID string    `db:"credential_uid" json:"credential_uid"`
+	Mode          string    `db:"mode" json:"mode"`
+	ExpiresAt     time.Time `db:"expires_at" json:"expires_at"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// RefreshTokenModel only stores the hash of the token, tokens rotated from the same login share a FamilyUID.
+type RefreshTokenModel struct {
+	ID        int          `db:"id" json:"id"`
+	UID       string       `db:"uid" json:"uid"`
+	FamilyUID string       `db:"family_uid" json:"family_uid"`
+	TokenHash string       `db:"token_hash" json:"-"`
+	ExpiresAt time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at" json:"used_at"`
+	RevokedAt sql.NullTime `db:"revoked_at" json:"revoked_at"`
+	UserAgent string       `db:"user_agent" json:"user_agent"`
+	IPAddress string       `db:"ip_address" json:"ip_address"`
+	UserID    int          `db:"user_id" json:"user_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// SessionModel is the refresh token family of a login, LastSeenAt is the last time it was refreshed.
+type SessionModel struct {
+	FamilyUID  string    `db:"family_uid" json:"family_uid"`
+	UserAgent  string    `db:"user_agent" json:"user_agent"`
+	IPAddress  string    `db:"ip_address" json:"ip_address"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	LastSeenAt time.Time `db:"last_seen_at" json:"last_seen_at"`
+	ExpiresAt  time.Time `db:"expires_at" json:"expires_at"`
+}
+
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, refreshTokenPayload *RefreshTokenRepositoryPayloadCreateRefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshTokenModel, error)
+	RotateRefreshToken(ctx context.Context, usedID int, usedAt time.Time, refreshTokenPayload *RefreshTokenRepositoryPayloadCreateRefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyUID string, revokedAt time.Time) error
+	RevokeRefreshTokensByUserID(ctx context.Context, userID int, revokedAt time.Time) error
+	ListActiveSessionsByUserID(ctx context.Context, userID int, now time.Time) ([]*SessionModel, error)
+}
+
+type RefreshTokenRepositoryPayloadCreateRefreshToken struct {
+	UID       string    `db:"uid" json:"uid"`
+	FamilyUID string    `db:"family_uid" json:"family_uid"`
+	TokenHash string    `db:"token_hash" json:"-"`
+	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
+	UserAgent string    `db:"user_agent" json:"user_agent"`
+	IPAddress string    `db:"ip_address" json:"ip_address"`
+	UserID    int       `db:"user_id" json:"user_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
@@ -2,14 +2,28 @@ package domain
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/cart_usecase_mock.go --fake-name CartUsecaseMock . CartUsecase
+
+// CartTokenHeader carries the token of a guest cart. Sending it together with an access token
+// merges the guest cart into the cart of the user.
+const CartTokenHeader = "X-Cart-Token"
+
+var (
+	ErrCartNotFound     = NewNotFoundError("CART_NOT_FOUND", "cart not found")
+	ErrCartItemNotFound = NewNotFoundError("CART_ITEM_NOT_FOUND", "cart item not found")
+	ErrGuestCartOnly    = NewValidationError("GUEST_CART_ONLY", "guest cart is only available to guests")
+)
+
 // Controller
 type CartController interface {
 	// Cart
+	CreateGuestCart(c echo.Context) error
 	GetCartByUserID(c echo.Context) error
 
 	// Cart item
@@ -19,12 +33,16 @@ type CartController interface {
 }
 
 type CartControllerPayloadCreateCartItem struct {
-	ProductUID string `json:"product_uid"`
-	Quantity   int    `json:"quantity"`
+	ProductUID string `json:"product_uid" validate:"required"`
+	Quantity   int    `json:"quantity" validate:"required,min=1"`
 }
 
 type CartControllerPayloadUpdateCartItem struct {
-	Quantity int `json:"quantity"`
+	Quantity int `json:"quantity" validate:"required,min=1"`
+}
+
+type CartControllerResponseCreateGuestCart struct {
+	Token string `json:"token"`
 }
 
 type CartControllerResponseGetCart struct {
@@ -56,18 +74,30 @@ type ControllerResponsePropertyCartItem struct {
 	OfferPrice         string  `db:"offer_price" json:"offer_price"`
 	OfferPriceValue    int     `db:"offer_price_value" json:"offer_price_value"`
 	Discount           uint8   `db:"discount" json:"discount"`
+	ProductStatus      string  `db:"product_status" json:"product_status"`
+
+	// PriceChanged is true when the product price changed since the item was added or last updated
+	PriceChanged bool `db:"price_changed" json:"price_changed"`
 }
 
 // Usecase
 type CartUsecase interface {
+	GetProductByUID(ctx context.Context, UID string) (*ProductModel, error)
+
 	// Cart
 	GetCartByUserID(ctx context.Context, userID int) (*CartControllerResponseGetCart, error)
 	GetCartByUserIDMiddleware(ctx context.Context, userID int) (*CartModel, error)
 
+	// Guest cart
+	CreateGuestCart(ctx context.Context) (string, error)
+	GetGuestCart(ctx context.Context, token string) (*CartControllerResponseGetCart, error)
+	GetGuestCartMiddleware(ctx context.Context, token string) (*CartModel, error)
+	MergeGuestCart(ctx context.Context, token string, userID int) error
+
 	// Cart item
 	CreateCartItem(ctx context.Context, payload *CartUsecasePayloadCreateCartItem) (string, error)
 	GetCartItemByUID(ctx context.Context, UID string) (*CartItemModel, error)
-	GetCartItemByProductID(ctx context.Context, productID int) (*CartItemModel, error)
+	GetCartItemByProductID(ctx context.Context, cartID, productID int) (*CartItemModel, error)
 	UpdateCartItem(ctx context.Context, payload *CartUsecasePayloadUpdateCartItem) error
 	DeleteCartItemByUID(ctx context.Context, payload *CartUsecasePayloadDeleteCartItem) error
 }
@@ -103,7 +133,7 @@ type CartModel struct {
 
 	// Relationship
 	CartItems []CartItemModel `db:"cart_items" json:"cart_items"`
-	UserID    int             `db:"user_id" json:"user_id"`
+	UserID    sql.NullInt64   `db:"user_id" json:"user_id"`
 
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
@@ -129,6 +159,8 @@ type CartItemModel struct {
 	OfferPrice         string  `db:"offer_price" json:"offer_price"`
 	OfferPriceValue    int     `db:"offer_price_value" json:"offer_price_value"`
 	Discount           int     `db:"discount" json:"discount"`
+	ProductStatus      string  `db:"product_status" json:"product_status"`
+	PriceChanged       bool    `db:"price_changed" json:"price_changed"`
 
 	// Relationship
 	CartID    int `db:"cart_id" json:"cart_id"`
@@ -139,19 +171,31 @@ type CartItemModel struct {
 }
 
 type CartRepository interface {
-	GetProductByUID(UID string) (*ProductModel, error)
+	GetProductByID(ctx context.Context, ID int) (*ProductModel, error)
+	GetProductByUID(ctx context.Context, UID string) (*ProductModel, error)
 
 	// Cart
-	CreateCart() error
-	GetCartByUID(UID string) (*CartModel, error)
-	GetCartByUserID(userID int) (*CartModel, error)
+	CreateCart(ctx context.Context, userID int) error
+	GetCartByUID(ctx context.Context, UID string) (*CartModel, error)
+	GetCartByUserID(ctx context.Context, userID int) (*CartModel, error)
+
+	// Guest cart
+	CreateGuestCart(ctx context.Context) (string, error)
+	MergeCart(ctx context.Context, guestCartID, userCartID int) error
+	DeleteGuestCartsUpdatedBefore(ctx context.Context, before time.Time) (int64, error)
 
 	// Cart item
-	CreateCartItem(cartItemPayload CartRepositoryPayloadCreateCartItem, cartPayload CartRepositoryPayloadUpdateCart) (string, error)
-	GetCartItemByUID(UID string) (*CartItemModel, error)
-	GetCartItemByProductID(productID int) (*CartItemModel, error)
-	UpdateCartItem(cartItemPayload CartRepositoryPayloadUpdateCartItem, cartPayload CartRepositoryPayloadUpdateCart) error
-	DeleteCartItemByUID(UID string, cartPayload CartRepositoryPayloadUpdateCart) error
+	CreateCartItem(ctx context.Context, cartItemPayload CartRepositoryPayloadCreateCartItem) (string, error)
+	GetCartItemByUID(ctx context.Context, UID string) (*CartItemModel, error)
+	GetCartItemByProductID(ctx context.Context, cartID, productID int) (*CartItemModel, error)
+	UpdateCartItem(ctx context.Context, cartItemPayload CartRepositoryPayloadUpdateCartItem) error
+	DeleteCartItemByUID(ctx context.Context, UID string, cartID int) error
+
+	// RecalculateCart recomputes the cart quantity, price and weight from its items
+	RecalculateCart(ctx context.Context, cartID int) error
+
+	// Stock reservation
+	DeleteExpiredReservations(ctx context.Context, now time.Time) (int64, error)
 }
 
 type CartRepositoryPayloadUpdateCart struct {
@@ -184,22 +228,29 @@ type CartRepositoryPayloadCreateCartItem struct {
 	OfferPrice         string  `db:"offer_price" json:"offer_price"`
 	OfferPriceValue    int     `db:"offer_price_value" json:"offer_price_value"`
 	Discount           int     `db:"discount" json:"discount"`
+	ProductStatus      string  `db:"product_status" json:"product_status"`
 
 	// Relationship
 	CartID    int `db:"cart_id" json:"cart_id"`
 	ProductID int `db:"product_id" json:"product_id"`
 
+	// ReservedUntil holds the product stock for the cart until the given time, if valid
+	ReservedUntil sql.NullTime `db:"-" json:"reserved_until"`
+
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
 type CartRepositoryPayloadUpdateCartItem struct {
-	UID              string  `db:"uid" json:"uid"`
-	Quantity         int     `db:"quantity" json:"quantity"`
-	TotalPrice       string  `db:"total_price" json:"total_price"`
-	TotalPriceValue  int     `db:"total_price_value" json:"total_price_value"`
-	TotalWeight      string  `db:"total_weight" json:"total_weight"`
-	TotalWeightValue float64 `db:"total_weight_value" json:"total_weight_value"`
+	UID      string `db:"uid" json:"uid"`
+	Quantity int    `db:"quantity" json:"quantity"`
+
+	// Relationship
+	CartID    int `db:"cart_id" json:"cart_id"`
+	ProductID int `db:"product_id" json:"product_id"`
+
+	// ReservedUntil holds the product stock for the cart until the given time, if valid
+	ReservedUntil sql.NullTime `db:"-" json:"reserved_until"`
 
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
@@ -0,0 +1,64 @@
+package domain
+
+import "errors"
+
+// Kinds of domain errors, the HTTP error handler maps each kind to a status code. Match a kind with
+// errors.Is(err, ErrNotFound), match a specific error with errors.Is(err, ErrOrderNotFound).
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrValidation      = errors.New("validation failed")
+	ErrForbidden       = errors.New("forbidden")
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+const ErrCodeAlreadyExist = "ALREADY_EXIST"
+
+// Error is a domain error with a stable machine-readable Code, clients should match on Code as Message
+// is meant for humans and may change.
+type Error struct {
+	kind    error
+	Code    string
+	Message string
+	// Field is the field of the payload the error is about, it is empty when the error is not about a single field.
+	Field string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the kind of the error.
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+func NewNotFoundError(code, message string) *Error {
+	return &Error{kind: ErrNotFound, Code: code, Message: message}
+}
+
+func NewConflictError(code, message string) *Error {
+	return &Error{kind: ErrConflict, Code: code, Message: message}
+}
+
+func NewValidationError(code, message string) *Error {
+	return &Error{kind: ErrValidation, Code: code, Message: message}
+}
+
+func NewForbiddenError(code, message string) *Error {
+	return &Error{kind: ErrForbidden, Code: code, Message: message}
+}
+
+func NewUnauthenticatedError(code, message string) *Error {
+	return &Error{kind: ErrUnauthenticated, Code: code, Message: message}
+}
+
+func NewTooManyRequestsError(code, message string) *Error {
+	return &Error{kind: ErrTooManyRequests, Code: code, Message: message}
+}
+
+// NewAlreadyExistError is the Conflict error of a unique field, e.g. a product name that is already taken.
+func NewAlreadyExistError(field string) *Error {
+	return &Error{kind: ErrConflict, Code: ErrCodeAlreadyExist, Message: field + " already exist", Field: field}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/health_usecase_mock.go --fake-name HealthUsecaseMock . HealthUsecase
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/health_repository_mock.go --fake-name HealthRepositoryMock . HealthRepository
+
+const (
+	HealthStatusUp   = "UP"
+	HealthStatusDown = "DOWN"
+
+	HealthDependencyPostgres     = "postgres"
+	HealthDependencyMigrations   = "migrations"
+	HealthDependencyAuthProvider = "auth_provider"
+
+	// Readiness is checked again once the last result is ReadinessCacheTTL old, each dependency is given
+	// HealthCheckTimeout to answer.
+	ReadinessCacheTTL  = 5 * time.Second
+	HealthCheckTimeout = 2 * time.Second
+)
+
+var ErrShuttingDown = errors.New("service is shutting down")
+
+// Controller
+type HealthController interface {
+	Liveness(c echo.Context) error
+	Readiness(c echo.Context) error
+}
+
+type HealthControllerResponseDependency struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// HealthControllerResponseReadiness is UP only when every dependency is UP.
+type HealthControllerResponseReadiness struct {
+	Status       string                                `json:"status"`
+	CheckedAt    time.Time                             `json:"checked_at"`
+	Dependencies []*HealthControllerResponseDependency `json:"dependencies"`
+}
+
+// Usecase
+type HealthUsecase interface {
+	// Readiness checks the dependencies concurrently, the result is cached for ReadinessCacheTTL.
+	Readiness(ctx context.Context) *HealthControllerResponseReadiness
+}
+
+// Repository
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	// MigrationVersion returns the version of the last migration applied to the database, 0 if there is none.
+	MigrationVersion(ctx context.Context) (version uint, dirty bool, err error)
+	// LatestMigrationVersion returns the version of the last migration in MIGRATIONS_DIR.
+	LatestMigrationVersion() (version uint, err error)
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/login_attempt_repository_mock.go --fake-name LoginAttemptRepositoryMock . LoginAttemptRepository
+
+const (
+	LoginAttemptStoreMemory   = "memory"
+	LoginAttemptStorePostgres = "postgres"
+
+	// A key is locked once it reaches its max failures, every further failure doubles the lockout up to LoginMaxLockout.
+	LoginMaxFailuresPerEmail = 5
+	LoginMaxFailuresPerIP    = 20
+	LoginBaseLockout         = time.Minute
+	LoginMaxLockout          = time.Hour
+	// Failures are forgotten after LoginFailureWindow without failures or lockout.
+	LoginFailureWindow = 15 * time.Minute
+)
+
+var (
+	ErrInvalidCredentials   = NewUnauthenticatedError("INVALID_CREDENTIALS", "invalid email or password")
+	ErrTooManyLoginAttempts = NewTooManyRequestsError("TOO_MANY_LOGIN_ATTEMPTS", "too many login attempts, try again later")
+)
+
+// Repository
+// LoginAttemptModel counts failed logins of a key, keys are prefixed with email: or ip:.
+type LoginAttemptModel struct {
+	Key          string       `db:"key" json:"key"`
+	Failures     int          `db:"failures" json:"failures"`
+	LastFailedAt time.Time    `db:"last_failed_at" json:"last_failed_at"`
+	LockedUntil  sql.NullTime `db:"locked_until" json:"locked_until"`
+}
+
+type LoginAttemptRepository interface {
+	GetLoginAttempt(ctx context.Context, key string) (*LoginAttemptModel, error)
+	// RecordFailedLoginAttempt increments the failures of key, starting from 1 again when the last failure and
+	// lockout are older than LoginFailureWindow.
+	RecordFailedLoginAttempt(ctx context.Context, key string, failedAt time.Time) (*LoginAttemptModel, error)
+	LockLoginAttempt(ctx context.Context, key string, lockedUntil time.Time) error
+	DeleteLoginAttempt(ctx context.Context, key string) error
+}
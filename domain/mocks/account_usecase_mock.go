@@ -0,0 +1,347 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type AccountUsecaseMock struct {
+	BuildPendingDataExportsStub        func(context.Context) error
+	buildPendingDataExportsMutex       sync.RWMutex
+	buildPendingDataExportsArgsForCall []struct {
+		arg1 context.Context
+	}
+	buildPendingDataExportsReturns struct {
+		result1 error
+	}
+	buildPendingDataExportsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAccountStub        func(context.Context, *domain.UserModel, string) error
+	deleteAccountMutex       sync.RWMutex
+	deleteAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 string
+	}
+	deleteAccountReturns struct {
+		result1 error
+	}
+	deleteAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteQueuedAuthUsersStub        func(context.Context) error
+	deleteQueuedAuthUsersMutex       sync.RWMutex
+	deleteQueuedAuthUsersArgsForCall []struct {
+		arg1 context.Context
+	}
+	deleteQueuedAuthUsersReturns struct {
+		result1 error
+	}
+	deleteQueuedAuthUsersReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExportDataStub        func(context.Context, *domain.UserModel, string) (*domain.AccountControllerResponseDataExport, error)
+	exportDataMutex       sync.RWMutex
+	exportDataArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 string
+	}
+	exportDataReturns struct {
+		result1 *domain.AccountControllerResponseDataExport
+		result2 error
+	}
+	exportDataReturnsOnCall map[int]struct {
+		result1 *domain.AccountControllerResponseDataExport
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *AccountUsecaseMock) BuildPendingDataExports(arg1 context.Context) error {
+	fake.buildPendingDataExportsMutex.Lock()
+	ret, specificReturn := fake.buildPendingDataExportsReturnsOnCall[len(fake.buildPendingDataExportsArgsForCall)]
+	fake.buildPendingDataExportsArgsForCall = append(fake.buildPendingDataExportsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.BuildPendingDataExportsStub
+	fakeReturns := fake.buildPendingDataExportsReturns
+	fake.recordInvocation("BuildPendingDataExports", []interface{}{arg1})
+	fake.buildPendingDataExportsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AccountUsecaseMock) BuildPendingDataExportsCallCount() int {
+	fake.buildPendingDataExportsMutex.RLock()
+	defer fake.buildPendingDataExportsMutex.RUnlock()
+	return len(fake.buildPendingDataExportsArgsForCall)
+}
+
+func (fake *AccountUsecaseMock) BuildPendingDataExportsCalls(stub func(context.Context) error) {
+	fake.buildPendingDataExportsMutex.Lock()
+	defer fake.buildPendingDataExportsMutex.Unlock()
+	fake.BuildPendingDataExportsStub = stub
+}
+
+func (fake *AccountUsecaseMock) BuildPendingDataExportsArgsForCall(i int) context.Context {
+	fake.buildPendingDataExportsMutex.RLock()
+	defer fake.buildPendingDataExportsMutex.RUnlock()
+	argsForCall := fake.buildPendingDataExportsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *AccountUsecaseMock) BuildPendingDataExportsReturns(result1 error) {
+	fake.buildPendingDataExportsMutex.Lock()
+	defer fake.buildPendingDataExportsMutex.Unlock()
+	fake.BuildPendingDataExportsStub = nil
+	fake.buildPendingDataExportsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AccountUsecaseMock) BuildPendingDataExportsReturnsOnCall(i int, result1 error) {
+	fake.buildPendingDataExportsMutex.Lock()
+	defer fake.buildPendingDataExportsMutex.Unlock()
+	fake.BuildPendingDataExportsStub = nil
+	if fake.buildPendingDataExportsReturnsOnCall == nil {
+		fake.buildPendingDataExportsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.buildPendingDataExportsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AccountUsecaseMock) DeleteAccount(arg1 context.Context, arg2 *domain.UserModel, arg3 string) error {
+	fake.deleteAccountMutex.Lock()
+	ret, specificReturn := fake.deleteAccountReturnsOnCall[len(fake.deleteAccountArgsForCall)]
+	fake.deleteAccountArgsForCall = append(fake.deleteAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAccountStub
+	fakeReturns := fake.deleteAccountReturns
+	fake.recordInvocation("DeleteAccount", []interface{}{arg1, arg2, arg3})
+	fake.deleteAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AccountUsecaseMock) DeleteAccountCallCount() int {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	return len(fake.deleteAccountArgsForCall)
+}
+
+func (fake *AccountUsecaseMock) DeleteAccountCalls(stub func(context.Context, *domain.UserModel, string) error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = stub
+}
+
+func (fake *AccountUsecaseMock) DeleteAccountArgsForCall(i int) (context.Context, *domain.UserModel, string) {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	argsForCall := fake.deleteAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AccountUsecaseMock) DeleteAccountReturns(result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	fake.deleteAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AccountUsecaseMock) DeleteAccountReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	if fake.deleteAccountReturnsOnCall == nil {
+		fake.deleteAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AccountUsecaseMock) DeleteQueuedAuthUsers(arg1 context.Context) error {
+	fake.deleteQueuedAuthUsersMutex.Lock()
+	ret, specificReturn := fake.deleteQueuedAuthUsersReturnsOnCall[len(fake.deleteQueuedAuthUsersArgsForCall)]
+	fake.deleteQueuedAuthUsersArgsForCall = append(fake.deleteQueuedAuthUsersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.DeleteQueuedAuthUsersStub
+	fakeReturns := fake.deleteQueuedAuthUsersReturns
+	fake.recordInvocation("DeleteQueuedAuthUsers", []interface{}{arg1})
+	fake.deleteQueuedAuthUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AccountUsecaseMock) DeleteQueuedAuthUsersCallCount() int {
+	fake.deleteQueuedAuthUsersMutex.RLock()
+	defer fake.deleteQueuedAuthUsersMutex.RUnlock()
+	return len(fake.deleteQueuedAuthUsersArgsForCall)
+}
+
+func (fake *AccountUsecaseMock) DeleteQueuedAuthUsersCalls(stub func(context.Context) error) {
+	fake.deleteQueuedAuthUsersMutex.Lock()
+	defer fake.deleteQueuedAuthUsersMutex.Unlock()
+	fake.DeleteQueuedAuthUsersStub = stub
+}
+
+func (fake *AccountUsecaseMock) DeleteQueuedAuthUsersArgsForCall(i int) context.Context {
+	fake.deleteQueuedAuthUsersMutex.RLock()
+	defer fake.deleteQueuedAuthUsersMutex.RUnlock()
+	argsForCall := fake.deleteQueuedAuthUsersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *AccountUsecaseMock) DeleteQueuedAuthUsersReturns(result1 error) {
+	fake.deleteQueuedAuthUsersMutex.Lock()
+	defer fake.deleteQueuedAuthUsersMutex.Unlock()
+	fake.DeleteQueuedAuthUsersStub = nil
+	fake.deleteQueuedAuthUsersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AccountUsecaseMock) DeleteQueuedAuthUsersReturnsOnCall(i int, result1 error) {
+	fake.deleteQueuedAuthUsersMutex.Lock()
+	defer fake.deleteQueuedAuthUsersMutex.Unlock()
+	fake.DeleteQueuedAuthUsersStub = nil
+	if fake.deleteQueuedAuthUsersReturnsOnCall == nil {
+		fake.deleteQueuedAuthUsersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteQueuedAuthUsersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AccountUsecaseMock) ExportData(arg1 context.Context, arg2 *domain.UserModel, arg3 string) (*domain.AccountControllerResponseDataExport, error) {
+	fake.exportDataMutex.Lock()
+	ret, specificReturn := fake.exportDataReturnsOnCall[len(fake.exportDataArgsForCall)]
+	fake.exportDataArgsForCall = append(fake.exportDataArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExportDataStub
+	fakeReturns := fake.exportDataReturns
+	fake.recordInvocation("ExportData", []interface{}{arg1, arg2, arg3})
+	fake.exportDataMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AccountUsecaseMock) ExportDataCallCount() int {
+	fake.exportDataMutex.RLock()
+	defer fake.exportDataMutex.RUnlock()
+	return len(fake.exportDataArgsForCall)
+}
+
+func (fake *AccountUsecaseMock) ExportDataCalls(stub func(context.Context, *domain.UserModel, string) (*domain.AccountControllerResponseDataExport, error)) {
+	fake.exportDataMutex.Lock()
+	defer fake.exportDataMutex.Unlock()
+	fake.ExportDataStub = stub
+}
+
+func (fake *AccountUsecaseMock) ExportDataArgsForCall(i int) (context.Context, *domain.UserModel, string) {
+	fake.exportDataMutex.RLock()
+	defer fake.exportDataMutex.RUnlock()
+	argsForCall := fake.exportDataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AccountUsecaseMock) ExportDataReturns(result1 *domain.AccountControllerResponseDataExport, result2 error) {
+	fake.exportDataMutex.Lock()
+	defer fake.exportDataMutex.Unlock()
+	fake.ExportDataStub = nil
+	fake.exportDataReturns = struct {
+		result1 *domain.AccountControllerResponseDataExport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AccountUsecaseMock) ExportDataReturnsOnCall(i int, result1 *domain.AccountControllerResponseDataExport, result2 error) {
+	fake.exportDataMutex.Lock()
+	defer fake.exportDataMutex.Unlock()
+	fake.ExportDataStub = nil
+	if fake.exportDataReturnsOnCall == nil {
+		fake.exportDataReturnsOnCall = make(map[int]struct {
+			result1 *domain.AccountControllerResponseDataExport
+			result2 error
+		})
+	}
+	fake.exportDataReturnsOnCall[i] = struct {
+		result1 *domain.AccountControllerResponseDataExport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AccountUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.buildPendingDataExportsMutex.RLock()
+	defer fake.buildPendingDataExportsMutex.RUnlock()
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	fake.deleteQueuedAuthUsersMutex.RLock()
+	defer fake.deleteQueuedAuthUsersMutex.RUnlock()
+	fake.exportDataMutex.RLock()
+	defer fake.exportDataMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *AccountUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.AccountUsecase = new(AccountUsecaseMock)
@@ -0,0 +1,195 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type ActionTokenRepositoryMock struct {
+	CreateActionTokenStub        func(context.Context, *domain.ActionTokenRepositoryPayloadCreateActionToken) error
+	createActionTokenMutex       sync.RWMutex
+	createActionTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.ActionTokenRepositoryPayloadCreateActionToken
+	}
+	createActionTokenReturns struct {
+		result1 error
+	}
+	createActionTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseActionTokenStub        func(context.Context, string, string, time.Time) error
+	useActionTokenMutex       sync.RWMutex
+	useActionTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	useActionTokenReturns struct {
+		result1 error
+	}
+	useActionTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *ActionTokenRepositoryMock) CreateActionToken(arg1 context.Context, arg2 *domain.ActionTokenRepositoryPayloadCreateActionToken) error {
+	fake.createActionTokenMutex.Lock()
+	ret, specificReturn := fake.createActionTokenReturnsOnCall[len(fake.createActionTokenArgsForCall)]
+	fake.createActionTokenArgsForCall = append(fake.createActionTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.ActionTokenRepositoryPayloadCreateActionToken
+	}{arg1, arg2})
+	stub := fake.CreateActionTokenStub
+	fakeReturns := fake.createActionTokenReturns
+	fake.recordInvocation("CreateActionToken", []interface{}{arg1, arg2})
+	fake.createActionTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *ActionTokenRepositoryMock) CreateActionTokenCallCount() int {
+	fake.createActionTokenMutex.RLock()
+	defer fake.createActionTokenMutex.RUnlock()
+	return len(fake.createActionTokenArgsForCall)
+}
+
+func (fake *ActionTokenRepositoryMock) CreateActionTokenCalls(stub func(context.Context, *domain.ActionTokenRepositoryPayloadCreateActionToken) error) {
+	fake.createActionTokenMutex.Lock()
+	defer fake.createActionTokenMutex.Unlock()
+	fake.CreateActionTokenStub = stub
+}
+
+func (fake *ActionTokenRepositoryMock) CreateActionTokenArgsForCall(i int) (context.Context, *domain.ActionTokenRepositoryPayloadCreateActionToken) {
+	fake.createActionTokenMutex.RLock()
+	defer fake.createActionTokenMutex.RUnlock()
+	argsForCall := fake.createActionTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ActionTokenRepositoryMock) CreateActionTokenReturns(result1 error) {
+	fake.createActionTokenMutex.Lock()
+	defer fake.createActionTokenMutex.Unlock()
+	fake.CreateActionTokenStub = nil
+	fake.createActionTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ActionTokenRepositoryMock) CreateActionTokenReturnsOnCall(i int, result1 error) {
+	fake.createActionTokenMutex.Lock()
+	defer fake.createActionTokenMutex.Unlock()
+	fake.CreateActionTokenStub = nil
+	if fake.createActionTokenReturnsOnCall == nil {
+		fake.createActionTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createActionTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ActionTokenRepositoryMock) UseActionToken(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) error {
+	fake.useActionTokenMutex.Lock()
+	ret, specificReturn := fake.useActionTokenReturnsOnCall[len(fake.useActionTokenArgsForCall)]
+	fake.useActionTokenArgsForCall = append(fake.useActionTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UseActionTokenStub
+	fakeReturns := fake.useActionTokenReturns
+	fake.recordInvocation("UseActionToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.useActionTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *ActionTokenRepositoryMock) UseActionTokenCallCount() int {
+	fake.useActionTokenMutex.RLock()
+	defer fake.useActionTokenMutex.RUnlock()
+	return len(fake.useActionTokenArgsForCall)
+}
+
+func (fake *ActionTokenRepositoryMock) UseActionTokenCalls(stub func(context.Context, string, string, time.Time) error) {
+	fake.useActionTokenMutex.Lock()
+	defer fake.useActionTokenMutex.Unlock()
+	fake.UseActionTokenStub = stub
+}
+
+func (fake *ActionTokenRepositoryMock) UseActionTokenArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.useActionTokenMutex.RLock()
+	defer fake.useActionTokenMutex.RUnlock()
+	argsForCall := fake.useActionTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *ActionTokenRepositoryMock) UseActionTokenReturns(result1 error) {
+	fake.useActionTokenMutex.Lock()
+	defer fake.useActionTokenMutex.Unlock()
+	fake.UseActionTokenStub = nil
+	fake.useActionTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ActionTokenRepositoryMock) UseActionTokenReturnsOnCall(i int, result1 error) {
+	fake.useActionTokenMutex.Lock()
+	defer fake.useActionTokenMutex.Unlock()
+	fake.UseActionTokenStub = nil
+	if fake.useActionTokenReturnsOnCall == nil {
+		fake.useActionTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useActionTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ActionTokenRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createActionTokenMutex.RLock()
+	defer fake.createActionTokenMutex.RUnlock()
+	fake.useActionTokenMutex.RLock()
+	defer fake.useActionTokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ActionTokenRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.ActionTokenRepository = new(ActionTokenRepositoryMock)
@@ -0,0 +1,515 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type AddressRepositoryMock struct {
+	CreateAddressStub        func(context.Context, *domain.AddressRepositoryPayloadCreateAddress) error
+	createAddressMutex       sync.RWMutex
+	createAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.AddressRepositoryPayloadCreateAddress
+	}
+	createAddressReturns struct {
+		result1 error
+	}
+	createAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAddressStub        func(context.Context, int, string, time.Time) error
+	deleteAddressMutex       sync.RWMutex
+	deleteAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 time.Time
+	}
+	deleteAddressReturns struct {
+		result1 error
+	}
+	deleteAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAddressByUIDStub        func(context.Context, int, string) (*domain.AddressModel, error)
+	getAddressByUIDMutex       sync.RWMutex
+	getAddressByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	getAddressByUIDReturns struct {
+		result1 *domain.AddressModel
+		result2 error
+	}
+	getAddressByUIDReturnsOnCall map[int]struct {
+		result1 *domain.AddressModel
+		result2 error
+	}
+	ListAddressesByUserIDStub        func(context.Context, int) ([]*domain.AddressModel, error)
+	listAddressesByUserIDMutex       sync.RWMutex
+	listAddressesByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	listAddressesByUserIDReturns struct {
+		result1 []*domain.AddressModel
+		result2 error
+	}
+	listAddressesByUserIDReturnsOnCall map[int]struct {
+		result1 []*domain.AddressModel
+		result2 error
+	}
+	SetDefaultAddressStub        func(context.Context, int, string, time.Time) error
+	setDefaultAddressMutex       sync.RWMutex
+	setDefaultAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 time.Time
+	}
+	setDefaultAddressReturns struct {
+		result1 error
+	}
+	setDefaultAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateAddressStub        func(context.Context, *domain.AddressRepositoryPayloadUpdateAddress) error
+	updateAddressMutex       sync.RWMutex
+	updateAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.AddressRepositoryPayloadUpdateAddress
+	}
+	updateAddressReturns struct {
+		result1 error
+	}
+	updateAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *AddressRepositoryMock) CreateAddress(arg1 context.Context, arg2 *domain.AddressRepositoryPayloadCreateAddress) error {
+	fake.createAddressMutex.Lock()
+	ret, specificReturn := fake.createAddressReturnsOnCall[len(fake.createAddressArgsForCall)]
+	fake.createAddressArgsForCall = append(fake.createAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.AddressRepositoryPayloadCreateAddress
+	}{arg1, arg2})
+	stub := fake.CreateAddressStub
+	fakeReturns := fake.createAddressReturns
+	fake.recordInvocation("CreateAddress", []interface{}{arg1, arg2})
+	fake.createAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AddressRepositoryMock) CreateAddressCallCount() int {
+	fake.createAddressMutex.RLock()
+	defer fake.createAddressMutex.RUnlock()
+	return len(fake.createAddressArgsForCall)
+}
+
+func (fake *AddressRepositoryMock) CreateAddressCalls(stub func(context.Context, *domain.AddressRepositoryPayloadCreateAddress) error) {
+	fake.createAddressMutex.Lock()
+	defer fake.createAddressMutex.Unlock()
+	fake.CreateAddressStub = stub
+}
+
+func (fake *AddressRepositoryMock) CreateAddressArgsForCall(i int) (context.Context, *domain.AddressRepositoryPayloadCreateAddress) {
+	fake.createAddressMutex.RLock()
+	defer fake.createAddressMutex.RUnlock()
+	argsForCall := fake.createAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AddressRepositoryMock) CreateAddressReturns(result1 error) {
+	fake.createAddressMutex.Lock()
+	defer fake.createAddressMutex.Unlock()
+	fake.CreateAddressStub = nil
+	fake.createAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) CreateAddressReturnsOnCall(i int, result1 error) {
+	fake.createAddressMutex.Lock()
+	defer fake.createAddressMutex.Unlock()
+	fake.CreateAddressStub = nil
+	if fake.createAddressReturnsOnCall == nil {
+		fake.createAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) DeleteAddress(arg1 context.Context, arg2 int, arg3 string, arg4 time.Time) error {
+	fake.deleteAddressMutex.Lock()
+	ret, specificReturn := fake.deleteAddressReturnsOnCall[len(fake.deleteAddressArgsForCall)]
+	fake.deleteAddressArgsForCall = append(fake.deleteAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteAddressStub
+	fakeReturns := fake.deleteAddressReturns
+	fake.recordInvocation("DeleteAddress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AddressRepositoryMock) DeleteAddressCallCount() int {
+	fake.deleteAddressMutex.RLock()
+	defer fake.deleteAddressMutex.RUnlock()
+	return len(fake.deleteAddressArgsForCall)
+}
+
+func (fake *AddressRepositoryMock) DeleteAddressCalls(stub func(context.Context, int, string, time.Time) error) {
+	fake.deleteAddressMutex.Lock()
+	defer fake.deleteAddressMutex.Unlock()
+	fake.DeleteAddressStub = stub
+}
+
+func (fake *AddressRepositoryMock) DeleteAddressArgsForCall(i int) (context.Context, int, string, time.Time) {
+	fake.deleteAddressMutex.RLock()
+	defer fake.deleteAddressMutex.RUnlock()
+	argsForCall := fake.deleteAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *AddressRepositoryMock) DeleteAddressReturns(result1 error) {
+	fake.deleteAddressMutex.Lock()
+	defer fake.deleteAddressMutex.Unlock()
+	fake.DeleteAddressStub = nil
+	fake.deleteAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) DeleteAddressReturnsOnCall(i int, result1 error) {
+	fake.deleteAddressMutex.Lock()
+	defer fake.deleteAddressMutex.Unlock()
+	fake.DeleteAddressStub = nil
+	if fake.deleteAddressReturnsOnCall == nil {
+		fake.deleteAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) GetAddressByUID(arg1 context.Context, arg2 int, arg3 string) (*domain.AddressModel, error) {
+	fake.getAddressByUIDMutex.Lock()
+	ret, specificReturn := fake.getAddressByUIDReturnsOnCall[len(fake.getAddressByUIDArgsForCall)]
+	fake.getAddressByUIDArgsForCall = append(fake.getAddressByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetAddressByUIDStub
+	fakeReturns := fake.getAddressByUIDReturns
+	fake.recordInvocation("GetAddressByUID", []interface{}{arg1, arg2, arg3})
+	fake.getAddressByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AddressRepositoryMock) GetAddressByUIDCallCount() int {
+	fake.getAddressByUIDMutex.RLock()
+	defer fake.getAddressByUIDMutex.RUnlock()
+	return len(fake.getAddressByUIDArgsForCall)
+}
+
+func (fake *AddressRepositoryMock) GetAddressByUIDCalls(stub func(context.Context, int, string) (*domain.AddressModel, error)) {
+	fake.getAddressByUIDMutex.Lock()
+	defer fake.getAddressByUIDMutex.Unlock()
+	fake.GetAddressByUIDStub = stub
+}
+
+func (fake *AddressRepositoryMock) GetAddressByUIDArgsForCall(i int) (context.Context, int, string) {
+	fake.getAddressByUIDMutex.RLock()
+	defer fake.getAddressByUIDMutex.RUnlock()
+	argsForCall := fake.getAddressByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AddressRepositoryMock) GetAddressByUIDReturns(result1 *domain.AddressModel, result2 error) {
+	fake.getAddressByUIDMutex.Lock()
+	defer fake.getAddressByUIDMutex.Unlock()
+	fake.GetAddressByUIDStub = nil
+	fake.getAddressByUIDReturns = struct {
+		result1 *domain.AddressModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressRepositoryMock) GetAddressByUIDReturnsOnCall(i int, result1 *domain.AddressModel, result2 error) {
+	fake.getAddressByUIDMutex.Lock()
+	defer fake.getAddressByUIDMutex.Unlock()
+	fake.GetAddressByUIDStub = nil
+	if fake.getAddressByUIDReturnsOnCall == nil {
+		fake.getAddressByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.AddressModel
+			result2 error
+		})
+	}
+	fake.getAddressByUIDReturnsOnCall[i] = struct {
+		result1 *domain.AddressModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressRepositoryMock) ListAddressesByUserID(arg1 context.Context, arg2 int) ([]*domain.AddressModel, error) {
+	fake.listAddressesByUserIDMutex.Lock()
+	ret, specificReturn := fake.listAddressesByUserIDReturnsOnCall[len(fake.listAddressesByUserIDArgsForCall)]
+	fake.listAddressesByUserIDArgsForCall = append(fake.listAddressesByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListAddressesByUserIDStub
+	fakeReturns := fake.listAddressesByUserIDReturns
+	fake.recordInvocation("ListAddressesByUserID", []interface{}{arg1, arg2})
+	fake.listAddressesByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AddressRepositoryMock) ListAddressesByUserIDCallCount() int {
+	fake.listAddressesByUserIDMutex.RLock()
+	defer fake.listAddressesByUserIDMutex.RUnlock()
+	return len(fake.listAddressesByUserIDArgsForCall)
+}
+
+func (fake *AddressRepositoryMock) ListAddressesByUserIDCalls(stub func(context.Context, int) ([]*domain.AddressModel, error)) {
+	fake.listAddressesByUserIDMutex.Lock()
+	defer fake.listAddressesByUserIDMutex.Unlock()
+	fake.ListAddressesByUserIDStub = stub
+}
+
+func (fake *AddressRepositoryMock) ListAddressesByUserIDArgsForCall(i int) (context.Context, int) {
+	fake.listAddressesByUserIDMutex.RLock()
+	defer fake.listAddressesByUserIDMutex.RUnlock()
+	argsForCall := fake.listAddressesByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AddressRepositoryMock) ListAddressesByUserIDReturns(result1 []*domain.AddressModel, result2 error) {
+	fake.listAddressesByUserIDMutex.Lock()
+	defer fake.listAddressesByUserIDMutex.Unlock()
+	fake.ListAddressesByUserIDStub = nil
+	fake.listAddressesByUserIDReturns = struct {
+		result1 []*domain.AddressModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressRepositoryMock) ListAddressesByUserIDReturnsOnCall(i int, result1 []*domain.AddressModel, result2 error) {
+	fake.listAddressesByUserIDMutex.Lock()
+	defer fake.listAddressesByUserIDMutex.Unlock()
+	fake.ListAddressesByUserIDStub = nil
+	if fake.listAddressesByUserIDReturnsOnCall == nil {
+		fake.listAddressesByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []*domain.AddressModel
+			result2 error
+		})
+	}
+	fake.listAddressesByUserIDReturnsOnCall[i] = struct {
+		result1 []*domain.AddressModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressRepositoryMock) SetDefaultAddress(arg1 context.Context, arg2 int, arg3 string, arg4 time.Time) error {
+	fake.setDefaultAddressMutex.Lock()
+	ret, specificReturn := fake.setDefaultAddressReturnsOnCall[len(fake.setDefaultAddressArgsForCall)]
+	fake.setDefaultAddressArgsForCall = append(fake.setDefaultAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetDefaultAddressStub
+	fakeReturns := fake.setDefaultAddressReturns
+	fake.recordInvocation("SetDefaultAddress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setDefaultAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AddressRepositoryMock) SetDefaultAddressCallCount() int {
+	fake.setDefaultAddressMutex.RLock()
+	defer fake.setDefaultAddressMutex.RUnlock()
+	return len(fake.setDefaultAddressArgsForCall)
+}
+
+func (fake *AddressRepositoryMock) SetDefaultAddressCalls(stub func(context.Context, int, string, time.Time) error) {
+	fake.setDefaultAddressMutex.Lock()
+	defer fake.setDefaultAddressMutex.Unlock()
+	fake.SetDefaultAddressStub = stub
+}
+
+func (fake *AddressRepositoryMock) SetDefaultAddressArgsForCall(i int) (context.Context, int, string, time.Time) {
+	fake.setDefaultAddressMutex.RLock()
+	defer fake.setDefaultAddressMutex.RUnlock()
+	argsForCall := fake.setDefaultAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *AddressRepositoryMock) SetDefaultAddressReturns(result1 error) {
+	fake.setDefaultAddressMutex.Lock()
+	defer fake.setDefaultAddressMutex.Unlock()
+	fake.SetDefaultAddressStub = nil
+	fake.setDefaultAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) SetDefaultAddressReturnsOnCall(i int, result1 error) {
+	fake.setDefaultAddressMutex.Lock()
+	defer fake.setDefaultAddressMutex.Unlock()
+	fake.SetDefaultAddressStub = nil
+	if fake.setDefaultAddressReturnsOnCall == nil {
+		fake.setDefaultAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDefaultAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) UpdateAddress(arg1 context.Context, arg2 *domain.AddressRepositoryPayloadUpdateAddress) error {
+	fake.updateAddressMutex.Lock()
+	ret, specificReturn := fake.updateAddressReturnsOnCall[len(fake.updateAddressArgsForCall)]
+	fake.updateAddressArgsForCall = append(fake.updateAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.AddressRepositoryPayloadUpdateAddress
+	}{arg1, arg2})
+	stub := fake.UpdateAddressStub
+	fakeReturns := fake.updateAddressReturns
+	fake.recordInvocation("UpdateAddress", []interface{}{arg1, arg2})
+	fake.updateAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AddressRepositoryMock) UpdateAddressCallCount() int {
+	fake.updateAddressMutex.RLock()
+	defer fake.updateAddressMutex.RUnlock()
+	return len(fake.updateAddressArgsForCall)
+}
+
+func (fake *AddressRepositoryMock) UpdateAddressCalls(stub func(context.Context, *domain.AddressRepositoryPayloadUpdateAddress) error) {
+	fake.updateAddressMutex.Lock()
+	defer fake.updateAddressMutex.Unlock()
+	fake.UpdateAddressStub = stub
+}
+
+func (fake *AddressRepositoryMock) UpdateAddressArgsForCall(i int) (context.Context, *domain.AddressRepositoryPayloadUpdateAddress) {
+	fake.updateAddressMutex.RLock()
+	defer fake.updateAddressMutex.RUnlock()
+	argsForCall := fake.updateAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AddressRepositoryMock) UpdateAddressReturns(result1 error) {
+	fake.updateAddressMutex.Lock()
+	defer fake.updateAddressMutex.Unlock()
+	fake.UpdateAddressStub = nil
+	fake.updateAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) UpdateAddressReturnsOnCall(i int, result1 error) {
+	fake.updateAddressMutex.Lock()
+	defer fake.updateAddressMutex.Unlock()
+	fake.UpdateAddressStub = nil
+	if fake.updateAddressReturnsOnCall == nil {
+		fake.updateAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createAddressMutex.RLock()
+	defer fake.createAddressMutex.RUnlock()
+	fake.deleteAddressMutex.RLock()
+	defer fake.deleteAddressMutex.RUnlock()
+	fake.getAddressByUIDMutex.RLock()
+	defer fake.getAddressByUIDMutex.RUnlock()
+	fake.listAddressesByUserIDMutex.RLock()
+	defer fake.listAddressesByUserIDMutex.RUnlock()
+	fake.setDefaultAddressMutex.RLock()
+	defer fake.setDefaultAddressMutex.RUnlock()
+	fake.updateAddressMutex.RLock()
+	defer fake.updateAddressMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *AddressRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.AddressRepository = new(AddressRepositoryMock)
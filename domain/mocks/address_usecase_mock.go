@@ -0,0 +1,526 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type AddressUsecaseMock struct {
+	CreateAddressStub        func(context.Context, int, *domain.AddressControllerPayloadCreateAddress) (*domain.AddressControllerResponseAddress, error)
+	createAddressMutex       sync.RWMutex
+	createAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *domain.AddressControllerPayloadCreateAddress
+	}
+	createAddressReturns struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}
+	createAddressReturnsOnCall map[int]struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}
+	DeleteAddressStub        func(context.Context, int, string) error
+	deleteAddressMutex       sync.RWMutex
+	deleteAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	deleteAddressReturns struct {
+		result1 error
+	}
+	deleteAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAddressStub        func(context.Context, int, string) (*domain.AddressControllerResponseAddress, error)
+	getAddressMutex       sync.RWMutex
+	getAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	getAddressReturns struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}
+	getAddressReturnsOnCall map[int]struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}
+	ListAddressesStub        func(context.Context, int) ([]*domain.AddressControllerResponseAddress, error)
+	listAddressesMutex       sync.RWMutex
+	listAddressesArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	listAddressesReturns struct {
+		result1 []*domain.AddressControllerResponseAddress
+		result2 error
+	}
+	listAddressesReturnsOnCall map[int]struct {
+		result1 []*domain.AddressControllerResponseAddress
+		result2 error
+	}
+	SetDefaultAddressStub        func(context.Context, int, string) error
+	setDefaultAddressMutex       sync.RWMutex
+	setDefaultAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	setDefaultAddressReturns struct {
+		result1 error
+	}
+	setDefaultAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateAddressStub        func(context.Context, int, string, *domain.AddressControllerPayloadUpdateAddress) (*domain.AddressControllerResponseAddress, error)
+	updateAddressMutex       sync.RWMutex
+	updateAddressArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 *domain.AddressControllerPayloadUpdateAddress
+	}
+	updateAddressReturns struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}
+	updateAddressReturnsOnCall map[int]struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *AddressUsecaseMock) CreateAddress(arg1 context.Context, arg2 int, arg3 *domain.AddressControllerPayloadCreateAddress) (*domain.AddressControllerResponseAddress, error) {
+	fake.createAddressMutex.Lock()
+	ret, specificReturn := fake.createAddressReturnsOnCall[len(fake.createAddressArgsForCall)]
+	fake.createAddressArgsForCall = append(fake.createAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 *domain.AddressControllerPayloadCreateAddress
+	}{arg1, arg2, arg3})
+	stub := fake.CreateAddressStub
+	fakeReturns := fake.createAddressReturns
+	fake.recordInvocation("CreateAddress", []interface{}{arg1, arg2, arg3})
+	fake.createAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AddressUsecaseMock) CreateAddressCallCount() int {
+	fake.createAddressMutex.RLock()
+	defer fake.createAddressMutex.RUnlock()
+	return len(fake.createAddressArgsForCall)
+}
+
+func (fake *AddressUsecaseMock) CreateAddressCalls(stub func(context.Context, int, *domain.AddressControllerPayloadCreateAddress) (*domain.AddressControllerResponseAddress, error)) {
+	fake.createAddressMutex.Lock()
+	defer fake.createAddressMutex.Unlock()
+	fake.CreateAddressStub = stub
+}
+
+func (fake *AddressUsecaseMock) CreateAddressArgsForCall(i int) (context.Context, int, *domain.AddressControllerPayloadCreateAddress) {
+	fake.createAddressMutex.RLock()
+	defer fake.createAddressMutex.RUnlock()
+	argsForCall := fake.createAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AddressUsecaseMock) CreateAddressReturns(result1 *domain.AddressControllerResponseAddress, result2 error) {
+	fake.createAddressMutex.Lock()
+	defer fake.createAddressMutex.Unlock()
+	fake.CreateAddressStub = nil
+	fake.createAddressReturns = struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) CreateAddressReturnsOnCall(i int, result1 *domain.AddressControllerResponseAddress, result2 error) {
+	fake.createAddressMutex.Lock()
+	defer fake.createAddressMutex.Unlock()
+	fake.CreateAddressStub = nil
+	if fake.createAddressReturnsOnCall == nil {
+		fake.createAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.AddressControllerResponseAddress
+			result2 error
+		})
+	}
+	fake.createAddressReturnsOnCall[i] = struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) DeleteAddress(arg1 context.Context, arg2 int, arg3 string) error {
+	fake.deleteAddressMutex.Lock()
+	ret, specificReturn := fake.deleteAddressReturnsOnCall[len(fake.deleteAddressArgsForCall)]
+	fake.deleteAddressArgsForCall = append(fake.deleteAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAddressStub
+	fakeReturns := fake.deleteAddressReturns
+	fake.recordInvocation("DeleteAddress", []interface{}{arg1, arg2, arg3})
+	fake.deleteAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AddressUsecaseMock) DeleteAddressCallCount() int {
+	fake.deleteAddressMutex.RLock()
+	defer fake.deleteAddressMutex.RUnlock()
+	return len(fake.deleteAddressArgsForCall)
+}
+
+func (fake *AddressUsecaseMock) DeleteAddressCalls(stub func(context.Context, int, string) error) {
+	fake.deleteAddressMutex.Lock()
+	defer fake.deleteAddressMutex.Unlock()
+	fake.DeleteAddressStub = stub
+}
+
+func (fake *AddressUsecaseMock) DeleteAddressArgsForCall(i int) (context.Context, int, string) {
+	fake.deleteAddressMutex.RLock()
+	defer fake.deleteAddressMutex.RUnlock()
+	argsForCall := fake.deleteAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AddressUsecaseMock) DeleteAddressReturns(result1 error) {
+	fake.deleteAddressMutex.Lock()
+	defer fake.deleteAddressMutex.Unlock()
+	fake.DeleteAddressStub = nil
+	fake.deleteAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressUsecaseMock) DeleteAddressReturnsOnCall(i int, result1 error) {
+	fake.deleteAddressMutex.Lock()
+	defer fake.deleteAddressMutex.Unlock()
+	fake.DeleteAddressStub = nil
+	if fake.deleteAddressReturnsOnCall == nil {
+		fake.deleteAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressUsecaseMock) GetAddress(arg1 context.Context, arg2 int, arg3 string) (*domain.AddressControllerResponseAddress, error) {
+	fake.getAddressMutex.Lock()
+	ret, specificReturn := fake.getAddressReturnsOnCall[len(fake.getAddressArgsForCall)]
+	fake.getAddressArgsForCall = append(fake.getAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetAddressStub
+	fakeReturns := fake.getAddressReturns
+	fake.recordInvocation("GetAddress", []interface{}{arg1, arg2, arg3})
+	fake.getAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AddressUsecaseMock) GetAddressCallCount() int {
+	fake.getAddressMutex.RLock()
+	defer fake.getAddressMutex.RUnlock()
+	return len(fake.getAddressArgsForCall)
+}
+
+func (fake *AddressUsecaseMock) GetAddressCalls(stub func(context.Context, int, string) (*domain.AddressControllerResponseAddress, error)) {
+	fake.getAddressMutex.Lock()
+	defer fake.getAddressMutex.Unlock()
+	fake.GetAddressStub = stub
+}
+
+func (fake *AddressUsecaseMock) GetAddressArgsForCall(i int) (context.Context, int, string) {
+	fake.getAddressMutex.RLock()
+	defer fake.getAddressMutex.RUnlock()
+	argsForCall := fake.getAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AddressUsecaseMock) GetAddressReturns(result1 *domain.AddressControllerResponseAddress, result2 error) {
+	fake.getAddressMutex.Lock()
+	defer fake.getAddressMutex.Unlock()
+	fake.GetAddressStub = nil
+	fake.getAddressReturns = struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) GetAddressReturnsOnCall(i int, result1 *domain.AddressControllerResponseAddress, result2 error) {
+	fake.getAddressMutex.Lock()
+	defer fake.getAddressMutex.Unlock()
+	fake.GetAddressStub = nil
+	if fake.getAddressReturnsOnCall == nil {
+		fake.getAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.AddressControllerResponseAddress
+			result2 error
+		})
+	}
+	fake.getAddressReturnsOnCall[i] = struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) ListAddresses(arg1 context.Context, arg2 int) ([]*domain.AddressControllerResponseAddress, error) {
+	fake.listAddressesMutex.Lock()
+	ret, specificReturn := fake.listAddressesReturnsOnCall[len(fake.listAddressesArgsForCall)]
+	fake.listAddressesArgsForCall = append(fake.listAddressesArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListAddressesStub
+	fakeReturns := fake.listAddressesReturns
+	fake.recordInvocation("ListAddresses", []interface{}{arg1, arg2})
+	fake.listAddressesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AddressUsecaseMock) ListAddressesCallCount() int {
+	fake.listAddressesMutex.RLock()
+	defer fake.listAddressesMutex.RUnlock()
+	return len(fake.listAddressesArgsForCall)
+}
+
+func (fake *AddressUsecaseMock) ListAddressesCalls(stub func(context.Context, int) ([]*domain.AddressControllerResponseAddress, error)) {
+	fake.listAddressesMutex.Lock()
+	defer fake.listAddressesMutex.Unlock()
+	fake.ListAddressesStub = stub
+}
+
+func (fake *AddressUsecaseMock) ListAddressesArgsForCall(i int) (context.Context, int) {
+	fake.listAddressesMutex.RLock()
+	defer fake.listAddressesMutex.RUnlock()
+	argsForCall := fake.listAddressesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AddressUsecaseMock) ListAddressesReturns(result1 []*domain.AddressControllerResponseAddress, result2 error) {
+	fake.listAddressesMutex.Lock()
+	defer fake.listAddressesMutex.Unlock()
+	fake.ListAddressesStub = nil
+	fake.listAddressesReturns = struct {
+		result1 []*domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) ListAddressesReturnsOnCall(i int, result1 []*domain.AddressControllerResponseAddress, result2 error) {
+	fake.listAddressesMutex.Lock()
+	defer fake.listAddressesMutex.Unlock()
+	fake.ListAddressesStub = nil
+	if fake.listAddressesReturnsOnCall == nil {
+		fake.listAddressesReturnsOnCall = make(map[int]struct {
+			result1 []*domain.AddressControllerResponseAddress
+			result2 error
+		})
+	}
+	fake.listAddressesReturnsOnCall[i] = struct {
+		result1 []*domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) SetDefaultAddress(arg1 context.Context, arg2 int, arg3 string) error {
+	fake.setDefaultAddressMutex.Lock()
+	ret, specificReturn := fake.setDefaultAddressReturnsOnCall[len(fake.setDefaultAddressArgsForCall)]
+	fake.setDefaultAddressArgsForCall = append(fake.setDefaultAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SetDefaultAddressStub
+	fakeReturns := fake.setDefaultAddressReturns
+	fake.recordInvocation("SetDefaultAddress", []interface{}{arg1, arg2, arg3})
+	fake.setDefaultAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AddressUsecaseMock) SetDefaultAddressCallCount() int {
+	fake.setDefaultAddressMutex.RLock()
+	defer fake.setDefaultAddressMutex.RUnlock()
+	return len(fake.setDefaultAddressArgsForCall)
+}
+
+func (fake *AddressUsecaseMock) SetDefaultAddressCalls(stub func(context.Context, int, string) error) {
+	fake.setDefaultAddressMutex.Lock()
+	defer fake.setDefaultAddressMutex.Unlock()
+	fake.SetDefaultAddressStub = stub
+}
+
+func (fake *AddressUsecaseMock) SetDefaultAddressArgsForCall(i int) (context.Context, int, string) {
+	fake.setDefaultAddressMutex.RLock()
+	defer fake.setDefaultAddressMutex.RUnlock()
+	argsForCall := fake.setDefaultAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AddressUsecaseMock) SetDefaultAddressReturns(result1 error) {
+	fake.setDefaultAddressMutex.Lock()
+	defer fake.setDefaultAddressMutex.Unlock()
+	fake.SetDefaultAddressStub = nil
+	fake.setDefaultAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressUsecaseMock) SetDefaultAddressReturnsOnCall(i int, result1 error) {
+	fake.setDefaultAddressMutex.Lock()
+	defer fake.setDefaultAddressMutex.Unlock()
+	fake.SetDefaultAddressStub = nil
+	if fake.setDefaultAddressReturnsOnCall == nil {
+		fake.setDefaultAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDefaultAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AddressUsecaseMock) UpdateAddress(arg1 context.Context, arg2 int, arg3 string, arg4 *domain.AddressControllerPayloadUpdateAddress) (*domain.AddressControllerResponseAddress, error) {
+	fake.updateAddressMutex.Lock()
+	ret, specificReturn := fake.updateAddressReturnsOnCall[len(fake.updateAddressArgsForCall)]
+	fake.updateAddressArgsForCall = append(fake.updateAddressArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 *domain.AddressControllerPayloadUpdateAddress
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateAddressStub
+	fakeReturns := fake.updateAddressReturns
+	fake.recordInvocation("UpdateAddress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AddressUsecaseMock) UpdateAddressCallCount() int {
+	fake.updateAddressMutex.RLock()
+	defer fake.updateAddressMutex.RUnlock()
+	return len(fake.updateAddressArgsForCall)
+}
+
+func (fake *AddressUsecaseMock) UpdateAddressCalls(stub func(context.Context, int, string, *domain.AddressControllerPayloadUpdateAddress) (*domain.AddressControllerResponseAddress, error)) {
+	fake.updateAddressMutex.Lock()
+	defer fake.updateAddressMutex.Unlock()
+	fake.UpdateAddressStub = stub
+}
+
+func (fake *AddressUsecaseMock) UpdateAddressArgsForCall(i int) (context.Context, int, string, *domain.AddressControllerPayloadUpdateAddress) {
+	fake.updateAddressMutex.RLock()
+	defer fake.updateAddressMutex.RUnlock()
+	argsForCall := fake.updateAddressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *AddressUsecaseMock) UpdateAddressReturns(result1 *domain.AddressControllerResponseAddress, result2 error) {
+	fake.updateAddressMutex.Lock()
+	defer fake.updateAddressMutex.Unlock()
+	fake.UpdateAddressStub = nil
+	fake.updateAddressReturns = struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) UpdateAddressReturnsOnCall(i int, result1 *domain.AddressControllerResponseAddress, result2 error) {
+	fake.updateAddressMutex.Lock()
+	defer fake.updateAddressMutex.Unlock()
+	fake.UpdateAddressStub = nil
+	if fake.updateAddressReturnsOnCall == nil {
+		fake.updateAddressReturnsOnCall = make(map[int]struct {
+			result1 *domain.AddressControllerResponseAddress
+			result2 error
+		})
+	}
+	fake.updateAddressReturnsOnCall[i] = struct {
+		result1 *domain.AddressControllerResponseAddress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AddressUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createAddressMutex.RLock()
+	defer fake.createAddressMutex.RUnlock()
+	fake.deleteAddressMutex.RLock()
+	defer fake.deleteAddressMutex.RUnlock()
+	fake.getAddressMutex.RLock()
+	defer fake.getAddressMutex.RUnlock()
+	fake.listAddressesMutex.RLock()
+	defer fake.listAddressesMutex.RUnlock()
+	fake.setDefaultAddressMutex.RLock()
+	defer fake.setDefaultAddressMutex.RUnlock()
+	fake.updateAddressMutex.RLock()
+	defer fake.updateAddressMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *AddressUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.AddressUsecase = new(AddressUsecaseMock)
@@ -0,0 +1,355 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type AdminUsecaseMock struct {
+	CreateFirstAdminStub        func(context.Context, string) (string, error)
+	createFirstAdminMutex       sync.RWMutex
+	createFirstAdminArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createFirstAdminReturns struct {
+		result1 string
+		result2 error
+	}
+	createFirstAdminReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GrantAdminStub        func(context.Context, *domain.AdminUsecasePayloadGrantAdmin) (string, error)
+	grantAdminMutex       sync.RWMutex
+	grantAdminArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.AdminUsecasePayloadGrantAdmin
+	}
+	grantAdminReturns struct {
+		result1 string
+		result2 error
+	}
+	grantAdminReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ListAdminsStub        func(context.Context) ([]*domain.AdminControllerResponseAdmin, error)
+	listAdminsMutex       sync.RWMutex
+	listAdminsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listAdminsReturns struct {
+		result1 []*domain.AdminControllerResponseAdmin
+		result2 error
+	}
+	listAdminsReturnsOnCall map[int]struct {
+		result1 []*domain.AdminControllerResponseAdmin
+		result2 error
+	}
+	RevokeAdminStub        func(context.Context, *domain.AdminUsecasePayloadRevokeAdmin) error
+	revokeAdminMutex       sync.RWMutex
+	revokeAdminArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.AdminUsecasePayloadRevokeAdmin
+	}
+	revokeAdminReturns struct {
+		result1 error
+	}
+	revokeAdminReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *AdminUsecaseMock) CreateFirstAdmin(arg1 context.Context, arg2 string) (string, error) {
+	fake.createFirstAdminMutex.Lock()
+	ret, specificReturn := fake.createFirstAdminReturnsOnCall[len(fake.createFirstAdminArgsForCall)]
+	fake.createFirstAdminArgsForCall = append(fake.createFirstAdminArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateFirstAdminStub
+	fakeReturns := fake.createFirstAdminReturns
+	fake.recordInvocation("CreateFirstAdmin", []interface{}{arg1, arg2})
+	fake.createFirstAdminMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AdminUsecaseMock) CreateFirstAdminCallCount() int {
+	fake.createFirstAdminMutex.RLock()
+	defer fake.createFirstAdminMutex.RUnlock()
+	return len(fake.createFirstAdminArgsForCall)
+}
+
+func (fake *AdminUsecaseMock) CreateFirstAdminCalls(stub func(context.Context, string) (string, error)) {
+	fake.createFirstAdminMutex.Lock()
+	defer fake.createFirstAdminMutex.Unlock()
+	fake.CreateFirstAdminStub = stub
+}
+
+func (fake *AdminUsecaseMock) CreateFirstAdminArgsForCall(i int) (context.Context, string) {
+	fake.createFirstAdminMutex.RLock()
+	defer fake.createFirstAdminMutex.RUnlock()
+	argsForCall := fake.createFirstAdminArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AdminUsecaseMock) CreateFirstAdminReturns(result1 string, result2 error) {
+	fake.createFirstAdminMutex.Lock()
+	defer fake.createFirstAdminMutex.Unlock()
+	fake.CreateFirstAdminStub = nil
+	fake.createFirstAdminReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AdminUsecaseMock) CreateFirstAdminReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createFirstAdminMutex.Lock()
+	defer fake.createFirstAdminMutex.Unlock()
+	fake.CreateFirstAdminStub = nil
+	if fake.createFirstAdminReturnsOnCall == nil {
+		fake.createFirstAdminReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createFirstAdminReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AdminUsecaseMock) GrantAdmin(arg1 context.Context, arg2 *domain.AdminUsecasePayloadGrantAdmin) (string, error) {
+	fake.grantAdminMutex.Lock()
+	ret, specificReturn := fake.grantAdminReturnsOnCall[len(fake.grantAdminArgsForCall)]
+	fake.grantAdminArgsForCall = append(fake.grantAdminArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.AdminUsecasePayloadGrantAdmin
+	}{arg1, arg2})
+	stub := fake.GrantAdminStub
+	fakeReturns := fake.grantAdminReturns
+	fake.recordInvocation("GrantAdmin", []interface{}{arg1, arg2})
+	fake.grantAdminMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AdminUsecaseMock) GrantAdminCallCount() int {
+	fake.grantAdminMutex.RLock()
+	defer fake.grantAdminMutex.RUnlock()
+	return len(fake.grantAdminArgsForCall)
+}
+
+func (fake *AdminUsecaseMock) GrantAdminCalls(stub func(context.Context, *domain.AdminUsecasePayloadGrantAdmin) (string, error)) {
+	fake.grantAdminMutex.Lock()
+	defer fake.grantAdminMutex.Unlock()
+	fake.GrantAdminStub = stub
+}
+
+func (fake *AdminUsecaseMock) GrantAdminArgsForCall(i int) (context.Context, *domain.AdminUsecasePayloadGrantAdmin) {
+	fake.grantAdminMutex.RLock()
+	defer fake.grantAdminMutex.RUnlock()
+	argsForCall := fake.grantAdminArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AdminUsecaseMock) GrantAdminReturns(result1 string, result2 error) {
+	fake.grantAdminMutex.Lock()
+	defer fake.grantAdminMutex.Unlock()
+	fake.GrantAdminStub = nil
+	fake.grantAdminReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AdminUsecaseMock) GrantAdminReturnsOnCall(i int, result1 string, result2 error) {
+	fake.grantAdminMutex.Lock()
+	defer fake.grantAdminMutex.Unlock()
+	fake.GrantAdminStub = nil
+	if fake.grantAdminReturnsOnCall == nil {
+		fake.grantAdminReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.grantAdminReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AdminUsecaseMock) ListAdmins(arg1 context.Context) ([]*domain.AdminControllerResponseAdmin, error) {
+	fake.listAdminsMutex.Lock()
+	ret, specificReturn := fake.listAdminsReturnsOnCall[len(fake.listAdminsArgsForCall)]
+	fake.listAdminsArgsForCall = append(fake.listAdminsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListAdminsStub
+	fakeReturns := fake.listAdminsReturns
+	fake.recordInvocation("ListAdmins", []interface{}{arg1})
+	fake.listAdminsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AdminUsecaseMock) ListAdminsCallCount() int {
+	fake.listAdminsMutex.RLock()
+	defer fake.listAdminsMutex.RUnlock()
+	return len(fake.listAdminsArgsForCall)
+}
+
+func (fake *AdminUsecaseMock) ListAdminsCalls(stub func(context.Context) ([]*domain.AdminControllerResponseAdmin, error)) {
+	fake.listAdminsMutex.Lock()
+	defer fake.listAdminsMutex.Unlock()
+	fake.ListAdminsStub = stub
+}
+
+func (fake *AdminUsecaseMock) ListAdminsArgsForCall(i int) context.Context {
+	fake.listAdminsMutex.RLock()
+	defer fake.listAdminsMutex.RUnlock()
+	argsForCall := fake.listAdminsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *AdminUsecaseMock) ListAdminsReturns(result1 []*domain.AdminControllerResponseAdmin, result2 error) {
+	fake.listAdminsMutex.Lock()
+	defer fake.listAdminsMutex.Unlock()
+	fake.ListAdminsStub = nil
+	fake.listAdminsReturns = struct {
+		result1 []*domain.AdminControllerResponseAdmin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AdminUsecaseMock) ListAdminsReturnsOnCall(i int, result1 []*domain.AdminControllerResponseAdmin, result2 error) {
+	fake.listAdminsMutex.Lock()
+	defer fake.listAdminsMutex.Unlock()
+	fake.ListAdminsStub = nil
+	if fake.listAdminsReturnsOnCall == nil {
+		fake.listAdminsReturnsOnCall = make(map[int]struct {
+			result1 []*domain.AdminControllerResponseAdmin
+			result2 error
+		})
+	}
+	fake.listAdminsReturnsOnCall[i] = struct {
+		result1 []*domain.AdminControllerResponseAdmin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AdminUsecaseMock) RevokeAdmin(arg1 context.Context, arg2 *domain.AdminUsecasePayloadRevokeAdmin) error {
+	fake.revokeAdminMutex.Lock()
+	ret, specificReturn := fake.revokeAdminReturnsOnCall[len(fake.revokeAdminArgsForCall)]
+	fake.revokeAdminArgsForCall = append(fake.revokeAdminArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.AdminUsecasePayloadRevokeAdmin
+	}{arg1, arg2})
+	stub := fake.RevokeAdminStub
+	fakeReturns := fake.revokeAdminReturns
+	fake.recordInvocation("RevokeAdmin", []interface{}{arg1, arg2})
+	fake.revokeAdminMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AdminUsecaseMock) RevokeAdminCallCount() int {
+	fake.revokeAdminMutex.RLock()
+	defer fake.revokeAdminMutex.RUnlock()
+	return len(fake.revokeAdminArgsForCall)
+}
+
+func (fake *AdminUsecaseMock) RevokeAdminCalls(stub func(context.Context, *domain.AdminUsecasePayloadRevokeAdmin) error) {
+	fake.revokeAdminMutex.Lock()
+	defer fake.revokeAdminMutex.Unlock()
+	fake.RevokeAdminStub = stub
+}
+
+func (fake *AdminUsecaseMock) RevokeAdminArgsForCall(i int) (context.Context, *domain.AdminUsecasePayloadRevokeAdmin) {
+	fake.revokeAdminMutex.RLock()
+	defer fake.revokeAdminMutex.RUnlock()
+	argsForCall := fake.revokeAdminArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AdminUsecaseMock) RevokeAdminReturns(result1 error) {
+	fake.revokeAdminMutex.Lock()
+	defer fake.revokeAdminMutex.Unlock()
+	fake.RevokeAdminStub = nil
+	fake.revokeAdminReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AdminUsecaseMock) RevokeAdminReturnsOnCall(i int, result1 error) {
+	fake.revokeAdminMutex.Lock()
+	defer fake.revokeAdminMutex.Unlock()
+	fake.RevokeAdminStub = nil
+	if fake.revokeAdminReturnsOnCall == nil {
+		fake.revokeAdminReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeAdminReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AdminUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createFirstAdminMutex.RLock()
+	defer fake.createFirstAdminMutex.RUnlock()
+	fake.grantAdminMutex.RLock()
+	defer fake.grantAdminMutex.RUnlock()
+	fake.listAdminsMutex.RLock()
+	defer fake.listAdminsMutex.RUnlock()
+	fake.revokeAdminMutex.RLock()
+	defer fake.revokeAdminMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *AdminUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.AdminUsecase = new(AdminUsecaseMock)
@@ -0,0 +1,195 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type AuditLogRepositoryMock struct {
+	CreateAuditLogStub        func(context.Context, *domain.AuditLogRepositoryPayloadCreateAuditLog) error
+	createAuditLogMutex       sync.RWMutex
+	createAuditLogArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.AuditLogRepositoryPayloadCreateAuditLog
+	}
+	createAuditLogReturns struct {
+		result1 error
+	}
+	createAuditLogReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListAuditLogsByTargetUserIDStub        func(context.Context, int) ([]*domain.AuditLogModel, error)
+	listAuditLogsByTargetUserIDMutex       sync.RWMutex
+	listAuditLogsByTargetUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	listAuditLogsByTargetUserIDReturns struct {
+		result1 []*domain.AuditLogModel
+		result2 error
+	}
+	listAuditLogsByTargetUserIDReturnsOnCall map[int]struct {
+		result1 []*domain.AuditLogModel
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *AuditLogRepositoryMock) CreateAuditLog(arg1 context.Context, arg2 *domain.AuditLogRepositoryPayloadCreateAuditLog) error {
+	fake.createAuditLogMutex.Lock()
+	ret, specificReturn := fake.createAuditLogReturnsOnCall[len(fake.createAuditLogArgsForCall)]
+	fake.createAuditLogArgsForCall = append(fake.createAuditLogArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.AuditLogRepositoryPayloadCreateAuditLog
+	}{arg1, arg2})
+	stub := fake.CreateAuditLogStub
+	fakeReturns := fake.createAuditLogReturns
+	fake.recordInvocation("CreateAuditLog", []interface{}{arg1, arg2})
+	fake.createAuditLogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuditLogRepositoryMock) CreateAuditLogCallCount() int {
+	fake.createAuditLogMutex.RLock()
+	defer fake.createAuditLogMutex.RUnlock()
+	return len(fake.createAuditLogArgsForCall)
+}
+
+func (fake *AuditLogRepositoryMock) CreateAuditLogCalls(stub func(context.Context, *domain.AuditLogRepositoryPayloadCreateAuditLog) error) {
+	fake.createAuditLogMutex.Lock()
+	defer fake.createAuditLogMutex.Unlock()
+	fake.CreateAuditLogStub = stub
+}
+
+func (fake *AuditLogRepositoryMock) CreateAuditLogArgsForCall(i int) (context.Context, *domain.AuditLogRepositoryPayloadCreateAuditLog) {
+	fake.createAuditLogMutex.RLock()
+	defer fake.createAuditLogMutex.RUnlock()
+	argsForCall := fake.createAuditLogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuditLogRepositoryMock) CreateAuditLogReturns(result1 error) {
+	fake.createAuditLogMutex.Lock()
+	defer fake.createAuditLogMutex.Unlock()
+	fake.CreateAuditLogStub = nil
+	fake.createAuditLogReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuditLogRepositoryMock) CreateAuditLogReturnsOnCall(i int, result1 error) {
+	fake.createAuditLogMutex.Lock()
+	defer fake.createAuditLogMutex.Unlock()
+	fake.CreateAuditLogStub = nil
+	if fake.createAuditLogReturnsOnCall == nil {
+		fake.createAuditLogReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createAuditLogReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuditLogRepositoryMock) ListAuditLogsByTargetUserID(arg1 context.Context, arg2 int) ([]*domain.AuditLogModel, error) {
+	fake.listAuditLogsByTargetUserIDMutex.Lock()
+	ret, specificReturn := fake.listAuditLogsByTargetUserIDReturnsOnCall[len(fake.listAuditLogsByTargetUserIDArgsForCall)]
+	fake.listAuditLogsByTargetUserIDArgsForCall = append(fake.listAuditLogsByTargetUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListAuditLogsByTargetUserIDStub
+	fakeReturns := fake.listAuditLogsByTargetUserIDReturns
+	fake.recordInvocation("ListAuditLogsByTargetUserID", []interface{}{arg1, arg2})
+	fake.listAuditLogsByTargetUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuditLogRepositoryMock) ListAuditLogsByTargetUserIDCallCount() int {
+	fake.listAuditLogsByTargetUserIDMutex.RLock()
+	defer fake.listAuditLogsByTargetUserIDMutex.RUnlock()
+	return len(fake.listAuditLogsByTargetUserIDArgsForCall)
+}
+
+func (fake *AuditLogRepositoryMock) ListAuditLogsByTargetUserIDCalls(stub func(context.Context, int) ([]*domain.AuditLogModel, error)) {
+	fake.listAuditLogsByTargetUserIDMutex.Lock()
+	defer fake.listAuditLogsByTargetUserIDMutex.Unlock()
+	fake.ListAuditLogsByTargetUserIDStub = stub
+}
+
+func (fake *AuditLogRepositoryMock) ListAuditLogsByTargetUserIDArgsForCall(i int) (context.Context, int) {
+	fake.listAuditLogsByTargetUserIDMutex.RLock()
+	defer fake.listAuditLogsByTargetUserIDMutex.RUnlock()
+	argsForCall := fake.listAuditLogsByTargetUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuditLogRepositoryMock) ListAuditLogsByTargetUserIDReturns(result1 []*domain.AuditLogModel, result2 error) {
+	fake.listAuditLogsByTargetUserIDMutex.Lock()
+	defer fake.listAuditLogsByTargetUserIDMutex.Unlock()
+	fake.ListAuditLogsByTargetUserIDStub = nil
+	fake.listAuditLogsByTargetUserIDReturns = struct {
+		result1 []*domain.AuditLogModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuditLogRepositoryMock) ListAuditLogsByTargetUserIDReturnsOnCall(i int, result1 []*domain.AuditLogModel, result2 error) {
+	fake.listAuditLogsByTargetUserIDMutex.Lock()
+	defer fake.listAuditLogsByTargetUserIDMutex.Unlock()
+	fake.ListAuditLogsByTargetUserIDStub = nil
+	if fake.listAuditLogsByTargetUserIDReturnsOnCall == nil {
+		fake.listAuditLogsByTargetUserIDReturnsOnCall = make(map[int]struct {
+			result1 []*domain.AuditLogModel
+			result2 error
+		})
+	}
+	fake.listAuditLogsByTargetUserIDReturnsOnCall[i] = struct {
+		result1 []*domain.AuditLogModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuditLogRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createAuditLogMutex.RLock()
+	defer fake.createAuditLogMutex.RUnlock()
+	fake.listAuditLogsByTargetUserIDMutex.RLock()
+	defer fake.listAuditLogsByTargetUserIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *AuditLogRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.AuditLogRepository = new(AuditLogRepositoryMock)
@@ -9,28 +9,119 @@ import (
 )
 
 type AuthUsecaseMock struct {
-	GetAccessTokenStub        func(context.Context, string, string) (string, error)
+	ForgotPasswordStub        func(context.Context, string) error
+	forgotPasswordMutex       sync.RWMutex
+	forgotPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	forgotPasswordReturns struct {
+		result1 error
+	}
+	forgotPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAccessTokenStub        func(context.Context, string, string, *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error)
 	getAccessTokenMutex       sync.RWMutex
 	getAccessTokenArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
+		arg4 *domain.AuthUsecasePropertyClient
 	}
 	getAccessTokenReturns struct {
-		result1 string
+		result1 *domain.AuthControllerResponseTokenPair
 		result2 error
 	}
 	getAccessTokenReturnsOnCall map[int]struct {
-		result1 string
+		result1 *domain.AuthControllerResponseTokenPair
 		result2 error
 	}
-	SignUpStub        func(context.Context, string, string, bool) error
+	ListSessionsStub        func(context.Context, int) ([]*domain.AuthControllerResponseSession, error)
+	listSessionsMutex       sync.RWMutex
+	listSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	listSessionsReturns struct {
+		result1 []*domain.AuthControllerResponseSession
+		result2 error
+	}
+	listSessionsReturnsOnCall map[int]struct {
+		result1 []*domain.AuthControllerResponseSession
+		result2 error
+	}
+	LogoutStub        func(context.Context, int, string) error
+	logoutMutex       sync.RWMutex
+	logoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	logoutReturns struct {
+		result1 error
+	}
+	logoutReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LogoutAllStub        func(context.Context, *domain.UserModel) error
+	logoutAllMutex       sync.RWMutex
+	logoutAllArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+	}
+	logoutAllReturns struct {
+		result1 error
+	}
+	logoutAllReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RefreshTokenStub        func(context.Context, string, *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error)
+	refreshTokenMutex       sync.RWMutex
+	refreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *domain.AuthUsecasePropertyClient
+	}
+	refreshTokenReturns struct {
+		result1 *domain.AuthControllerResponseTokenPair
+		result2 error
+	}
+	refreshTokenReturnsOnCall map[int]struct {
+		result1 *domain.AuthControllerResponseTokenPair
+		result2 error
+	}
+	ResetPasswordStub        func(context.Context, string, string) error
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	resetPasswordReturns struct {
+		result1 error
+	}
+	resetPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendEmailVerificationStub        func(context.Context, *domain.UserModel) error
+	sendEmailVerificationMutex       sync.RWMutex
+	sendEmailVerificationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+	}
+	sendEmailVerificationReturns struct {
+		result1 error
+	}
+	sendEmailVerificationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SignUpStub        func(context.Context, string, string) error
 	signUpMutex       sync.RWMutex
 	signUpArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-		arg4 bool
 	}
 	signUpReturns struct {
 		result1 error
@@ -38,24 +129,99 @@ type AuthUsecaseMock struct {
 	signUpReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VerifyEmailStub        func(context.Context, string) error
+	verifyEmailMutex       sync.RWMutex
+	verifyEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	verifyEmailReturns struct {
+		result1 error
+	}
+	verifyEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *AuthUsecaseMock) GetAccessToken(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+func (fake *AuthUsecaseMock) ForgotPassword(arg1 context.Context, arg2 string) error {
+	fake.forgotPasswordMutex.Lock()
+	ret, specificReturn := fake.forgotPasswordReturnsOnCall[len(fake.forgotPasswordArgsForCall)]
+	fake.forgotPasswordArgsForCall = append(fake.forgotPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ForgotPasswordStub
+	fakeReturns := fake.forgotPasswordReturns
+	fake.recordInvocation("ForgotPassword", []interface{}{arg1, arg2})
+	fake.forgotPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUsecaseMock) ForgotPasswordCallCount() int {
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	return len(fake.forgotPasswordArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) ForgotPasswordCalls(stub func(context.Context, string) error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = stub
+}
+
+func (fake *AuthUsecaseMock) ForgotPasswordArgsForCall(i int) (context.Context, string) {
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	argsForCall := fake.forgotPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUsecaseMock) ForgotPasswordReturns(result1 error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = nil
+	fake.forgotPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) ForgotPasswordReturnsOnCall(i int, result1 error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = nil
+	if fake.forgotPasswordReturnsOnCall == nil {
+		fake.forgotPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forgotPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) GetAccessToken(arg1 context.Context, arg2 string, arg3 string, arg4 *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error) {
 	fake.getAccessTokenMutex.Lock()
 	ret, specificReturn := fake.getAccessTokenReturnsOnCall[len(fake.getAccessTokenArgsForCall)]
 	fake.getAccessTokenArgsForCall = append(fake.getAccessTokenArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 *domain.AuthUsecasePropertyClient
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.GetAccessTokenStub
 	fakeReturns := fake.getAccessTokenReturns
-	fake.recordInvocation("GetAccessToken", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("GetAccessToken", []interface{}{arg1, arg2, arg3, arg4})
 	fake.getAccessTokenMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -69,60 +235,440 @@ func (fake *AuthUsecaseMock) GetAccessTokenCallCount() int {
 	return len(fake.getAccessTokenArgsForCall)
 }
 
-func (fake *AuthUsecaseMock) GetAccessTokenCalls(stub func(context.Context, string, string) (string, error)) {
+func (fake *AuthUsecaseMock) GetAccessTokenCalls(stub func(context.Context, string, string, *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error)) {
 	fake.getAccessTokenMutex.Lock()
 	defer fake.getAccessTokenMutex.Unlock()
 	fake.GetAccessTokenStub = stub
 }
 
-func (fake *AuthUsecaseMock) GetAccessTokenArgsForCall(i int) (context.Context, string, string) {
+func (fake *AuthUsecaseMock) GetAccessTokenArgsForCall(i int) (context.Context, string, string, *domain.AuthUsecasePropertyClient) {
 	fake.getAccessTokenMutex.RLock()
 	defer fake.getAccessTokenMutex.RUnlock()
 	argsForCall := fake.getAccessTokenArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *AuthUsecaseMock) GetAccessTokenReturns(result1 string, result2 error) {
+func (fake *AuthUsecaseMock) GetAccessTokenReturns(result1 *domain.AuthControllerResponseTokenPair, result2 error) {
 	fake.getAccessTokenMutex.Lock()
 	defer fake.getAccessTokenMutex.Unlock()
 	fake.GetAccessTokenStub = nil
 	fake.getAccessTokenReturns = struct {
-		result1 string
+		result1 *domain.AuthControllerResponseTokenPair
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *AuthUsecaseMock) GetAccessTokenReturnsOnCall(i int, result1 string, result2 error) {
+func (fake *AuthUsecaseMock) GetAccessTokenReturnsOnCall(i int, result1 *domain.AuthControllerResponseTokenPair, result2 error) {
 	fake.getAccessTokenMutex.Lock()
 	defer fake.getAccessTokenMutex.Unlock()
 	fake.GetAccessTokenStub = nil
 	if fake.getAccessTokenReturnsOnCall == nil {
 		fake.getAccessTokenReturnsOnCall = make(map[int]struct {
-			result1 string
+			result1 *domain.AuthControllerResponseTokenPair
 			result2 error
 		})
 	}
 	fake.getAccessTokenReturnsOnCall[i] = struct {
-		result1 string
+		result1 *domain.AuthControllerResponseTokenPair
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUsecaseMock) ListSessions(arg1 context.Context, arg2 int) ([]*domain.AuthControllerResponseSession, error) {
+	fake.listSessionsMutex.Lock()
+	ret, specificReturn := fake.listSessionsReturnsOnCall[len(fake.listSessionsArgsForCall)]
+	fake.listSessionsArgsForCall = append(fake.listSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ListSessionsStub
+	fakeReturns := fake.listSessionsReturns
+	fake.recordInvocation("ListSessions", []interface{}{arg1, arg2})
+	fake.listSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuthUsecaseMock) ListSessionsCallCount() int {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	return len(fake.listSessionsArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) ListSessionsCalls(stub func(context.Context, int) ([]*domain.AuthControllerResponseSession, error)) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = stub
+}
+
+func (fake *AuthUsecaseMock) ListSessionsArgsForCall(i int) (context.Context, int) {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	argsForCall := fake.listSessionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUsecaseMock) ListSessionsReturns(result1 []*domain.AuthControllerResponseSession, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	fake.listSessionsReturns = struct {
+		result1 []*domain.AuthControllerResponseSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUsecaseMock) ListSessionsReturnsOnCall(i int, result1 []*domain.AuthControllerResponseSession, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	if fake.listSessionsReturnsOnCall == nil {
+		fake.listSessionsReturnsOnCall = make(map[int]struct {
+			result1 []*domain.AuthControllerResponseSession
+			result2 error
+		})
+	}
+	fake.listSessionsReturnsOnCall[i] = struct {
+		result1 []*domain.AuthControllerResponseSession
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *AuthUsecaseMock) SignUp(arg1 context.Context, arg2 string, arg3 string, arg4 bool) error {
+func (fake *AuthUsecaseMock) Logout(arg1 context.Context, arg2 int, arg3 string) error {
+	fake.logoutMutex.Lock()
+	ret, specificReturn := fake.logoutReturnsOnCall[len(fake.logoutArgsForCall)]
+	fake.logoutArgsForCall = append(fake.logoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LogoutStub
+	fakeReturns := fake.logoutReturns
+	fake.recordInvocation("Logout", []interface{}{arg1, arg2, arg3})
+	fake.logoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUsecaseMock) LogoutCallCount() int {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	return len(fake.logoutArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) LogoutCalls(stub func(context.Context, int, string) error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = stub
+}
+
+func (fake *AuthUsecaseMock) LogoutArgsForCall(i int) (context.Context, int, string) {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	argsForCall := fake.logoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AuthUsecaseMock) LogoutReturns(result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	fake.logoutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) LogoutReturnsOnCall(i int, result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	if fake.logoutReturnsOnCall == nil {
+		fake.logoutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.logoutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) LogoutAll(arg1 context.Context, arg2 *domain.UserModel) error {
+	fake.logoutAllMutex.Lock()
+	ret, specificReturn := fake.logoutAllReturnsOnCall[len(fake.logoutAllArgsForCall)]
+	fake.logoutAllArgsForCall = append(fake.logoutAllArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+	}{arg1, arg2})
+	stub := fake.LogoutAllStub
+	fakeReturns := fake.logoutAllReturns
+	fake.recordInvocation("LogoutAll", []interface{}{arg1, arg2})
+	fake.logoutAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUsecaseMock) LogoutAllCallCount() int {
+	fake.logoutAllMutex.RLock()
+	defer fake.logoutAllMutex.RUnlock()
+	return len(fake.logoutAllArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) LogoutAllCalls(stub func(context.Context, *domain.UserModel) error) {
+	fake.logoutAllMutex.Lock()
+	defer fake.logoutAllMutex.Unlock()
+	fake.LogoutAllStub = stub
+}
+
+func (fake *AuthUsecaseMock) LogoutAllArgsForCall(i int) (context.Context, *domain.UserModel) {
+	fake.logoutAllMutex.RLock()
+	defer fake.logoutAllMutex.RUnlock()
+	argsForCall := fake.logoutAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUsecaseMock) LogoutAllReturns(result1 error) {
+	fake.logoutAllMutex.Lock()
+	defer fake.logoutAllMutex.Unlock()
+	fake.LogoutAllStub = nil
+	fake.logoutAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) LogoutAllReturnsOnCall(i int, result1 error) {
+	fake.logoutAllMutex.Lock()
+	defer fake.logoutAllMutex.Unlock()
+	fake.LogoutAllStub = nil
+	if fake.logoutAllReturnsOnCall == nil {
+		fake.logoutAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.logoutAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) RefreshToken(arg1 context.Context, arg2 string, arg3 *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error) {
+	fake.refreshTokenMutex.Lock()
+	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
+	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *domain.AuthUsecasePropertyClient
+	}{arg1, arg2, arg3})
+	stub := fake.RefreshTokenStub
+	fakeReturns := fake.refreshTokenReturns
+	fake.recordInvocation("RefreshToken", []interface{}{arg1, arg2, arg3})
+	fake.refreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuthUsecaseMock) RefreshTokenCallCount() int {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	return len(fake.refreshTokenArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) RefreshTokenCalls(stub func(context.Context, string, *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error)) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = stub
+}
+
+func (fake *AuthUsecaseMock) RefreshTokenArgsForCall(i int) (context.Context, string, *domain.AuthUsecasePropertyClient) {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	argsForCall := fake.refreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AuthUsecaseMock) RefreshTokenReturns(result1 *domain.AuthControllerResponseTokenPair, result2 error) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = nil
+	fake.refreshTokenReturns = struct {
+		result1 *domain.AuthControllerResponseTokenPair
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUsecaseMock) RefreshTokenReturnsOnCall(i int, result1 *domain.AuthControllerResponseTokenPair, result2 error) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = nil
+	if fake.refreshTokenReturnsOnCall == nil {
+		fake.refreshTokenReturnsOnCall = make(map[int]struct {
+			result1 *domain.AuthControllerResponseTokenPair
+			result2 error
+		})
+	}
+	fake.refreshTokenReturnsOnCall[i] = struct {
+		result1 *domain.AuthControllerResponseTokenPair
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUsecaseMock) ResetPassword(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResetPasswordStub
+	fakeReturns := fake.resetPasswordReturns
+	fake.recordInvocation("ResetPassword", []interface{}{arg1, arg2, arg3})
+	fake.resetPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUsecaseMock) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) ResetPasswordCalls(stub func(context.Context, string, string) error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = stub
+}
+
+func (fake *AuthUsecaseMock) ResetPasswordArgsForCall(i int) (context.Context, string, string) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	argsForCall := fake.resetPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AuthUsecaseMock) ResetPasswordReturns(result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) ResetPasswordReturnsOnCall(i int, result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) SendEmailVerification(arg1 context.Context, arg2 *domain.UserModel) error {
+	fake.sendEmailVerificationMutex.Lock()
+	ret, specificReturn := fake.sendEmailVerificationReturnsOnCall[len(fake.sendEmailVerificationArgsForCall)]
+	fake.sendEmailVerificationArgsForCall = append(fake.sendEmailVerificationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+	}{arg1, arg2})
+	stub := fake.SendEmailVerificationStub
+	fakeReturns := fake.sendEmailVerificationReturns
+	fake.recordInvocation("SendEmailVerification", []interface{}{arg1, arg2})
+	fake.sendEmailVerificationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUsecaseMock) SendEmailVerificationCallCount() int {
+	fake.sendEmailVerificationMutex.RLock()
+	defer fake.sendEmailVerificationMutex.RUnlock()
+	return len(fake.sendEmailVerificationArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) SendEmailVerificationCalls(stub func(context.Context, *domain.UserModel) error) {
+	fake.sendEmailVerificationMutex.Lock()
+	defer fake.sendEmailVerificationMutex.Unlock()
+	fake.SendEmailVerificationStub = stub
+}
+
+func (fake *AuthUsecaseMock) SendEmailVerificationArgsForCall(i int) (context.Context, *domain.UserModel) {
+	fake.sendEmailVerificationMutex.RLock()
+	defer fake.sendEmailVerificationMutex.RUnlock()
+	argsForCall := fake.sendEmailVerificationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUsecaseMock) SendEmailVerificationReturns(result1 error) {
+	fake.sendEmailVerificationMutex.Lock()
+	defer fake.sendEmailVerificationMutex.Unlock()
+	fake.SendEmailVerificationStub = nil
+	fake.sendEmailVerificationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) SendEmailVerificationReturnsOnCall(i int, result1 error) {
+	fake.sendEmailVerificationMutex.Lock()
+	defer fake.sendEmailVerificationMutex.Unlock()
+	fake.SendEmailVerificationStub = nil
+	if fake.sendEmailVerificationReturnsOnCall == nil {
+		fake.sendEmailVerificationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendEmailVerificationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) SignUp(arg1 context.Context, arg2 string, arg3 string) error {
 	fake.signUpMutex.Lock()
 	ret, specificReturn := fake.signUpReturnsOnCall[len(fake.signUpArgsForCall)]
 	fake.signUpArgsForCall = append(fake.signUpArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-		arg4 bool
-	}{arg1, arg2, arg3, arg4})
+	}{arg1, arg2, arg3})
 	stub := fake.SignUpStub
 	fakeReturns := fake.signUpReturns
-	fake.recordInvocation("SignUp", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SignUp", []interface{}{arg1, arg2, arg3})
 	fake.signUpMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -136,17 +682,17 @@ func (fake *AuthUsecaseMock) SignUpCallCount() int {
 	return len(fake.signUpArgsForCall)
 }
 
-func (fake *AuthUsecaseMock) SignUpCalls(stub func(context.Context, string, string, bool) error) {
+func (fake *AuthUsecaseMock) SignUpCalls(stub func(context.Context, string, string) error) {
 	fake.signUpMutex.Lock()
 	defer fake.signUpMutex.Unlock()
 	fake.SignUpStub = stub
 }
 
-func (fake *AuthUsecaseMock) SignUpArgsForCall(i int) (context.Context, string, string, bool) {
+func (fake *AuthUsecaseMock) SignUpArgsForCall(i int) (context.Context, string, string) {
 	fake.signUpMutex.RLock()
 	defer fake.signUpMutex.RUnlock()
 	argsForCall := fake.signUpArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *AuthUsecaseMock) SignUpReturns(result1 error) {
@@ -172,13 +718,91 @@ func (fake *AuthUsecaseMock) SignUpReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *AuthUsecaseMock) VerifyEmail(arg1 context.Context, arg2 string) error {
+	fake.verifyEmailMutex.Lock()
+	ret, specificReturn := fake.verifyEmailReturnsOnCall[len(fake.verifyEmailArgsForCall)]
+	fake.verifyEmailArgsForCall = append(fake.verifyEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.VerifyEmailStub
+	fakeReturns := fake.verifyEmailReturns
+	fake.recordInvocation("VerifyEmail", []interface{}{arg1, arg2})
+	fake.verifyEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUsecaseMock) VerifyEmailCallCount() int {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	return len(fake.verifyEmailArgsForCall)
+}
+
+func (fake *AuthUsecaseMock) VerifyEmailCalls(stub func(context.Context, string) error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = stub
+}
+
+func (fake *AuthUsecaseMock) VerifyEmailArgsForCall(i int) (context.Context, string) {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	argsForCall := fake.verifyEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUsecaseMock) VerifyEmailReturns(result1 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	fake.verifyEmailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUsecaseMock) VerifyEmailReturnsOnCall(i int, result1 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	if fake.verifyEmailReturnsOnCall == nil {
+		fake.verifyEmailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyEmailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *AuthUsecaseMock) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
 	fake.getAccessTokenMutex.RLock()
 	defer fake.getAccessTokenMutex.RUnlock()
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	fake.logoutAllMutex.RLock()
+	defer fake.logoutAllMutex.RUnlock()
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	fake.sendEmailVerificationMutex.RLock()
+	defer fake.sendEmailVerificationMutex.RUnlock()
 	fake.signUpMutex.RLock()
 	defer fake.signUpMutex.RUnlock()
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -2,17 +2,20 @@
 package mocks
 
 import (
+	"context"
 	"sync"
+	"time"
 
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
 )
 
 type AuthUtilMock struct {
-	CreateUserStub        func(string, string) (string, error)
+	CreateUserStub        func(context.Context, string, string) (string, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
+		arg3 string
 	}
 	createUserReturns struct {
 		result1 string
@@ -22,11 +25,52 @@ type AuthUtilMock struct {
 		result1 string
 		result2 error
 	}
-	GetAccessTokenStub        func(string, string) (string, error)
+	DeleteUserStub        func(context.Context, string) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GenerateEmailVerificationLinkStub        func(context.Context, string) (string, error)
+	generateEmailVerificationLinkMutex       sync.RWMutex
+	generateEmailVerificationLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	generateEmailVerificationLinkReturns struct {
+		result1 string
+		result2 error
+	}
+	generateEmailVerificationLinkReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GeneratePasswordResetLinkStub        func(context.Context, string) (string, error)
+	generatePasswordResetLinkMutex       sync.RWMutex
+	generatePasswordResetLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	generatePasswordResetLinkReturns struct {
+		result1 string
+		result2 error
+	}
+	generatePasswordResetLinkReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetAccessTokenStub        func(context.Context, string, string) (string, error)
 	getAccessTokenMutex       sync.RWMutex
 	getAccessTokenArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
+		arg3 string
 	}
 	getAccessTokenReturns struct {
 		result1 string
@@ -36,10 +80,92 @@ type AuthUtilMock struct {
 		result1 string
 		result2 error
 	}
-	VerifyTokenStub        func(string) (string, error)
+	IssueAccessTokenStub        func(context.Context, string) (string, time.Time, error)
+	issueAccessTokenMutex       sync.RWMutex
+	issueAccessTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	issueAccessTokenReturns struct {
+		result1 string
+		result2 time.Time
+		result3 error
+	}
+	issueAccessTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 time.Time
+		result3 error
+	}
+	PingStub        func(context.Context) error
+	pingMutex       sync.RWMutex
+	pingArgsForCall []struct {
+		arg1 context.Context
+	}
+	pingReturns struct {
+		result1 error
+	}
+	pingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResetPasswordStub        func(context.Context, string, string) (string, error)
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	resetPasswordReturns struct {
+		result1 string
+		result2 error
+	}
+	resetPasswordReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	RevokeTokensStub        func(context.Context, string) error
+	revokeTokensMutex       sync.RWMutex
+	revokeTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	revokeTokensReturns struct {
+		result1 error
+	}
+	revokeTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateEmailStub        func(context.Context, string, string) error
+	updateEmailMutex       sync.RWMutex
+	updateEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateEmailReturns struct {
+		result1 error
+	}
+	updateEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerifyEmailStub        func(context.Context, string) (string, error)
+	verifyEmailMutex       sync.RWMutex
+	verifyEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	verifyEmailReturns struct {
+		result1 string
+		result2 error
+	}
+	verifyEmailReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	VerifyTokenStub        func(context.Context, string) (string, error)
 	verifyTokenMutex       sync.RWMutex
 	verifyTokenArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	verifyTokenReturns struct {
 		result1 string
@@ -53,19 +179,20 @@ type AuthUtilMock struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *AuthUtilMock) CreateUser(arg1 string, arg2 string) (string, error) {
+func (fake *AuthUtilMock) CreateUser(arg1 context.Context, arg2 string, arg3 string) (string, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
 	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.CreateUserStub
 	fakeReturns := fake.createUserReturns
-	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2})
+	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2, arg3})
 	fake.createUserMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -79,17 +206,17 @@ func (fake *AuthUtilMock) CreateUserCallCount() int {
 	return len(fake.createUserArgsForCall)
 }
 
-func (fake *AuthUtilMock) CreateUserCalls(stub func(string, string) (string, error)) {
+func (fake *AuthUtilMock) CreateUserCalls(stub func(context.Context, string, string) (string, error)) {
 	fake.createUserMutex.Lock()
 	defer fake.createUserMutex.Unlock()
 	fake.CreateUserStub = stub
 }
 
-func (fake *AuthUtilMock) CreateUserArgsForCall(i int) (string, string) {
+func (fake *AuthUtilMock) CreateUserArgsForCall(i int) (context.Context, string, string) {
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	argsForCall := fake.createUserArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *AuthUtilMock) CreateUserReturns(result1 string, result2 error) {
@@ -118,19 +245,212 @@ func (fake *AuthUtilMock) CreateUserReturnsOnCall(i int, result1 string, result2
 	}{result1, result2}
 }
 
-func (fake *AuthUtilMock) GetAccessToken(arg1 string, arg2 string) (string, error) {
+func (fake *AuthUtilMock) DeleteUser(arg1 context.Context, arg2 string) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUtilMock) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *AuthUtilMock) DeleteUserCalls(stub func(context.Context, string) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *AuthUtilMock) DeleteUserArgsForCall(i int) (context.Context, string) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUtilMock) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) GenerateEmailVerificationLink(arg1 context.Context, arg2 string) (string, error) {
+	fake.generateEmailVerificationLinkMutex.Lock()
+	ret, specificReturn := fake.generateEmailVerificationLinkReturnsOnCall[len(fake.generateEmailVerificationLinkArgsForCall)]
+	fake.generateEmailVerificationLinkArgsForCall = append(fake.generateEmailVerificationLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GenerateEmailVerificationLinkStub
+	fakeReturns := fake.generateEmailVerificationLinkReturns
+	fake.recordInvocation("GenerateEmailVerificationLink", []interface{}{arg1, arg2})
+	fake.generateEmailVerificationLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuthUtilMock) GenerateEmailVerificationLinkCallCount() int {
+	fake.generateEmailVerificationLinkMutex.RLock()
+	defer fake.generateEmailVerificationLinkMutex.RUnlock()
+	return len(fake.generateEmailVerificationLinkArgsForCall)
+}
+
+func (fake *AuthUtilMock) GenerateEmailVerificationLinkCalls(stub func(context.Context, string) (string, error)) {
+	fake.generateEmailVerificationLinkMutex.Lock()
+	defer fake.generateEmailVerificationLinkMutex.Unlock()
+	fake.GenerateEmailVerificationLinkStub = stub
+}
+
+func (fake *AuthUtilMock) GenerateEmailVerificationLinkArgsForCall(i int) (context.Context, string) {
+	fake.generateEmailVerificationLinkMutex.RLock()
+	defer fake.generateEmailVerificationLinkMutex.RUnlock()
+	argsForCall := fake.generateEmailVerificationLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUtilMock) GenerateEmailVerificationLinkReturns(result1 string, result2 error) {
+	fake.generateEmailVerificationLinkMutex.Lock()
+	defer fake.generateEmailVerificationLinkMutex.Unlock()
+	fake.GenerateEmailVerificationLinkStub = nil
+	fake.generateEmailVerificationLinkReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) GenerateEmailVerificationLinkReturnsOnCall(i int, result1 string, result2 error) {
+	fake.generateEmailVerificationLinkMutex.Lock()
+	defer fake.generateEmailVerificationLinkMutex.Unlock()
+	fake.GenerateEmailVerificationLinkStub = nil
+	if fake.generateEmailVerificationLinkReturnsOnCall == nil {
+		fake.generateEmailVerificationLinkReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.generateEmailVerificationLinkReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) GeneratePasswordResetLink(arg1 context.Context, arg2 string) (string, error) {
+	fake.generatePasswordResetLinkMutex.Lock()
+	ret, specificReturn := fake.generatePasswordResetLinkReturnsOnCall[len(fake.generatePasswordResetLinkArgsForCall)]
+	fake.generatePasswordResetLinkArgsForCall = append(fake.generatePasswordResetLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GeneratePasswordResetLinkStub
+	fakeReturns := fake.generatePasswordResetLinkReturns
+	fake.recordInvocation("GeneratePasswordResetLink", []interface{}{arg1, arg2})
+	fake.generatePasswordResetLinkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuthUtilMock) GeneratePasswordResetLinkCallCount() int {
+	fake.generatePasswordResetLinkMutex.RLock()
+	defer fake.generatePasswordResetLinkMutex.RUnlock()
+	return len(fake.generatePasswordResetLinkArgsForCall)
+}
+
+func (fake *AuthUtilMock) GeneratePasswordResetLinkCalls(stub func(context.Context, string) (string, error)) {
+	fake.generatePasswordResetLinkMutex.Lock()
+	defer fake.generatePasswordResetLinkMutex.Unlock()
+	fake.GeneratePasswordResetLinkStub = stub
+}
+
+func (fake *AuthUtilMock) GeneratePasswordResetLinkArgsForCall(i int) (context.Context, string) {
+	fake.generatePasswordResetLinkMutex.RLock()
+	defer fake.generatePasswordResetLinkMutex.RUnlock()
+	argsForCall := fake.generatePasswordResetLinkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUtilMock) GeneratePasswordResetLinkReturns(result1 string, result2 error) {
+	fake.generatePasswordResetLinkMutex.Lock()
+	defer fake.generatePasswordResetLinkMutex.Unlock()
+	fake.GeneratePasswordResetLinkStub = nil
+	fake.generatePasswordResetLinkReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) GeneratePasswordResetLinkReturnsOnCall(i int, result1 string, result2 error) {
+	fake.generatePasswordResetLinkMutex.Lock()
+	defer fake.generatePasswordResetLinkMutex.Unlock()
+	fake.GeneratePasswordResetLinkStub = nil
+	if fake.generatePasswordResetLinkReturnsOnCall == nil {
+		fake.generatePasswordResetLinkReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.generatePasswordResetLinkReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) GetAccessToken(arg1 context.Context, arg2 string, arg3 string) (string, error) {
 	fake.getAccessTokenMutex.Lock()
 	ret, specificReturn := fake.getAccessTokenReturnsOnCall[len(fake.getAccessTokenArgsForCall)]
 	fake.getAccessTokenArgsForCall = append(fake.getAccessTokenArgsForCall, struct {
-		arg1 string
+		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.GetAccessTokenStub
 	fakeReturns := fake.getAccessTokenReturns
-	fake.recordInvocation("GetAccessToken", []interface{}{arg1, arg2})
+	fake.recordInvocation("GetAccessToken", []interface{}{arg1, arg2, arg3})
 	fake.getAccessTokenMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -144,17 +464,17 @@ func (fake *AuthUtilMock) GetAccessTokenCallCount() int {
 	return len(fake.getAccessTokenArgsForCall)
 }
 
-func (fake *AuthUtilMock) GetAccessTokenCalls(stub func(string, string) (string, error)) {
+func (fake *AuthUtilMock) GetAccessTokenCalls(stub func(context.Context, string, string) (string, error)) {
 	fake.getAccessTokenMutex.Lock()
 	defer fake.getAccessTokenMutex.Unlock()
 	fake.GetAccessTokenStub = stub
 }
 
-func (fake *AuthUtilMock) GetAccessTokenArgsForCall(i int) (string, string) {
+func (fake *AuthUtilMock) GetAccessTokenArgsForCall(i int) (context.Context, string, string) {
 	fake.getAccessTokenMutex.RLock()
 	defer fake.getAccessTokenMutex.RUnlock()
 	argsForCall := fake.getAccessTokenArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *AuthUtilMock) GetAccessTokenReturns(result1 string, result2 error) {
@@ -183,18 +503,404 @@ func (fake *AuthUtilMock) GetAccessTokenReturnsOnCall(i int, result1 string, res
 	}{result1, result2}
 }
 
-func (fake *AuthUtilMock) VerifyToken(arg1 string) (string, error) {
+func (fake *AuthUtilMock) IssueAccessToken(arg1 context.Context, arg2 string) (string, time.Time, error) {
+	fake.issueAccessTokenMutex.Lock()
+	ret, specificReturn := fake.issueAccessTokenReturnsOnCall[len(fake.issueAccessTokenArgsForCall)]
+	fake.issueAccessTokenArgsForCall = append(fake.issueAccessTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IssueAccessTokenStub
+	fakeReturns := fake.issueAccessTokenReturns
+	fake.recordInvocation("IssueAccessToken", []interface{}{arg1, arg2})
+	fake.issueAccessTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *AuthUtilMock) IssueAccessTokenCallCount() int {
+	fake.issueAccessTokenMutex.RLock()
+	defer fake.issueAccessTokenMutex.RUnlock()
+	return len(fake.issueAccessTokenArgsForCall)
+}
+
+func (fake *AuthUtilMock) IssueAccessTokenCalls(stub func(context.Context, string) (string, time.Time, error)) {
+	fake.issueAccessTokenMutex.Lock()
+	defer fake.issueAccessTokenMutex.Unlock()
+	fake.IssueAccessTokenStub = stub
+}
+
+func (fake *AuthUtilMock) IssueAccessTokenArgsForCall(i int) (context.Context, string) {
+	fake.issueAccessTokenMutex.RLock()
+	defer fake.issueAccessTokenMutex.RUnlock()
+	argsForCall := fake.issueAccessTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUtilMock) IssueAccessTokenReturns(result1 string, result2 time.Time, result3 error) {
+	fake.issueAccessTokenMutex.Lock()
+	defer fake.issueAccessTokenMutex.Unlock()
+	fake.IssueAccessTokenStub = nil
+	fake.issueAccessTokenReturns = struct {
+		result1 string
+		result2 time.Time
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *AuthUtilMock) IssueAccessTokenReturnsOnCall(i int, result1 string, result2 time.Time, result3 error) {
+	fake.issueAccessTokenMutex.Lock()
+	defer fake.issueAccessTokenMutex.Unlock()
+	fake.IssueAccessTokenStub = nil
+	if fake.issueAccessTokenReturnsOnCall == nil {
+		fake.issueAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 time.Time
+			result3 error
+		})
+	}
+	fake.issueAccessTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 time.Time
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *AuthUtilMock) Ping(arg1 context.Context) error {
+	fake.pingMutex.Lock()
+	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
+	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.PingStub
+	fakeReturns := fake.pingReturns
+	fake.recordInvocation("Ping", []interface{}{arg1})
+	fake.pingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUtilMock) PingCallCount() int {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	return len(fake.pingArgsForCall)
+}
+
+func (fake *AuthUtilMock) PingCalls(stub func(context.Context) error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = stub
+}
+
+func (fake *AuthUtilMock) PingArgsForCall(i int) context.Context {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	argsForCall := fake.pingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *AuthUtilMock) PingReturns(result1 error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	fake.pingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) PingReturnsOnCall(i int, result1 error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	if fake.pingReturnsOnCall == nil {
+		fake.pingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) ResetPassword(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResetPasswordStub
+	fakeReturns := fake.resetPasswordReturns
+	fake.recordInvocation("ResetPassword", []interface{}{arg1, arg2, arg3})
+	fake.resetPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuthUtilMock) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
+}
+
+func (fake *AuthUtilMock) ResetPasswordCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = stub
+}
+
+func (fake *AuthUtilMock) ResetPasswordArgsForCall(i int) (context.Context, string, string) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	argsForCall := fake.resetPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AuthUtilMock) ResetPasswordReturns(result1 string, result2 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) ResetPasswordReturnsOnCall(i int, result1 string, result2 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) RevokeTokens(arg1 context.Context, arg2 string) error {
+	fake.revokeTokensMutex.Lock()
+	ret, specificReturn := fake.revokeTokensReturnsOnCall[len(fake.revokeTokensArgsForCall)]
+	fake.revokeTokensArgsForCall = append(fake.revokeTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeTokensStub
+	fakeReturns := fake.revokeTokensReturns
+	fake.recordInvocation("RevokeTokens", []interface{}{arg1, arg2})
+	fake.revokeTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUtilMock) RevokeTokensCallCount() int {
+	fake.revokeTokensMutex.RLock()
+	defer fake.revokeTokensMutex.RUnlock()
+	return len(fake.revokeTokensArgsForCall)
+}
+
+func (fake *AuthUtilMock) RevokeTokensCalls(stub func(context.Context, string) error) {
+	fake.revokeTokensMutex.Lock()
+	defer fake.revokeTokensMutex.Unlock()
+	fake.RevokeTokensStub = stub
+}
+
+func (fake *AuthUtilMock) RevokeTokensArgsForCall(i int) (context.Context, string) {
+	fake.revokeTokensMutex.RLock()
+	defer fake.revokeTokensMutex.RUnlock()
+	argsForCall := fake.revokeTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUtilMock) RevokeTokensReturns(result1 error) {
+	fake.revokeTokensMutex.Lock()
+	defer fake.revokeTokensMutex.Unlock()
+	fake.RevokeTokensStub = nil
+	fake.revokeTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) RevokeTokensReturnsOnCall(i int, result1 error) {
+	fake.revokeTokensMutex.Lock()
+	defer fake.revokeTokensMutex.Unlock()
+	fake.RevokeTokensStub = nil
+	if fake.revokeTokensReturnsOnCall == nil {
+		fake.revokeTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) UpdateEmail(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.updateEmailMutex.Lock()
+	ret, specificReturn := fake.updateEmailReturnsOnCall[len(fake.updateEmailArgsForCall)]
+	fake.updateEmailArgsForCall = append(fake.updateEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateEmailStub
+	fakeReturns := fake.updateEmailReturns
+	fake.recordInvocation("UpdateEmail", []interface{}{arg1, arg2, arg3})
+	fake.updateEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuthUtilMock) UpdateEmailCallCount() int {
+	fake.updateEmailMutex.RLock()
+	defer fake.updateEmailMutex.RUnlock()
+	return len(fake.updateEmailArgsForCall)
+}
+
+func (fake *AuthUtilMock) UpdateEmailCalls(stub func(context.Context, string, string) error) {
+	fake.updateEmailMutex.Lock()
+	defer fake.updateEmailMutex.Unlock()
+	fake.UpdateEmailStub = stub
+}
+
+func (fake *AuthUtilMock) UpdateEmailArgsForCall(i int) (context.Context, string, string) {
+	fake.updateEmailMutex.RLock()
+	defer fake.updateEmailMutex.RUnlock()
+	argsForCall := fake.updateEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *AuthUtilMock) UpdateEmailReturns(result1 error) {
+	fake.updateEmailMutex.Lock()
+	defer fake.updateEmailMutex.Unlock()
+	fake.UpdateEmailStub = nil
+	fake.updateEmailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) UpdateEmailReturnsOnCall(i int, result1 error) {
+	fake.updateEmailMutex.Lock()
+	defer fake.updateEmailMutex.Unlock()
+	fake.UpdateEmailStub = nil
+	if fake.updateEmailReturnsOnCall == nil {
+		fake.updateEmailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateEmailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuthUtilMock) VerifyEmail(arg1 context.Context, arg2 string) (string, error) {
+	fake.verifyEmailMutex.Lock()
+	ret, specificReturn := fake.verifyEmailReturnsOnCall[len(fake.verifyEmailArgsForCall)]
+	fake.verifyEmailArgsForCall = append(fake.verifyEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.VerifyEmailStub
+	fakeReturns := fake.verifyEmailReturns
+	fake.recordInvocation("VerifyEmail", []interface{}{arg1, arg2})
+	fake.verifyEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *AuthUtilMock) VerifyEmailCallCount() int {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	return len(fake.verifyEmailArgsForCall)
+}
+
+func (fake *AuthUtilMock) VerifyEmailCalls(stub func(context.Context, string) (string, error)) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = stub
+}
+
+func (fake *AuthUtilMock) VerifyEmailArgsForCall(i int) (context.Context, string) {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	argsForCall := fake.verifyEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuthUtilMock) VerifyEmailReturns(result1 string, result2 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	fake.verifyEmailReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) VerifyEmailReturnsOnCall(i int, result1 string, result2 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	if fake.verifyEmailReturnsOnCall == nil {
+		fake.verifyEmailReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.verifyEmailReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *AuthUtilMock) VerifyToken(arg1 context.Context, arg2 string) (string, error) {
 	fake.verifyTokenMutex.Lock()
 	ret, specificReturn := fake.verifyTokenReturnsOnCall[len(fake.verifyTokenArgsForCall)]
 	fake.verifyTokenArgsForCall = append(fake.verifyTokenArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.VerifyTokenStub
 	fakeReturns := fake.verifyTokenReturns
-	fake.recordInvocation("VerifyToken", []interface{}{arg1})
+	fake.recordInvocation("VerifyToken", []interface{}{arg1, arg2})
 	fake.verifyTokenMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -208,17 +914,17 @@ func (fake *AuthUtilMock) VerifyTokenCallCount() int {
 	return len(fake.verifyTokenArgsForCall)
 }
 
-func (fake *AuthUtilMock) VerifyTokenCalls(stub func(string) (string, error)) {
+func (fake *AuthUtilMock) VerifyTokenCalls(stub func(context.Context, string) (string, error)) {
 	fake.verifyTokenMutex.Lock()
 	defer fake.verifyTokenMutex.Unlock()
 	fake.VerifyTokenStub = stub
 }
 
-func (fake *AuthUtilMock) VerifyTokenArgsForCall(i int) string {
+func (fake *AuthUtilMock) VerifyTokenArgsForCall(i int) (context.Context, string) {
 	fake.verifyTokenMutex.RLock()
 	defer fake.verifyTokenMutex.RUnlock()
 	argsForCall := fake.verifyTokenArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *AuthUtilMock) VerifyTokenReturns(result1 string, result2 error) {
@@ -252,8 +958,26 @@ func (fake *AuthUtilMock) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	fake.generateEmailVerificationLinkMutex.RLock()
+	defer fake.generateEmailVerificationLinkMutex.RUnlock()
+	fake.generatePasswordResetLinkMutex.RLock()
+	defer fake.generatePasswordResetLinkMutex.RUnlock()
 	fake.getAccessTokenMutex.RLock()
 	defer fake.getAccessTokenMutex.RUnlock()
+	fake.issueAccessTokenMutex.RLock()
+	defer fake.issueAccessTokenMutex.RUnlock()
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	fake.revokeTokensMutex.RLock()
+	defer fake.revokeTokensMutex.RUnlock()
+	fake.updateEmailMutex.RLock()
+	defer fake.updateEmailMutex.RUnlock()
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
 	fake.verifyTokenMutex.RLock()
 	defer fake.verifyTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
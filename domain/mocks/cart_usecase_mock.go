@@ -0,0 +1,997 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type CartUsecaseMock struct {
+	CreateCartItemStub        func(context.Context, *domain.CartUsecasePayloadCreateCartItem) (string, error)
+	createCartItemMutex       sync.RWMutex
+	createCartItemArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.CartUsecasePayloadCreateCartItem
+	}
+	createCartItemReturns struct {
+		result1 string
+		result2 error
+	}
+	createCartItemReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateGuestCartStub        func(context.Context) (string, error)
+	createGuestCartMutex       sync.RWMutex
+	createGuestCartArgsForCall []struct {
+		arg1 context.Context
+	}
+	createGuestCartReturns struct {
+		result1 string
+		result2 error
+	}
+	createGuestCartReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DeleteCartItemByUIDStub        func(context.Context, *domain.CartUsecasePayloadDeleteCartItem) error
+	deleteCartItemByUIDMutex       sync.RWMutex
+	deleteCartItemByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.CartUsecasePayloadDeleteCartItem
+	}
+	deleteCartItemByUIDReturns struct {
+		result1 error
+	}
+	deleteCartItemByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetCartByUserIDStub        func(context.Context, int) (*domain.CartControllerResponseGetCart, error)
+	getCartByUserIDMutex       sync.RWMutex
+	getCartByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getCartByUserIDReturns struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}
+	getCartByUserIDReturnsOnCall map[int]struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}
+	GetCartByUserIDMiddlewareStub        func(context.Context, int) (*domain.CartModel, error)
+	getCartByUserIDMiddlewareMutex       sync.RWMutex
+	getCartByUserIDMiddlewareArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getCartByUserIDMiddlewareReturns struct {
+		result1 *domain.CartModel
+		result2 error
+	}
+	getCartByUserIDMiddlewareReturnsOnCall map[int]struct {
+		result1 *domain.CartModel
+		result2 error
+	}
+	GetCartItemByProductIDStub        func(context.Context, int, int) (*domain.CartItemModel, error)
+	getCartItemByProductIDMutex       sync.RWMutex
+	getCartItemByProductIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+	}
+	getCartItemByProductIDReturns struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}
+	getCartItemByProductIDReturnsOnCall map[int]struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}
+	GetCartItemByUIDStub        func(context.Context, string) (*domain.CartItemModel, error)
+	getCartItemByUIDMutex       sync.RWMutex
+	getCartItemByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getCartItemByUIDReturns struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}
+	getCartItemByUIDReturnsOnCall map[int]struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}
+	GetGuestCartStub        func(context.Context, string) (*domain.CartControllerResponseGetCart, error)
+	getGuestCartMutex       sync.RWMutex
+	getGuestCartArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getGuestCartReturns struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}
+	getGuestCartReturnsOnCall map[int]struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}
+	GetGuestCartMiddlewareStub        func(context.Context, string) (*domain.CartModel, error)
+	getGuestCartMiddlewareMutex       sync.RWMutex
+	getGuestCartMiddlewareArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getGuestCartMiddlewareReturns struct {
+		result1 *domain.CartModel
+		result2 error
+	}
+	getGuestCartMiddlewareReturnsOnCall map[int]struct {
+		result1 *domain.CartModel
+		result2 error
+	}
+	GetProductByUIDStub        func(context.Context, string) (*domain.ProductModel, error)
+	getProductByUIDMutex       sync.RWMutex
+	getProductByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getProductByUIDReturns struct {
+		result1 *domain.ProductModel
+		result2 error
+	}
+	getProductByUIDReturnsOnCall map[int]struct {
+		result1 *domain.ProductModel
+		result2 error
+	}
+	MergeGuestCartStub        func(context.Context, string, int) error
+	mergeGuestCartMutex       sync.RWMutex
+	mergeGuestCartArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	mergeGuestCartReturns struct {
+		result1 error
+	}
+	mergeGuestCartReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateCartItemStub        func(context.Context, *domain.CartUsecasePayloadUpdateCartItem) error
+	updateCartItemMutex       sync.RWMutex
+	updateCartItemArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.CartUsecasePayloadUpdateCartItem
+	}
+	updateCartItemReturns struct {
+		result1 error
+	}
+	updateCartItemReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *CartUsecaseMock) CreateCartItem(arg1 context.Context, arg2 *domain.CartUsecasePayloadCreateCartItem) (string, error) {
+	fake.createCartItemMutex.Lock()
+	ret, specificReturn := fake.createCartItemReturnsOnCall[len(fake.createCartItemArgsForCall)]
+	fake.createCartItemArgsForCall = append(fake.createCartItemArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.CartUsecasePayloadCreateCartItem
+	}{arg1, arg2})
+	stub := fake.CreateCartItemStub
+	fakeReturns := fake.createCartItemReturns
+	fake.recordInvocation("CreateCartItem", []interface{}{arg1, arg2})
+	fake.createCartItemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) CreateCartItemCallCount() int {
+	fake.createCartItemMutex.RLock()
+	defer fake.createCartItemMutex.RUnlock()
+	return len(fake.createCartItemArgsForCall)
+}
+
+func (fake *CartUsecaseMock) CreateCartItemCalls(stub func(context.Context, *domain.CartUsecasePayloadCreateCartItem) (string, error)) {
+	fake.createCartItemMutex.Lock()
+	defer fake.createCartItemMutex.Unlock()
+	fake.CreateCartItemStub = stub
+}
+
+func (fake *CartUsecaseMock) CreateCartItemArgsForCall(i int) (context.Context, *domain.CartUsecasePayloadCreateCartItem) {
+	fake.createCartItemMutex.RLock()
+	defer fake.createCartItemMutex.RUnlock()
+	argsForCall := fake.createCartItemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) CreateCartItemReturns(result1 string, result2 error) {
+	fake.createCartItemMutex.Lock()
+	defer fake.createCartItemMutex.Unlock()
+	fake.CreateCartItemStub = nil
+	fake.createCartItemReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) CreateCartItemReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createCartItemMutex.Lock()
+	defer fake.createCartItemMutex.Unlock()
+	fake.CreateCartItemStub = nil
+	if fake.createCartItemReturnsOnCall == nil {
+		fake.createCartItemReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createCartItemReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) CreateGuestCart(arg1 context.Context) (string, error) {
+	fake.createGuestCartMutex.Lock()
+	ret, specificReturn := fake.createGuestCartReturnsOnCall[len(fake.createGuestCartArgsForCall)]
+	fake.createGuestCartArgsForCall = append(fake.createGuestCartArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CreateGuestCartStub
+	fakeReturns := fake.createGuestCartReturns
+	fake.recordInvocation("CreateGuestCart", []interface{}{arg1})
+	fake.createGuestCartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) CreateGuestCartCallCount() int {
+	fake.createGuestCartMutex.RLock()
+	defer fake.createGuestCartMutex.RUnlock()
+	return len(fake.createGuestCartArgsForCall)
+}
+
+func (fake *CartUsecaseMock) CreateGuestCartCalls(stub func(context.Context) (string, error)) {
+	fake.createGuestCartMutex.Lock()
+	defer fake.createGuestCartMutex.Unlock()
+	fake.CreateGuestCartStub = stub
+}
+
+func (fake *CartUsecaseMock) CreateGuestCartArgsForCall(i int) context.Context {
+	fake.createGuestCartMutex.RLock()
+	defer fake.createGuestCartMutex.RUnlock()
+	argsForCall := fake.createGuestCartArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CartUsecaseMock) CreateGuestCartReturns(result1 string, result2 error) {
+	fake.createGuestCartMutex.Lock()
+	defer fake.createGuestCartMutex.Unlock()
+	fake.CreateGuestCartStub = nil
+	fake.createGuestCartReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) CreateGuestCartReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createGuestCartMutex.Lock()
+	defer fake.createGuestCartMutex.Unlock()
+	fake.CreateGuestCartStub = nil
+	if fake.createGuestCartReturnsOnCall == nil {
+		fake.createGuestCartReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createGuestCartReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) DeleteCartItemByUID(arg1 context.Context, arg2 *domain.CartUsecasePayloadDeleteCartItem) error {
+	fake.deleteCartItemByUIDMutex.Lock()
+	ret, specificReturn := fake.deleteCartItemByUIDReturnsOnCall[len(fake.deleteCartItemByUIDArgsForCall)]
+	fake.deleteCartItemByUIDArgsForCall = append(fake.deleteCartItemByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.CartUsecasePayloadDeleteCartItem
+	}{arg1, arg2})
+	stub := fake.DeleteCartItemByUIDStub
+	fakeReturns := fake.deleteCartItemByUIDReturns
+	fake.recordInvocation("DeleteCartItemByUID", []interface{}{arg1, arg2})
+	fake.deleteCartItemByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CartUsecaseMock) DeleteCartItemByUIDCallCount() int {
+	fake.deleteCartItemByUIDMutex.RLock()
+	defer fake.deleteCartItemByUIDMutex.RUnlock()
+	return len(fake.deleteCartItemByUIDArgsForCall)
+}
+
+func (fake *CartUsecaseMock) DeleteCartItemByUIDCalls(stub func(context.Context, *domain.CartUsecasePayloadDeleteCartItem) error) {
+	fake.deleteCartItemByUIDMutex.Lock()
+	defer fake.deleteCartItemByUIDMutex.Unlock()
+	fake.DeleteCartItemByUIDStub = stub
+}
+
+func (fake *CartUsecaseMock) DeleteCartItemByUIDArgsForCall(i int) (context.Context, *domain.CartUsecasePayloadDeleteCartItem) {
+	fake.deleteCartItemByUIDMutex.RLock()
+	defer fake.deleteCartItemByUIDMutex.RUnlock()
+	argsForCall := fake.deleteCartItemByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) DeleteCartItemByUIDReturns(result1 error) {
+	fake.deleteCartItemByUIDMutex.Lock()
+	defer fake.deleteCartItemByUIDMutex.Unlock()
+	fake.DeleteCartItemByUIDStub = nil
+	fake.deleteCartItemByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CartUsecaseMock) DeleteCartItemByUIDReturnsOnCall(i int, result1 error) {
+	fake.deleteCartItemByUIDMutex.Lock()
+	defer fake.deleteCartItemByUIDMutex.Unlock()
+	fake.DeleteCartItemByUIDStub = nil
+	if fake.deleteCartItemByUIDReturnsOnCall == nil {
+		fake.deleteCartItemByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteCartItemByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CartUsecaseMock) GetCartByUserID(arg1 context.Context, arg2 int) (*domain.CartControllerResponseGetCart, error) {
+	fake.getCartByUserIDMutex.Lock()
+	ret, specificReturn := fake.getCartByUserIDReturnsOnCall[len(fake.getCartByUserIDArgsForCall)]
+	fake.getCartByUserIDArgsForCall = append(fake.getCartByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetCartByUserIDStub
+	fakeReturns := fake.getCartByUserIDReturns
+	fake.recordInvocation("GetCartByUserID", []interface{}{arg1, arg2})
+	fake.getCartByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDCallCount() int {
+	fake.getCartByUserIDMutex.RLock()
+	defer fake.getCartByUserIDMutex.RUnlock()
+	return len(fake.getCartByUserIDArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDCalls(stub func(context.Context, int) (*domain.CartControllerResponseGetCart, error)) {
+	fake.getCartByUserIDMutex.Lock()
+	defer fake.getCartByUserIDMutex.Unlock()
+	fake.GetCartByUserIDStub = stub
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDArgsForCall(i int) (context.Context, int) {
+	fake.getCartByUserIDMutex.RLock()
+	defer fake.getCartByUserIDMutex.RUnlock()
+	argsForCall := fake.getCartByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDReturns(result1 *domain.CartControllerResponseGetCart, result2 error) {
+	fake.getCartByUserIDMutex.Lock()
+	defer fake.getCartByUserIDMutex.Unlock()
+	fake.GetCartByUserIDStub = nil
+	fake.getCartByUserIDReturns = struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDReturnsOnCall(i int, result1 *domain.CartControllerResponseGetCart, result2 error) {
+	fake.getCartByUserIDMutex.Lock()
+	defer fake.getCartByUserIDMutex.Unlock()
+	fake.GetCartByUserIDStub = nil
+	if fake.getCartByUserIDReturnsOnCall == nil {
+		fake.getCartByUserIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.CartControllerResponseGetCart
+			result2 error
+		})
+	}
+	fake.getCartByUserIDReturnsOnCall[i] = struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDMiddleware(arg1 context.Context, arg2 int) (*domain.CartModel, error) {
+	fake.getCartByUserIDMiddlewareMutex.Lock()
+	ret, specificReturn := fake.getCartByUserIDMiddlewareReturnsOnCall[len(fake.getCartByUserIDMiddlewareArgsForCall)]
+	fake.getCartByUserIDMiddlewareArgsForCall = append(fake.getCartByUserIDMiddlewareArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetCartByUserIDMiddlewareStub
+	fakeReturns := fake.getCartByUserIDMiddlewareReturns
+	fake.recordInvocation("GetCartByUserIDMiddleware", []interface{}{arg1, arg2})
+	fake.getCartByUserIDMiddlewareMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDMiddlewareCallCount() int {
+	fake.getCartByUserIDMiddlewareMutex.RLock()
+	defer fake.getCartByUserIDMiddlewareMutex.RUnlock()
+	return len(fake.getCartByUserIDMiddlewareArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDMiddlewareCalls(stub func(context.Context, int) (*domain.CartModel, error)) {
+	fake.getCartByUserIDMiddlewareMutex.Lock()
+	defer fake.getCartByUserIDMiddlewareMutex.Unlock()
+	fake.GetCartByUserIDMiddlewareStub = stub
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDMiddlewareArgsForCall(i int) (context.Context, int) {
+	fake.getCartByUserIDMiddlewareMutex.RLock()
+	defer fake.getCartByUserIDMiddlewareMutex.RUnlock()
+	argsForCall := fake.getCartByUserIDMiddlewareArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDMiddlewareReturns(result1 *domain.CartModel, result2 error) {
+	fake.getCartByUserIDMiddlewareMutex.Lock()
+	defer fake.getCartByUserIDMiddlewareMutex.Unlock()
+	fake.GetCartByUserIDMiddlewareStub = nil
+	fake.getCartByUserIDMiddlewareReturns = struct {
+		result1 *domain.CartModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartByUserIDMiddlewareReturnsOnCall(i int, result1 *domain.CartModel, result2 error) {
+	fake.getCartByUserIDMiddlewareMutex.Lock()
+	defer fake.getCartByUserIDMiddlewareMutex.Unlock()
+	fake.GetCartByUserIDMiddlewareStub = nil
+	if fake.getCartByUserIDMiddlewareReturnsOnCall == nil {
+		fake.getCartByUserIDMiddlewareReturnsOnCall = make(map[int]struct {
+			result1 *domain.CartModel
+			result2 error
+		})
+	}
+	fake.getCartByUserIDMiddlewareReturnsOnCall[i] = struct {
+		result1 *domain.CartModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartItemByProductID(arg1 context.Context, arg2 int, arg3 int) (*domain.CartItemModel, error) {
+	fake.getCartItemByProductIDMutex.Lock()
+	ret, specificReturn := fake.getCartItemByProductIDReturnsOnCall[len(fake.getCartItemByProductIDArgsForCall)]
+	fake.getCartItemByProductIDArgsForCall = append(fake.getCartItemByProductIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetCartItemByProductIDStub
+	fakeReturns := fake.getCartItemByProductIDReturns
+	fake.recordInvocation("GetCartItemByProductID", []interface{}{arg1, arg2, arg3})
+	fake.getCartItemByProductIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetCartItemByProductIDCallCount() int {
+	fake.getCartItemByProductIDMutex.RLock()
+	defer fake.getCartItemByProductIDMutex.RUnlock()
+	return len(fake.getCartItemByProductIDArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetCartItemByProductIDCalls(stub func(context.Context, int, int) (*domain.CartItemModel, error)) {
+	fake.getCartItemByProductIDMutex.Lock()
+	defer fake.getCartItemByProductIDMutex.Unlock()
+	fake.GetCartItemByProductIDStub = stub
+}
+
+func (fake *CartUsecaseMock) GetCartItemByProductIDArgsForCall(i int) (context.Context, int, int) {
+	fake.getCartItemByProductIDMutex.RLock()
+	defer fake.getCartItemByProductIDMutex.RUnlock()
+	argsForCall := fake.getCartItemByProductIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *CartUsecaseMock) GetCartItemByProductIDReturns(result1 *domain.CartItemModel, result2 error) {
+	fake.getCartItemByProductIDMutex.Lock()
+	defer fake.getCartItemByProductIDMutex.Unlock()
+	fake.GetCartItemByProductIDStub = nil
+	fake.getCartItemByProductIDReturns = struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartItemByProductIDReturnsOnCall(i int, result1 *domain.CartItemModel, result2 error) {
+	fake.getCartItemByProductIDMutex.Lock()
+	defer fake.getCartItemByProductIDMutex.Unlock()
+	fake.GetCartItemByProductIDStub = nil
+	if fake.getCartItemByProductIDReturnsOnCall == nil {
+		fake.getCartItemByProductIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.CartItemModel
+			result2 error
+		})
+	}
+	fake.getCartItemByProductIDReturnsOnCall[i] = struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartItemByUID(arg1 context.Context, arg2 string) (*domain.CartItemModel, error) {
+	fake.getCartItemByUIDMutex.Lock()
+	ret, specificReturn := fake.getCartItemByUIDReturnsOnCall[len(fake.getCartItemByUIDArgsForCall)]
+	fake.getCartItemByUIDArgsForCall = append(fake.getCartItemByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCartItemByUIDStub
+	fakeReturns := fake.getCartItemByUIDReturns
+	fake.recordInvocation("GetCartItemByUID", []interface{}{arg1, arg2})
+	fake.getCartItemByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetCartItemByUIDCallCount() int {
+	fake.getCartItemByUIDMutex.RLock()
+	defer fake.getCartItemByUIDMutex.RUnlock()
+	return len(fake.getCartItemByUIDArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetCartItemByUIDCalls(stub func(context.Context, string) (*domain.CartItemModel, error)) {
+	fake.getCartItemByUIDMutex.Lock()
+	defer fake.getCartItemByUIDMutex.Unlock()
+	fake.GetCartItemByUIDStub = stub
+}
+
+func (fake *CartUsecaseMock) GetCartItemByUIDArgsForCall(i int) (context.Context, string) {
+	fake.getCartItemByUIDMutex.RLock()
+	defer fake.getCartItemByUIDMutex.RUnlock()
+	argsForCall := fake.getCartItemByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) GetCartItemByUIDReturns(result1 *domain.CartItemModel, result2 error) {
+	fake.getCartItemByUIDMutex.Lock()
+	defer fake.getCartItemByUIDMutex.Unlock()
+	fake.GetCartItemByUIDStub = nil
+	fake.getCartItemByUIDReturns = struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetCartItemByUIDReturnsOnCall(i int, result1 *domain.CartItemModel, result2 error) {
+	fake.getCartItemByUIDMutex.Lock()
+	defer fake.getCartItemByUIDMutex.Unlock()
+	fake.GetCartItemByUIDStub = nil
+	if fake.getCartItemByUIDReturnsOnCall == nil {
+		fake.getCartItemByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.CartItemModel
+			result2 error
+		})
+	}
+	fake.getCartItemByUIDReturnsOnCall[i] = struct {
+		result1 *domain.CartItemModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetGuestCart(arg1 context.Context, arg2 string) (*domain.CartControllerResponseGetCart, error) {
+	fake.getGuestCartMutex.Lock()
+	ret, specificReturn := fake.getGuestCartReturnsOnCall[len(fake.getGuestCartArgsForCall)]
+	fake.getGuestCartArgsForCall = append(fake.getGuestCartArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetGuestCartStub
+	fakeReturns := fake.getGuestCartReturns
+	fake.recordInvocation("GetGuestCart", []interface{}{arg1, arg2})
+	fake.getGuestCartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetGuestCartCallCount() int {
+	fake.getGuestCartMutex.RLock()
+	defer fake.getGuestCartMutex.RUnlock()
+	return len(fake.getGuestCartArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetGuestCartCalls(stub func(context.Context, string) (*domain.CartControllerResponseGetCart, error)) {
+	fake.getGuestCartMutex.Lock()
+	defer fake.getGuestCartMutex.Unlock()
+	fake.GetGuestCartStub = stub
+}
+
+func (fake *CartUsecaseMock) GetGuestCartArgsForCall(i int) (context.Context, string) {
+	fake.getGuestCartMutex.RLock()
+	defer fake.getGuestCartMutex.RUnlock()
+	argsForCall := fake.getGuestCartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) GetGuestCartReturns(result1 *domain.CartControllerResponseGetCart, result2 error) {
+	fake.getGuestCartMutex.Lock()
+	defer fake.getGuestCartMutex.Unlock()
+	fake.GetGuestCartStub = nil
+	fake.getGuestCartReturns = struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetGuestCartReturnsOnCall(i int, result1 *domain.CartControllerResponseGetCart, result2 error) {
+	fake.getGuestCartMutex.Lock()
+	defer fake.getGuestCartMutex.Unlock()
+	fake.GetGuestCartStub = nil
+	if fake.getGuestCartReturnsOnCall == nil {
+		fake.getGuestCartReturnsOnCall = make(map[int]struct {
+			result1 *domain.CartControllerResponseGetCart
+			result2 error
+		})
+	}
+	fake.getGuestCartReturnsOnCall[i] = struct {
+		result1 *domain.CartControllerResponseGetCart
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetGuestCartMiddleware(arg1 context.Context, arg2 string) (*domain.CartModel, error) {
+	fake.getGuestCartMiddlewareMutex.Lock()
+	ret, specificReturn := fake.getGuestCartMiddlewareReturnsOnCall[len(fake.getGuestCartMiddlewareArgsForCall)]
+	fake.getGuestCartMiddlewareArgsForCall = append(fake.getGuestCartMiddlewareArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetGuestCartMiddlewareStub
+	fakeReturns := fake.getGuestCartMiddlewareReturns
+	fake.recordInvocation("GetGuestCartMiddleware", []interface{}{arg1, arg2})
+	fake.getGuestCartMiddlewareMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetGuestCartMiddlewareCallCount() int {
+	fake.getGuestCartMiddlewareMutex.RLock()
+	defer fake.getGuestCartMiddlewareMutex.RUnlock()
+	return len(fake.getGuestCartMiddlewareArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetGuestCartMiddlewareCalls(stub func(context.Context, string) (*domain.CartModel, error)) {
+	fake.getGuestCartMiddlewareMutex.Lock()
+	defer fake.getGuestCartMiddlewareMutex.Unlock()
+	fake.GetGuestCartMiddlewareStub = stub
+}
+
+func (fake *CartUsecaseMock) GetGuestCartMiddlewareArgsForCall(i int) (context.Context, string) {
+	fake.getGuestCartMiddlewareMutex.RLock()
+	defer fake.getGuestCartMiddlewareMutex.RUnlock()
+	argsForCall := fake.getGuestCartMiddlewareArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) GetGuestCartMiddlewareReturns(result1 *domain.CartModel, result2 error) {
+	fake.getGuestCartMiddlewareMutex.Lock()
+	defer fake.getGuestCartMiddlewareMutex.Unlock()
+	fake.GetGuestCartMiddlewareStub = nil
+	fake.getGuestCartMiddlewareReturns = struct {
+		result1 *domain.CartModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetGuestCartMiddlewareReturnsOnCall(i int, result1 *domain.CartModel, result2 error) {
+	fake.getGuestCartMiddlewareMutex.Lock()
+	defer fake.getGuestCartMiddlewareMutex.Unlock()
+	fake.GetGuestCartMiddlewareStub = nil
+	if fake.getGuestCartMiddlewareReturnsOnCall == nil {
+		fake.getGuestCartMiddlewareReturnsOnCall = make(map[int]struct {
+			result1 *domain.CartModel
+			result2 error
+		})
+	}
+	fake.getGuestCartMiddlewareReturnsOnCall[i] = struct {
+		result1 *domain.CartModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetProductByUID(arg1 context.Context, arg2 string) (*domain.ProductModel, error) {
+	fake.getProductByUIDMutex.Lock()
+	ret, specificReturn := fake.getProductByUIDReturnsOnCall[len(fake.getProductByUIDArgsForCall)]
+	fake.getProductByUIDArgsForCall = append(fake.getProductByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetProductByUIDStub
+	fakeReturns := fake.getProductByUIDReturns
+	fake.recordInvocation("GetProductByUID", []interface{}{arg1, arg2})
+	fake.getProductByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CartUsecaseMock) GetProductByUIDCallCount() int {
+	fake.getProductByUIDMutex.RLock()
+	defer fake.getProductByUIDMutex.RUnlock()
+	return len(fake.getProductByUIDArgsForCall)
+}
+
+func (fake *CartUsecaseMock) GetProductByUIDCalls(stub func(context.Context, string) (*domain.ProductModel, error)) {
+	fake.getProductByUIDMutex.Lock()
+	defer fake.getProductByUIDMutex.Unlock()
+	fake.GetProductByUIDStub = stub
+}
+
+func (fake *CartUsecaseMock) GetProductByUIDArgsForCall(i int) (context.Context, string) {
+	fake.getProductByUIDMutex.RLock()
+	defer fake.getProductByUIDMutex.RUnlock()
+	argsForCall := fake.getProductByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) GetProductByUIDReturns(result1 *domain.ProductModel, result2 error) {
+	fake.getProductByUIDMutex.Lock()
+	defer fake.getProductByUIDMutex.Unlock()
+	fake.GetProductByUIDStub = nil
+	fake.getProductByUIDReturns = struct {
+		result1 *domain.ProductModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) GetProductByUIDReturnsOnCall(i int, result1 *domain.ProductModel, result2 error) {
+	fake.getProductByUIDMutex.Lock()
+	defer fake.getProductByUIDMutex.Unlock()
+	fake.GetProductByUIDStub = nil
+	if fake.getProductByUIDReturnsOnCall == nil {
+		fake.getProductByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.ProductModel
+			result2 error
+		})
+	}
+	fake.getProductByUIDReturnsOnCall[i] = struct {
+		result1 *domain.ProductModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CartUsecaseMock) MergeGuestCart(arg1 context.Context, arg2 string, arg3 int) error {
+	fake.mergeGuestCartMutex.Lock()
+	ret, specificReturn := fake.mergeGuestCartReturnsOnCall[len(fake.mergeGuestCartArgsForCall)]
+	fake.mergeGuestCartArgsForCall = append(fake.mergeGuestCartArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.MergeGuestCartStub
+	fakeReturns := fake.mergeGuestCartReturns
+	fake.recordInvocation("MergeGuestCart", []interface{}{arg1, arg2, arg3})
+	fake.mergeGuestCartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CartUsecaseMock) MergeGuestCartCallCount() int {
+	fake.mergeGuestCartMutex.RLock()
+	defer fake.mergeGuestCartMutex.RUnlock()
+	return len(fake.mergeGuestCartArgsForCall)
+}
+
+func (fake *CartUsecaseMock) MergeGuestCartCalls(stub func(context.Context, string, int) error) {
+	fake.mergeGuestCartMutex.Lock()
+	defer fake.mergeGuestCartMutex.Unlock()
+	fake.MergeGuestCartStub = stub
+}
+
+func (fake *CartUsecaseMock) MergeGuestCartArgsForCall(i int) (context.Context, string, int) {
+	fake.mergeGuestCartMutex.RLock()
+	defer fake.mergeGuestCartMutex.RUnlock()
+	argsForCall := fake.mergeGuestCartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *CartUsecaseMock) MergeGuestCartReturns(result1 error) {
+	fake.mergeGuestCartMutex.Lock()
+	defer fake.mergeGuestCartMutex.Unlock()
+	fake.MergeGuestCartStub = nil
+	fake.mergeGuestCartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CartUsecaseMock) MergeGuestCartReturnsOnCall(i int, result1 error) {
+	fake.mergeGuestCartMutex.Lock()
+	defer fake.mergeGuestCartMutex.Unlock()
+	fake.MergeGuestCartStub = nil
+	if fake.mergeGuestCartReturnsOnCall == nil {
+		fake.mergeGuestCartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.mergeGuestCartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CartUsecaseMock) UpdateCartItem(arg1 context.Context, arg2 *domain.CartUsecasePayloadUpdateCartItem) error {
+	fake.updateCartItemMutex.Lock()
+	ret, specificReturn := fake.updateCartItemReturnsOnCall[len(fake.updateCartItemArgsForCall)]
+	fake.updateCartItemArgsForCall = append(fake.updateCartItemArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.CartUsecasePayloadUpdateCartItem
+	}{arg1, arg2})
+	stub := fake.UpdateCartItemStub
+	fakeReturns := fake.updateCartItemReturns
+	fake.recordInvocation("UpdateCartItem", []interface{}{arg1, arg2})
+	fake.updateCartItemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CartUsecaseMock) UpdateCartItemCallCount() int {
+	fake.updateCartItemMutex.RLock()
+	defer fake.updateCartItemMutex.RUnlock()
+	return len(fake.updateCartItemArgsForCall)
+}
+
+func (fake *CartUsecaseMock) UpdateCartItemCalls(stub func(context.Context, *domain.CartUsecasePayloadUpdateCartItem) error) {
+	fake.updateCartItemMutex.Lock()
+	defer fake.updateCartItemMutex.Unlock()
+	fake.UpdateCartItemStub = stub
+}
+
+func (fake *CartUsecaseMock) UpdateCartItemArgsForCall(i int) (context.Context, *domain.CartUsecasePayloadUpdateCartItem) {
+	fake.updateCartItemMutex.RLock()
+	defer fake.updateCartItemMutex.RUnlock()
+	argsForCall := fake.updateCartItemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CartUsecaseMock) UpdateCartItemReturns(result1 error) {
+	fake.updateCartItemMutex.Lock()
+	defer fake.updateCartItemMutex.Unlock()
+	fake.UpdateCartItemStub = nil
+	fake.updateCartItemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CartUsecaseMock) UpdateCartItemReturnsOnCall(i int, result1 error) {
+	fake.updateCartItemMutex.Lock()
+	defer fake.updateCartItemMutex.Unlock()
+	fake.UpdateCartItemStub = nil
+	if fake.updateCartItemReturnsOnCall == nil {
+		fake.updateCartItemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCartItemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CartUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createCartItemMutex.RLock()
+	defer fake.createCartItemMutex.RUnlock()
+	fake.createGuestCartMutex.RLock()
+	defer fake.createGuestCartMutex.RUnlock()
+	fake.deleteCartItemByUIDMutex.RLock()
+	defer fake.deleteCartItemByUIDMutex.RUnlock()
+	fake.getCartByUserIDMutex.RLock()
+	defer fake.getCartByUserIDMutex.RUnlock()
+	fake.getCartByUserIDMiddlewareMutex.RLock()
+	defer fake.getCartByUserIDMiddlewareMutex.RUnlock()
+	fake.getCartItemByProductIDMutex.RLock()
+	defer fake.getCartItemByProductIDMutex.RUnlock()
+	fake.getCartItemByUIDMutex.RLock()
+	defer fake.getCartItemByUIDMutex.RUnlock()
+	fake.getGuestCartMutex.RLock()
+	defer fake.getGuestCartMutex.RUnlock()
+	fake.getGuestCartMiddlewareMutex.RLock()
+	defer fake.getGuestCartMiddlewareMutex.RUnlock()
+	fake.getProductByUIDMutex.RLock()
+	defer fake.getProductByUIDMutex.RUnlock()
+	fake.mergeGuestCartMutex.RLock()
+	defer fake.mergeGuestCartMutex.RUnlock()
+	fake.updateCartItemMutex.RLock()
+	defer fake.updateCartItemMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *CartUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.CartUsecase = new(CartUsecaseMock)
@@ -0,0 +1,513 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type CredentialRepositoryMock struct {
+	CreateCredentialStub        func(context.Context, *domain.CredentialRepositoryPayloadCreateCredential) error
+	createCredentialMutex       sync.RWMutex
+	createCredentialArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.CredentialRepositoryPayloadCreateCredential
+	}
+	createCredentialReturns struct {
+		result1 error
+	}
+	createCredentialReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteCredentialByUIDStub        func(context.Context, string) error
+	deleteCredentialByUIDMutex       sync.RWMutex
+	deleteCredentialByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteCredentialByUIDReturns struct {
+		result1 error
+	}
+	deleteCredentialByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetCredentialByEmailStub        func(context.Context, string) (*domain.CredentialModel, error)
+	getCredentialByEmailMutex       sync.RWMutex
+	getCredentialByEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getCredentialByEmailReturns struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}
+	getCredentialByEmailReturnsOnCall map[int]struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}
+	GetCredentialByUIDStub        func(context.Context, string) (*domain.CredentialModel, error)
+	getCredentialByUIDMutex       sync.RWMutex
+	getCredentialByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getCredentialByUIDReturns struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}
+	getCredentialByUIDReturnsOnCall map[int]struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}
+	UpdateEmailByUIDStub        func(context.Context, string, string, time.Time) error
+	updateEmailByUIDMutex       sync.RWMutex
+	updateEmailByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	updateEmailByUIDReturns struct {
+		result1 error
+	}
+	updateEmailByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdatePasswordByUIDStub        func(context.Context, string, string, time.Time) error
+	updatePasswordByUIDMutex       sync.RWMutex
+	updatePasswordByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	updatePasswordByUIDReturns struct {
+		result1 error
+	}
+	updatePasswordByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *CredentialRepositoryMock) CreateCredential(arg1 context.Context, arg2 *domain.CredentialRepositoryPayloadCreateCredential) error {
+	fake.createCredentialMutex.Lock()
+	ret, specificReturn := fake.createCredentialReturnsOnCall[len(fake.createCredentialArgsForCall)]
+	fake.createCredentialArgsForCall = append(fake.createCredentialArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.CredentialRepositoryPayloadCreateCredential
+	}{arg1, arg2})
+	stub := fake.CreateCredentialStub
+	fakeReturns := fake.createCredentialReturns
+	fake.recordInvocation("CreateCredential", []interface{}{arg1, arg2})
+	fake.createCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CredentialRepositoryMock) CreateCredentialCallCount() int {
+	fake.createCredentialMutex.RLock()
+	defer fake.createCredentialMutex.RUnlock()
+	return len(fake.createCredentialArgsForCall)
+}
+
+func (fake *CredentialRepositoryMock) CreateCredentialCalls(stub func(context.Context, *domain.CredentialRepositoryPayloadCreateCredential) error) {
+	fake.createCredentialMutex.Lock()
+	defer fake.createCredentialMutex.Unlock()
+	fake.CreateCredentialStub = stub
+}
+
+func (fake *CredentialRepositoryMock) CreateCredentialArgsForCall(i int) (context.Context, *domain.CredentialRepositoryPayloadCreateCredential) {
+	fake.createCredentialMutex.RLock()
+	defer fake.createCredentialMutex.RUnlock()
+	argsForCall := fake.createCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CredentialRepositoryMock) CreateCredentialReturns(result1 error) {
+	fake.createCredentialMutex.Lock()
+	defer fake.createCredentialMutex.Unlock()
+	fake.CreateCredentialStub = nil
+	fake.createCredentialReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) CreateCredentialReturnsOnCall(i int, result1 error) {
+	fake.createCredentialMutex.Lock()
+	defer fake.createCredentialMutex.Unlock()
+	fake.CreateCredentialStub = nil
+	if fake.createCredentialReturnsOnCall == nil {
+		fake.createCredentialReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createCredentialReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) DeleteCredentialByUID(arg1 context.Context, arg2 string) error {
+	fake.deleteCredentialByUIDMutex.Lock()
+	ret, specificReturn := fake.deleteCredentialByUIDReturnsOnCall[len(fake.deleteCredentialByUIDArgsForCall)]
+	fake.deleteCredentialByUIDArgsForCall = append(fake.deleteCredentialByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteCredentialByUIDStub
+	fakeReturns := fake.deleteCredentialByUIDReturns
+	fake.recordInvocation("DeleteCredentialByUID", []interface{}{arg1, arg2})
+	fake.deleteCredentialByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CredentialRepositoryMock) DeleteCredentialByUIDCallCount() int {
+	fake.deleteCredentialByUIDMutex.RLock()
+	defer fake.deleteCredentialByUIDMutex.RUnlock()
+	return len(fake.deleteCredentialByUIDArgsForCall)
+}
+
+func (fake *CredentialRepositoryMock) DeleteCredentialByUIDCalls(stub func(context.Context, string) error) {
+	fake.deleteCredentialByUIDMutex.Lock()
+	defer fake.deleteCredentialByUIDMutex.Unlock()
+	fake.DeleteCredentialByUIDStub = stub
+}
+
+func (fake *CredentialRepositoryMock) DeleteCredentialByUIDArgsForCall(i int) (context.Context, string) {
+	fake.deleteCredentialByUIDMutex.RLock()
+	defer fake.deleteCredentialByUIDMutex.RUnlock()
+	argsForCall := fake.deleteCredentialByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CredentialRepositoryMock) DeleteCredentialByUIDReturns(result1 error) {
+	fake.deleteCredentialByUIDMutex.Lock()
+	defer fake.deleteCredentialByUIDMutex.Unlock()
+	fake.DeleteCredentialByUIDStub = nil
+	fake.deleteCredentialByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) DeleteCredentialByUIDReturnsOnCall(i int, result1 error) {
+	fake.deleteCredentialByUIDMutex.Lock()
+	defer fake.deleteCredentialByUIDMutex.Unlock()
+	fake.DeleteCredentialByUIDStub = nil
+	if fake.deleteCredentialByUIDReturnsOnCall == nil {
+		fake.deleteCredentialByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteCredentialByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByEmail(arg1 context.Context, arg2 string) (*domain.CredentialModel, error) {
+	fake.getCredentialByEmailMutex.Lock()
+	ret, specificReturn := fake.getCredentialByEmailReturnsOnCall[len(fake.getCredentialByEmailArgsForCall)]
+	fake.getCredentialByEmailArgsForCall = append(fake.getCredentialByEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCredentialByEmailStub
+	fakeReturns := fake.getCredentialByEmailReturns
+	fake.recordInvocation("GetCredentialByEmail", []interface{}{arg1, arg2})
+	fake.getCredentialByEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByEmailCallCount() int {
+	fake.getCredentialByEmailMutex.RLock()
+	defer fake.getCredentialByEmailMutex.RUnlock()
+	return len(fake.getCredentialByEmailArgsForCall)
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByEmailCalls(stub func(context.Context, string) (*domain.CredentialModel, error)) {
+	fake.getCredentialByEmailMutex.Lock()
+	defer fake.getCredentialByEmailMutex.Unlock()
+	fake.GetCredentialByEmailStub = stub
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByEmailArgsForCall(i int) (context.Context, string) {
+	fake.getCredentialByEmailMutex.RLock()
+	defer fake.getCredentialByEmailMutex.RUnlock()
+	argsForCall := fake.getCredentialByEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByEmailReturns(result1 *domain.CredentialModel, result2 error) {
+	fake.getCredentialByEmailMutex.Lock()
+	defer fake.getCredentialByEmailMutex.Unlock()
+	fake.GetCredentialByEmailStub = nil
+	fake.getCredentialByEmailReturns = struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByEmailReturnsOnCall(i int, result1 *domain.CredentialModel, result2 error) {
+	fake.getCredentialByEmailMutex.Lock()
+	defer fake.getCredentialByEmailMutex.Unlock()
+	fake.GetCredentialByEmailStub = nil
+	if fake.getCredentialByEmailReturnsOnCall == nil {
+		fake.getCredentialByEmailReturnsOnCall = make(map[int]struct {
+			result1 *domain.CredentialModel
+			result2 error
+		})
+	}
+	fake.getCredentialByEmailReturnsOnCall[i] = struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByUID(arg1 context.Context, arg2 string) (*domain.CredentialModel, error) {
+	fake.getCredentialByUIDMutex.Lock()
+	ret, specificReturn := fake.getCredentialByUIDReturnsOnCall[len(fake.getCredentialByUIDArgsForCall)]
+	fake.getCredentialByUIDArgsForCall = append(fake.getCredentialByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCredentialByUIDStub
+	fakeReturns := fake.getCredentialByUIDReturns
+	fake.recordInvocation("GetCredentialByUID", []interface{}{arg1, arg2})
+	fake.getCredentialByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByUIDCallCount() int {
+	fake.getCredentialByUIDMutex.RLock()
+	defer fake.getCredentialByUIDMutex.RUnlock()
+	return len(fake.getCredentialByUIDArgsForCall)
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByUIDCalls(stub func(context.Context, string) (*domain.CredentialModel, error)) {
+	fake.getCredentialByUIDMutex.Lock()
+	defer fake.getCredentialByUIDMutex.Unlock()
+	fake.GetCredentialByUIDStub = stub
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByUIDArgsForCall(i int) (context.Context, string) {
+	fake.getCredentialByUIDMutex.RLock()
+	defer fake.getCredentialByUIDMutex.RUnlock()
+	argsForCall := fake.getCredentialByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByUIDReturns(result1 *domain.CredentialModel, result2 error) {
+	fake.getCredentialByUIDMutex.Lock()
+	defer fake.getCredentialByUIDMutex.Unlock()
+	fake.GetCredentialByUIDStub = nil
+	fake.getCredentialByUIDReturns = struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CredentialRepositoryMock) GetCredentialByUIDReturnsOnCall(i int, result1 *domain.CredentialModel, result2 error) {
+	fake.getCredentialByUIDMutex.Lock()
+	defer fake.getCredentialByUIDMutex.Unlock()
+	fake.GetCredentialByUIDStub = nil
+	if fake.getCredentialByUIDReturnsOnCall == nil {
+		fake.getCredentialByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.CredentialModel
+			result2 error
+		})
+	}
+	fake.getCredentialByUIDReturnsOnCall[i] = struct {
+		result1 *domain.CredentialModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CredentialRepositoryMock) UpdateEmailByUID(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) error {
+	fake.updateEmailByUIDMutex.Lock()
+	ret, specificReturn := fake.updateEmailByUIDReturnsOnCall[len(fake.updateEmailByUIDArgsForCall)]
+	fake.updateEmailByUIDArgsForCall = append(fake.updateEmailByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateEmailByUIDStub
+	fakeReturns := fake.updateEmailByUIDReturns
+	fake.recordInvocation("UpdateEmailByUID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateEmailByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CredentialRepositoryMock) UpdateEmailByUIDCallCount() int {
+	fake.updateEmailByUIDMutex.RLock()
+	defer fake.updateEmailByUIDMutex.RUnlock()
+	return len(fake.updateEmailByUIDArgsForCall)
+}
+
+func (fake *CredentialRepositoryMock) UpdateEmailByUIDCalls(stub func(context.Context, string, string, time.Time) error) {
+	fake.updateEmailByUIDMutex.Lock()
+	defer fake.updateEmailByUIDMutex.Unlock()
+	fake.UpdateEmailByUIDStub = stub
+}
+
+func (fake *CredentialRepositoryMock) UpdateEmailByUIDArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.updateEmailByUIDMutex.RLock()
+	defer fake.updateEmailByUIDMutex.RUnlock()
+	argsForCall := fake.updateEmailByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *CredentialRepositoryMock) UpdateEmailByUIDReturns(result1 error) {
+	fake.updateEmailByUIDMutex.Lock()
+	defer fake.updateEmailByUIDMutex.Unlock()
+	fake.UpdateEmailByUIDStub = nil
+	fake.updateEmailByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) UpdateEmailByUIDReturnsOnCall(i int, result1 error) {
+	fake.updateEmailByUIDMutex.Lock()
+	defer fake.updateEmailByUIDMutex.Unlock()
+	fake.UpdateEmailByUIDStub = nil
+	if fake.updateEmailByUIDReturnsOnCall == nil {
+		fake.updateEmailByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateEmailByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) UpdatePasswordByUID(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) error {
+	fake.updatePasswordByUIDMutex.Lock()
+	ret, specificReturn := fake.updatePasswordByUIDReturnsOnCall[len(fake.updatePasswordByUIDArgsForCall)]
+	fake.updatePasswordByUIDArgsForCall = append(fake.updatePasswordByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdatePasswordByUIDStub
+	fakeReturns := fake.updatePasswordByUIDReturns
+	fake.recordInvocation("UpdatePasswordByUID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updatePasswordByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *CredentialRepositoryMock) UpdatePasswordByUIDCallCount() int {
+	fake.updatePasswordByUIDMutex.RLock()
+	defer fake.updatePasswordByUIDMutex.RUnlock()
+	return len(fake.updatePasswordByUIDArgsForCall)
+}
+
+func (fake *CredentialRepositoryMock) UpdatePasswordByUIDCalls(stub func(context.Context, string, string, time.Time) error) {
+	fake.updatePasswordByUIDMutex.Lock()
+	defer fake.updatePasswordByUIDMutex.Unlock()
+	fake.UpdatePasswordByUIDStub = stub
+}
+
+func (fake *CredentialRepositoryMock) UpdatePasswordByUIDArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.updatePasswordByUIDMutex.RLock()
+	defer fake.updatePasswordByUIDMutex.RUnlock()
+	argsForCall := fake.updatePasswordByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *CredentialRepositoryMock) UpdatePasswordByUIDReturns(result1 error) {
+	fake.updatePasswordByUIDMutex.Lock()
+	defer fake.updatePasswordByUIDMutex.Unlock()
+	fake.UpdatePasswordByUIDStub = nil
+	fake.updatePasswordByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) UpdatePasswordByUIDReturnsOnCall(i int, result1 error) {
+	fake.updatePasswordByUIDMutex.Lock()
+	defer fake.updatePasswordByUIDMutex.Unlock()
+	fake.UpdatePasswordByUIDStub = nil
+	if fake.updatePasswordByUIDReturnsOnCall == nil {
+		fake.updatePasswordByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updatePasswordByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *CredentialRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createCredentialMutex.RLock()
+	defer fake.createCredentialMutex.RUnlock()
+	fake.deleteCredentialByUIDMutex.RLock()
+	defer fake.deleteCredentialByUIDMutex.RUnlock()
+	fake.getCredentialByEmailMutex.RLock()
+	defer fake.getCredentialByEmailMutex.RUnlock()
+	fake.getCredentialByUIDMutex.RLock()
+	defer fake.getCredentialByUIDMutex.RUnlock()
+	fake.updateEmailByUIDMutex.RLock()
+	defer fake.updateEmailByUIDMutex.RUnlock()
+	fake.updatePasswordByUIDMutex.RLock()
+	defer fake.updatePasswordByUIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *CredentialRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.CredentialRepository = new(CredentialRepositoryMock)
@@ -0,0 +1,527 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type DataExportRepositoryMock struct {
+	CompleteDataExportStub        func(context.Context, string, []byte, time.Time) error
+	completeDataExportMutex       sync.RWMutex
+	completeDataExportArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Time
+	}
+	completeDataExportReturns struct {
+		result1 error
+	}
+	completeDataExportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateDataExportStub        func(context.Context, *domain.DataExportRepositoryPayloadCreateDataExport) error
+	createDataExportMutex       sync.RWMutex
+	createDataExportArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.DataExportRepositoryPayloadCreateDataExport
+	}
+	createDataExportReturns struct {
+		result1 error
+	}
+	createDataExportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FailDataExportStub        func(context.Context, string, string, time.Time) error
+	failDataExportMutex       sync.RWMutex
+	failDataExportArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	failDataExportReturns struct {
+		result1 error
+	}
+	failDataExportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FailPendingDataExportsStub        func(context.Context, time.Time, string, time.Time) (int64, error)
+	failPendingDataExportsMutex       sync.RWMutex
+	failPendingDataExportsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 string
+		arg4 time.Time
+	}
+	failPendingDataExportsReturns struct {
+		result1 int64
+		result2 error
+	}
+	failPendingDataExportsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetLatestDataExportByUserIDStub        func(context.Context, int) (*domain.DataExportModel, error)
+	getLatestDataExportByUserIDMutex       sync.RWMutex
+	getLatestDataExportByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getLatestDataExportByUserIDReturns struct {
+		result1 *domain.DataExportModel
+		result2 error
+	}
+	getLatestDataExportByUserIDReturnsOnCall map[int]struct {
+		result1 *domain.DataExportModel
+		result2 error
+	}
+	ListPendingDataExportsStub        func(context.Context, time.Time) ([]*domain.DataExportModel, error)
+	listPendingDataExportsMutex       sync.RWMutex
+	listPendingDataExportsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	listPendingDataExportsReturns struct {
+		result1 []*domain.DataExportModel
+		result2 error
+	}
+	listPendingDataExportsReturnsOnCall map[int]struct {
+		result1 []*domain.DataExportModel
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *DataExportRepositoryMock) CompleteDataExport(arg1 context.Context, arg2 string, arg3 []byte, arg4 time.Time) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.completeDataExportMutex.Lock()
+	ret, specificReturn := fake.completeDataExportReturnsOnCall[len(fake.completeDataExportArgsForCall)]
+	fake.completeDataExportArgsForCall = append(fake.completeDataExportArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 time.Time
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.CompleteDataExportStub
+	fakeReturns := fake.completeDataExportReturns
+	fake.recordInvocation("CompleteDataExport", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.completeDataExportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *DataExportRepositoryMock) CompleteDataExportCallCount() int {
+	fake.completeDataExportMutex.RLock()
+	defer fake.completeDataExportMutex.RUnlock()
+	return len(fake.completeDataExportArgsForCall)
+}
+
+func (fake *DataExportRepositoryMock) CompleteDataExportCalls(stub func(context.Context, string, []byte, time.Time) error) {
+	fake.completeDataExportMutex.Lock()
+	defer fake.completeDataExportMutex.Unlock()
+	fake.CompleteDataExportStub = stub
+}
+
+func (fake *DataExportRepositoryMock) CompleteDataExportArgsForCall(i int) (context.Context, string, []byte, time.Time) {
+	fake.completeDataExportMutex.RLock()
+	defer fake.completeDataExportMutex.RUnlock()
+	argsForCall := fake.completeDataExportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *DataExportRepositoryMock) CompleteDataExportReturns(result1 error) {
+	fake.completeDataExportMutex.Lock()
+	defer fake.completeDataExportMutex.Unlock()
+	fake.CompleteDataExportStub = nil
+	fake.completeDataExportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DataExportRepositoryMock) CompleteDataExportReturnsOnCall(i int, result1 error) {
+	fake.completeDataExportMutex.Lock()
+	defer fake.completeDataExportMutex.Unlock()
+	fake.CompleteDataExportStub = nil
+	if fake.completeDataExportReturnsOnCall == nil {
+		fake.completeDataExportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeDataExportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DataExportRepositoryMock) CreateDataExport(arg1 context.Context, arg2 *domain.DataExportRepositoryPayloadCreateDataExport) error {
+	fake.createDataExportMutex.Lock()
+	ret, specificReturn := fake.createDataExportReturnsOnCall[len(fake.createDataExportArgsForCall)]
+	fake.createDataExportArgsForCall = append(fake.createDataExportArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.DataExportRepositoryPayloadCreateDataExport
+	}{arg1, arg2})
+	stub := fake.CreateDataExportStub
+	fakeReturns := fake.createDataExportReturns
+	fake.recordInvocation("CreateDataExport", []interface{}{arg1, arg2})
+	fake.createDataExportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *DataExportRepositoryMock) CreateDataExportCallCount() int {
+	fake.createDataExportMutex.RLock()
+	defer fake.createDataExportMutex.RUnlock()
+	return len(fake.createDataExportArgsForCall)
+}
+
+func (fake *DataExportRepositoryMock) CreateDataExportCalls(stub func(context.Context, *domain.DataExportRepositoryPayloadCreateDataExport) error) {
+	fake.createDataExportMutex.Lock()
+	defer fake.createDataExportMutex.Unlock()
+	fake.CreateDataExportStub = stub
+}
+
+func (fake *DataExportRepositoryMock) CreateDataExportArgsForCall(i int) (context.Context, *domain.DataExportRepositoryPayloadCreateDataExport) {
+	fake.createDataExportMutex.RLock()
+	defer fake.createDataExportMutex.RUnlock()
+	argsForCall := fake.createDataExportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *DataExportRepositoryMock) CreateDataExportReturns(result1 error) {
+	fake.createDataExportMutex.Lock()
+	defer fake.createDataExportMutex.Unlock()
+	fake.CreateDataExportStub = nil
+	fake.createDataExportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DataExportRepositoryMock) CreateDataExportReturnsOnCall(i int, result1 error) {
+	fake.createDataExportMutex.Lock()
+	defer fake.createDataExportMutex.Unlock()
+	fake.CreateDataExportStub = nil
+	if fake.createDataExportReturnsOnCall == nil {
+		fake.createDataExportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createDataExportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DataExportRepositoryMock) FailDataExport(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) error {
+	fake.failDataExportMutex.Lock()
+	ret, specificReturn := fake.failDataExportReturnsOnCall[len(fake.failDataExportArgsForCall)]
+	fake.failDataExportArgsForCall = append(fake.failDataExportArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailDataExportStub
+	fakeReturns := fake.failDataExportReturns
+	fake.recordInvocation("FailDataExport", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failDataExportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *DataExportRepositoryMock) FailDataExportCallCount() int {
+	fake.failDataExportMutex.RLock()
+	defer fake.failDataExportMutex.RUnlock()
+	return len(fake.failDataExportArgsForCall)
+}
+
+func (fake *DataExportRepositoryMock) FailDataExportCalls(stub func(context.Context, string, string, time.Time) error) {
+	fake.failDataExportMutex.Lock()
+	defer fake.failDataExportMutex.Unlock()
+	fake.FailDataExportStub = stub
+}
+
+func (fake *DataExportRepositoryMock) FailDataExportArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.failDataExportMutex.RLock()
+	defer fake.failDataExportMutex.RUnlock()
+	argsForCall := fake.failDataExportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *DataExportRepositoryMock) FailDataExportReturns(result1 error) {
+	fake.failDataExportMutex.Lock()
+	defer fake.failDataExportMutex.Unlock()
+	fake.FailDataExportStub = nil
+	fake.failDataExportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DataExportRepositoryMock) FailDataExportReturnsOnCall(i int, result1 error) {
+	fake.failDataExportMutex.Lock()
+	defer fake.failDataExportMutex.Unlock()
+	fake.FailDataExportStub = nil
+	if fake.failDataExportReturnsOnCall == nil {
+		fake.failDataExportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.failDataExportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *DataExportRepositoryMock) FailPendingDataExports(arg1 context.Context, arg2 time.Time, arg3 string, arg4 time.Time) (int64, error) {
+	fake.failPendingDataExportsMutex.Lock()
+	ret, specificReturn := fake.failPendingDataExportsReturnsOnCall[len(fake.failPendingDataExportsArgsForCall)]
+	fake.failPendingDataExportsArgsForCall = append(fake.failPendingDataExportsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailPendingDataExportsStub
+	fakeReturns := fake.failPendingDataExportsReturns
+	fake.recordInvocation("FailPendingDataExports", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failPendingDataExportsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *DataExportRepositoryMock) FailPendingDataExportsCallCount() int {
+	fake.failPendingDataExportsMutex.RLock()
+	defer fake.failPendingDataExportsMutex.RUnlock()
+	return len(fake.failPendingDataExportsArgsForCall)
+}
+
+func (fake *DataExportRepositoryMock) FailPendingDataExportsCalls(stub func(context.Context, time.Time, string, time.Time) (int64, error)) {
+	fake.failPendingDataExportsMutex.Lock()
+	defer fake.failPendingDataExportsMutex.Unlock()
+	fake.FailPendingDataExportsStub = stub
+}
+
+func (fake *DataExportRepositoryMock) FailPendingDataExportsArgsForCall(i int) (context.Context, time.Time, string, time.Time) {
+	fake.failPendingDataExportsMutex.RLock()
+	defer fake.failPendingDataExportsMutex.RUnlock()
+	argsForCall := fake.failPendingDataExportsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *DataExportRepositoryMock) FailPendingDataExportsReturns(result1 int64, result2 error) {
+	fake.failPendingDataExportsMutex.Lock()
+	defer fake.failPendingDataExportsMutex.Unlock()
+	fake.FailPendingDataExportsStub = nil
+	fake.failPendingDataExportsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *DataExportRepositoryMock) FailPendingDataExportsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.failPendingDataExportsMutex.Lock()
+	defer fake.failPendingDataExportsMutex.Unlock()
+	fake.FailPendingDataExportsStub = nil
+	if fake.failPendingDataExportsReturnsOnCall == nil {
+		fake.failPendingDataExportsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.failPendingDataExportsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *DataExportRepositoryMock) GetLatestDataExportByUserID(arg1 context.Context, arg2 int) (*domain.DataExportModel, error) {
+	fake.getLatestDataExportByUserIDMutex.Lock()
+	ret, specificReturn := fake.getLatestDataExportByUserIDReturnsOnCall[len(fake.getLatestDataExportByUserIDArgsForCall)]
+	fake.getLatestDataExportByUserIDArgsForCall = append(fake.getLatestDataExportByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetLatestDataExportByUserIDStub
+	fakeReturns := fake.getLatestDataExportByUserIDReturns
+	fake.recordInvocation("GetLatestDataExportByUserID", []interface{}{arg1, arg2})
+	fake.getLatestDataExportByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *DataExportRepositoryMock) GetLatestDataExportByUserIDCallCount() int {
+	fake.getLatestDataExportByUserIDMutex.RLock()
+	defer fake.getLatestDataExportByUserIDMutex.RUnlock()
+	return len(fake.getLatestDataExportByUserIDArgsForCall)
+}
+
+func (fake *DataExportRepositoryMock) GetLatestDataExportByUserIDCalls(stub func(context.Context, int) (*domain.DataExportModel, error)) {
+	fake.getLatestDataExportByUserIDMutex.Lock()
+	defer fake.getLatestDataExportByUserIDMutex.Unlock()
+	fake.GetLatestDataExportByUserIDStub = stub
+}
+
+func (fake *DataExportRepositoryMock) GetLatestDataExportByUserIDArgsForCall(i int) (context.Context, int) {
+	fake.getLatestDataExportByUserIDMutex.RLock()
+	defer fake.getLatestDataExportByUserIDMutex.RUnlock()
+	argsForCall := fake.getLatestDataExportByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *DataExportRepositoryMock) GetLatestDataExportByUserIDReturns(result1 *domain.DataExportModel, result2 error) {
+	fake.getLatestDataExportByUserIDMutex.Lock()
+	defer fake.getLatestDataExportByUserIDMutex.Unlock()
+	fake.GetLatestDataExportByUserIDStub = nil
+	fake.getLatestDataExportByUserIDReturns = struct {
+		result1 *domain.DataExportModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *DataExportRepositoryMock) GetLatestDataExportByUserIDReturnsOnCall(i int, result1 *domain.DataExportModel, result2 error) {
+	fake.getLatestDataExportByUserIDMutex.Lock()
+	defer fake.getLatestDataExportByUserIDMutex.Unlock()
+	fake.GetLatestDataExportByUserIDStub = nil
+	if fake.getLatestDataExportByUserIDReturnsOnCall == nil {
+		fake.getLatestDataExportByUserIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.DataExportModel
+			result2 error
+		})
+	}
+	fake.getLatestDataExportByUserIDReturnsOnCall[i] = struct {
+		result1 *domain.DataExportModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *DataExportRepositoryMock) ListPendingDataExports(arg1 context.Context, arg2 time.Time) ([]*domain.DataExportModel, error) {
+	fake.listPendingDataExportsMutex.Lock()
+	ret, specificReturn := fake.listPendingDataExportsReturnsOnCall[len(fake.listPendingDataExportsArgsForCall)]
+	fake.listPendingDataExportsArgsForCall = append(fake.listPendingDataExportsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ListPendingDataExportsStub
+	fakeReturns := fake.listPendingDataExportsReturns
+	fake.recordInvocation("ListPendingDataExports", []interface{}{arg1, arg2})
+	fake.listPendingDataExportsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *DataExportRepositoryMock) ListPendingDataExportsCallCount() int {
+	fake.listPendingDataExportsMutex.RLock()
+	defer fake.listPendingDataExportsMutex.RUnlock()
+	return len(fake.listPendingDataExportsArgsForCall)
+}
+
+func (fake *DataExportRepositoryMock) ListPendingDataExportsCalls(stub func(context.Context, time.Time) ([]*domain.DataExportModel, error)) {
+	fake.listPendingDataExportsMutex.Lock()
+	defer fake.listPendingDataExportsMutex.Unlock()
+	fake.ListPendingDataExportsStub = stub
+}
+
+func (fake *DataExportRepositoryMock) ListPendingDataExportsArgsForCall(i int) (context.Context, time.Time) {
+	fake.listPendingDataExportsMutex.RLock()
+	defer fake.listPendingDataExportsMutex.RUnlock()
+	argsForCall := fake.listPendingDataExportsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *DataExportRepositoryMock) ListPendingDataExportsReturns(result1 []*domain.DataExportModel, result2 error) {
+	fake.listPendingDataExportsMutex.Lock()
+	defer fake.listPendingDataExportsMutex.Unlock()
+	fake.ListPendingDataExportsStub = nil
+	fake.listPendingDataExportsReturns = struct {
+		result1 []*domain.DataExportModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *DataExportRepositoryMock) ListPendingDataExportsReturnsOnCall(i int, result1 []*domain.DataExportModel, result2 error) {
+	fake.listPendingDataExportsMutex.Lock()
+	defer fake.listPendingDataExportsMutex.Unlock()
+	fake.ListPendingDataExportsStub = nil
+	if fake.listPendingDataExportsReturnsOnCall == nil {
+		fake.listPendingDataExportsReturnsOnCall = make(map[int]struct {
+			result1 []*domain.DataExportModel
+			result2 error
+		})
+	}
+	fake.listPendingDataExportsReturnsOnCall[i] = struct {
+		result1 []*domain.DataExportModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *DataExportRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.completeDataExportMutex.RLock()
+	defer fake.completeDataExportMutex.RUnlock()
+	fake.createDataExportMutex.RLock()
+	defer fake.createDataExportMutex.RUnlock()
+	fake.failDataExportMutex.RLock()
+	defer fake.failDataExportMutex.RUnlock()
+	fake.failPendingDataExportsMutex.RLock()
+	defer fake.failPendingDataExportsMutex.RUnlock()
+	fake.getLatestDataExportByUserIDMutex.RLock()
+	defer fake.getLatestDataExportByUserIDMutex.RUnlock()
+	fake.listPendingDataExportsMutex.RLock()
+	defer fake.listPendingDataExportsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *DataExportRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.DataExportRepository = new(DataExportRepositoryMock)
@@ -0,0 +1,266 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type HealthRepositoryMock struct {
+	LatestMigrationVersionStub        func() (uint, error)
+	latestMigrationVersionMutex       sync.RWMutex
+	latestMigrationVersionArgsForCall []struct {
+	}
+	latestMigrationVersionReturns struct {
+		result1 uint
+		result2 error
+	}
+	latestMigrationVersionReturnsOnCall map[int]struct {
+		result1 uint
+		result2 error
+	}
+	MigrationVersionStub        func(context.Context) (uint, bool, error)
+	migrationVersionMutex       sync.RWMutex
+	migrationVersionArgsForCall []struct {
+		arg1 context.Context
+	}
+	migrationVersionReturns struct {
+		result1 uint
+		result2 bool
+		result3 error
+	}
+	migrationVersionReturnsOnCall map[int]struct {
+		result1 uint
+		result2 bool
+		result3 error
+	}
+	PingStub        func(context.Context) error
+	pingMutex       sync.RWMutex
+	pingArgsForCall []struct {
+		arg1 context.Context
+	}
+	pingReturns struct {
+		result1 error
+	}
+	pingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *HealthRepositoryMock) LatestMigrationVersion() (uint, error) {
+	fake.latestMigrationVersionMutex.Lock()
+	ret, specificReturn := fake.latestMigrationVersionReturnsOnCall[len(fake.latestMigrationVersionArgsForCall)]
+	fake.latestMigrationVersionArgsForCall = append(fake.latestMigrationVersionArgsForCall, struct {
+	}{})
+	stub := fake.LatestMigrationVersionStub
+	fakeReturns := fake.latestMigrationVersionReturns
+	fake.recordInvocation("LatestMigrationVersion", []interface{}{})
+	fake.latestMigrationVersionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *HealthRepositoryMock) LatestMigrationVersionCallCount() int {
+	fake.latestMigrationVersionMutex.RLock()
+	defer fake.latestMigrationVersionMutex.RUnlock()
+	return len(fake.latestMigrationVersionArgsForCall)
+}
+
+func (fake *HealthRepositoryMock) LatestMigrationVersionCalls(stub func() (uint, error)) {
+	fake.latestMigrationVersionMutex.Lock()
+	defer fake.latestMigrationVersionMutex.Unlock()
+	fake.LatestMigrationVersionStub = stub
+}
+
+func (fake *HealthRepositoryMock) LatestMigrationVersionReturns(result1 uint, result2 error) {
+	fake.latestMigrationVersionMutex.Lock()
+	defer fake.latestMigrationVersionMutex.Unlock()
+	fake.LatestMigrationVersionStub = nil
+	fake.latestMigrationVersionReturns = struct {
+		result1 uint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HealthRepositoryMock) LatestMigrationVersionReturnsOnCall(i int, result1 uint, result2 error) {
+	fake.latestMigrationVersionMutex.Lock()
+	defer fake.latestMigrationVersionMutex.Unlock()
+	fake.LatestMigrationVersionStub = nil
+	if fake.latestMigrationVersionReturnsOnCall == nil {
+		fake.latestMigrationVersionReturnsOnCall = make(map[int]struct {
+			result1 uint
+			result2 error
+		})
+	}
+	fake.latestMigrationVersionReturnsOnCall[i] = struct {
+		result1 uint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HealthRepositoryMock) MigrationVersion(arg1 context.Context) (uint, bool, error) {
+	fake.migrationVersionMutex.Lock()
+	ret, specificReturn := fake.migrationVersionReturnsOnCall[len(fake.migrationVersionArgsForCall)]
+	fake.migrationVersionArgsForCall = append(fake.migrationVersionArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.MigrationVersionStub
+	fakeReturns := fake.migrationVersionReturns
+	fake.recordInvocation("MigrationVersion", []interface{}{arg1})
+	fake.migrationVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *HealthRepositoryMock) MigrationVersionCallCount() int {
+	fake.migrationVersionMutex.RLock()
+	defer fake.migrationVersionMutex.RUnlock()
+	return len(fake.migrationVersionArgsForCall)
+}
+
+func (fake *HealthRepositoryMock) MigrationVersionCalls(stub func(context.Context) (uint, bool, error)) {
+	fake.migrationVersionMutex.Lock()
+	defer fake.migrationVersionMutex.Unlock()
+	fake.MigrationVersionStub = stub
+}
+
+func (fake *HealthRepositoryMock) MigrationVersionArgsForCall(i int) context.Context {
+	fake.migrationVersionMutex.RLock()
+	defer fake.migrationVersionMutex.RUnlock()
+	argsForCall := fake.migrationVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *HealthRepositoryMock) MigrationVersionReturns(result1 uint, result2 bool, result3 error) {
+	fake.migrationVersionMutex.Lock()
+	defer fake.migrationVersionMutex.Unlock()
+	fake.MigrationVersionStub = nil
+	fake.migrationVersionReturns = struct {
+		result1 uint
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *HealthRepositoryMock) MigrationVersionReturnsOnCall(i int, result1 uint, result2 bool, result3 error) {
+	fake.migrationVersionMutex.Lock()
+	defer fake.migrationVersionMutex.Unlock()
+	fake.MigrationVersionStub = nil
+	if fake.migrationVersionReturnsOnCall == nil {
+		fake.migrationVersionReturnsOnCall = make(map[int]struct {
+			result1 uint
+			result2 bool
+			result3 error
+		})
+	}
+	fake.migrationVersionReturnsOnCall[i] = struct {
+		result1 uint
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *HealthRepositoryMock) Ping(arg1 context.Context) error {
+	fake.pingMutex.Lock()
+	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
+	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.PingStub
+	fakeReturns := fake.pingReturns
+	fake.recordInvocation("Ping", []interface{}{arg1})
+	fake.pingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *HealthRepositoryMock) PingCallCount() int {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	return len(fake.pingArgsForCall)
+}
+
+func (fake *HealthRepositoryMock) PingCalls(stub func(context.Context) error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = stub
+}
+
+func (fake *HealthRepositoryMock) PingArgsForCall(i int) context.Context {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	argsForCall := fake.pingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *HealthRepositoryMock) PingReturns(result1 error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	fake.pingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *HealthRepositoryMock) PingReturnsOnCall(i int, result1 error) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	if fake.pingReturnsOnCall == nil {
+		fake.pingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *HealthRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.latestMigrationVersionMutex.RLock()
+	defer fake.latestMigrationVersionMutex.RUnlock()
+	fake.migrationVersionMutex.RLock()
+	defer fake.migrationVersionMutex.RUnlock()
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *HealthRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.HealthRepository = new(HealthRepositoryMock)
@@ -0,0 +1,112 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type HealthUsecaseMock struct {
+	ReadinessStub        func(context.Context) *domain.HealthControllerResponseReadiness
+	readinessMutex       sync.RWMutex
+	readinessArgsForCall []struct {
+		arg1 context.Context
+	}
+	readinessReturns struct {
+		result1 *domain.HealthControllerResponseReadiness
+	}
+	readinessReturnsOnCall map[int]struct {
+		result1 *domain.HealthControllerResponseReadiness
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *HealthUsecaseMock) Readiness(arg1 context.Context) *domain.HealthControllerResponseReadiness {
+	fake.readinessMutex.Lock()
+	ret, specificReturn := fake.readinessReturnsOnCall[len(fake.readinessArgsForCall)]
+	fake.readinessArgsForCall = append(fake.readinessArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ReadinessStub
+	fakeReturns := fake.readinessReturns
+	fake.recordInvocation("Readiness", []interface{}{arg1})
+	fake.readinessMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *HealthUsecaseMock) ReadinessCallCount() int {
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return len(fake.readinessArgsForCall)
+}
+
+func (fake *HealthUsecaseMock) ReadinessCalls(stub func(context.Context) *domain.HealthControllerResponseReadiness) {
+	fake.readinessMutex.Lock()
+	defer fake.readinessMutex.Unlock()
+	fake.ReadinessStub = stub
+}
+
+func (fake *HealthUsecaseMock) ReadinessArgsForCall(i int) context.Context {
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	argsForCall := fake.readinessArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *HealthUsecaseMock) ReadinessReturns(result1 *domain.HealthControllerResponseReadiness) {
+	fake.readinessMutex.Lock()
+	defer fake.readinessMutex.Unlock()
+	fake.ReadinessStub = nil
+	fake.readinessReturns = struct {
+		result1 *domain.HealthControllerResponseReadiness
+	}{result1}
+}
+
+func (fake *HealthUsecaseMock) ReadinessReturnsOnCall(i int, result1 *domain.HealthControllerResponseReadiness) {
+	fake.readinessMutex.Lock()
+	defer fake.readinessMutex.Unlock()
+	fake.ReadinessStub = nil
+	if fake.readinessReturnsOnCall == nil {
+		fake.readinessReturnsOnCall = make(map[int]struct {
+			result1 *domain.HealthControllerResponseReadiness
+		})
+	}
+	fake.readinessReturnsOnCall[i] = struct {
+		result1 *domain.HealthControllerResponseReadiness
+	}{result1}
+}
+
+func (fake *HealthUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *HealthUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.HealthUsecase = new(HealthUsecaseMock)
@@ -0,0 +1,285 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type LifecycleMock struct {
+	DrainStub        func()
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+	}
+	ReadyStub        func() bool
+	readyMutex       sync.RWMutex
+	readyArgsForCall []struct {
+	}
+	readyReturns struct {
+		result1 bool
+	}
+	readyReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RegisterStub        func(...domain.Worker)
+	registerMutex       sync.RWMutex
+	registerArgsForCall []struct {
+		arg1 []domain.Worker
+	}
+	StartStub        func(context.Context)
+	startMutex       sync.RWMutex
+	startArgsForCall []struct {
+		arg1 context.Context
+	}
+	StopStub        func(context.Context) error
+	stopMutex       sync.RWMutex
+	stopArgsForCall []struct {
+		arg1 context.Context
+	}
+	stopReturns struct {
+		result1 error
+	}
+	stopReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *LifecycleMock) Drain() {
+	fake.drainMutex.Lock()
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+	}{})
+	stub := fake.DrainStub
+	fake.recordInvocation("Drain", []interface{}{})
+	fake.drainMutex.Unlock()
+	if stub != nil {
+		fake.DrainStub()
+	}
+}
+
+func (fake *LifecycleMock) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *LifecycleMock) DrainCalls(stub func()) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = stub
+}
+
+func (fake *LifecycleMock) Ready() bool {
+	fake.readyMutex.Lock()
+	ret, specificReturn := fake.readyReturnsOnCall[len(fake.readyArgsForCall)]
+	fake.readyArgsForCall = append(fake.readyArgsForCall, struct {
+	}{})
+	stub := fake.ReadyStub
+	fakeReturns := fake.readyReturns
+	fake.recordInvocation("Ready", []interface{}{})
+	fake.readyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *LifecycleMock) ReadyCallCount() int {
+	fake.readyMutex.RLock()
+	defer fake.readyMutex.RUnlock()
+	return len(fake.readyArgsForCall)
+}
+
+func (fake *LifecycleMock) ReadyCalls(stub func() bool) {
+	fake.readyMutex.Lock()
+	defer fake.readyMutex.Unlock()
+	fake.ReadyStub = stub
+}
+
+func (fake *LifecycleMock) ReadyReturns(result1 bool) {
+	fake.readyMutex.Lock()
+	defer fake.readyMutex.Unlock()
+	fake.ReadyStub = nil
+	fake.readyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *LifecycleMock) ReadyReturnsOnCall(i int, result1 bool) {
+	fake.readyMutex.Lock()
+	defer fake.readyMutex.Unlock()
+	fake.ReadyStub = nil
+	if fake.readyReturnsOnCall == nil {
+		fake.readyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.readyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *LifecycleMock) Register(arg1 ...domain.Worker) {
+	fake.registerMutex.Lock()
+	fake.registerArgsForCall = append(fake.registerArgsForCall, struct {
+		arg1 []domain.Worker
+	}{arg1})
+	stub := fake.RegisterStub
+	fake.recordInvocation("Register", []interface{}{arg1})
+	fake.registerMutex.Unlock()
+	if stub != nil {
+		fake.RegisterStub(arg1...)
+	}
+}
+
+func (fake *LifecycleMock) RegisterCallCount() int {
+	fake.registerMutex.RLock()
+	defer fake.registerMutex.RUnlock()
+	return len(fake.registerArgsForCall)
+}
+
+func (fake *LifecycleMock) RegisterCalls(stub func(...domain.Worker)) {
+	fake.registerMutex.Lock()
+	defer fake.registerMutex.Unlock()
+	fake.RegisterStub = stub
+}
+
+func (fake *LifecycleMock) RegisterArgsForCall(i int) []domain.Worker {
+	fake.registerMutex.RLock()
+	defer fake.registerMutex.RUnlock()
+	argsForCall := fake.registerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *LifecycleMock) Start(arg1 context.Context) {
+	fake.startMutex.Lock()
+	fake.startArgsForCall = append(fake.startArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.StartStub
+	fake.recordInvocation("Start", []interface{}{arg1})
+	fake.startMutex.Unlock()
+	if stub != nil {
+		fake.StartStub(arg1)
+	}
+}
+
+func (fake *LifecycleMock) StartCallCount() int {
+	fake.startMutex.RLock()
+	defer fake.startMutex.RUnlock()
+	return len(fake.startArgsForCall)
+}
+
+func (fake *LifecycleMock) StartCalls(stub func(context.Context)) {
+	fake.startMutex.Lock()
+	defer fake.startMutex.Unlock()
+	fake.StartStub = stub
+}
+
+func (fake *LifecycleMock) StartArgsForCall(i int) context.Context {
+	fake.startMutex.RLock()
+	defer fake.startMutex.RUnlock()
+	argsForCall := fake.startArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *LifecycleMock) Stop(arg1 context.Context) error {
+	fake.stopMutex.Lock()
+	ret, specificReturn := fake.stopReturnsOnCall[len(fake.stopArgsForCall)]
+	fake.stopArgsForCall = append(fake.stopArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.StopStub
+	fakeReturns := fake.stopReturns
+	fake.recordInvocation("Stop", []interface{}{arg1})
+	fake.stopMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *LifecycleMock) StopCallCount() int {
+	fake.stopMutex.RLock()
+	defer fake.stopMutex.RUnlock()
+	return len(fake.stopArgsForCall)
+}
+
+func (fake *LifecycleMock) StopCalls(stub func(context.Context) error) {
+	fake.stopMutex.Lock()
+	defer fake.stopMutex.Unlock()
+	fake.StopStub = stub
+}
+
+func (fake *LifecycleMock) StopArgsForCall(i int) context.Context {
+	fake.stopMutex.RLock()
+	defer fake.stopMutex.RUnlock()
+	argsForCall := fake.stopArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *LifecycleMock) StopReturns(result1 error) {
+	fake.stopMutex.Lock()
+	defer fake.stopMutex.Unlock()
+	fake.StopStub = nil
+	fake.stopReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *LifecycleMock) StopReturnsOnCall(i int, result1 error) {
+	fake.stopMutex.Lock()
+	defer fake.stopMutex.Unlock()
+	fake.StopStub = nil
+	if fake.stopReturnsOnCall == nil {
+		fake.stopReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.stopReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *LifecycleMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	fake.readyMutex.RLock()
+	defer fake.readyMutex.RUnlock()
+	fake.registerMutex.RLock()
+	defer fake.registerMutex.RUnlock()
+	fake.startMutex.RLock()
+	defer fake.startMutex.RUnlock()
+	fake.stopMutex.RLock()
+	defer fake.stopMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *LifecycleMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.Lifecycle = new(LifecycleMock)
@@ -0,0 +1,357 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type LoginAttemptRepositoryMock struct {
+	DeleteLoginAttemptStub        func(context.Context, string) error
+	deleteLoginAttemptMutex       sync.RWMutex
+	deleteLoginAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteLoginAttemptReturns struct {
+		result1 error
+	}
+	deleteLoginAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetLoginAttemptStub        func(context.Context, string) (*domain.LoginAttemptModel, error)
+	getLoginAttemptMutex       sync.RWMutex
+	getLoginAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getLoginAttemptReturns struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}
+	getLoginAttemptReturnsOnCall map[int]struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}
+	LockLoginAttemptStub        func(context.Context, string, time.Time) error
+	lockLoginAttemptMutex       sync.RWMutex
+	lockLoginAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	lockLoginAttemptReturns struct {
+		result1 error
+	}
+	lockLoginAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RecordFailedLoginAttemptStub        func(context.Context, string, time.Time) (*domain.LoginAttemptModel, error)
+	recordFailedLoginAttemptMutex       sync.RWMutex
+	recordFailedLoginAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	recordFailedLoginAttemptReturns struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}
+	recordFailedLoginAttemptReturnsOnCall map[int]struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *LoginAttemptRepositoryMock) DeleteLoginAttempt(arg1 context.Context, arg2 string) error {
+	fake.deleteLoginAttemptMutex.Lock()
+	ret, specificReturn := fake.deleteLoginAttemptReturnsOnCall[len(fake.deleteLoginAttemptArgsForCall)]
+	fake.deleteLoginAttemptArgsForCall = append(fake.deleteLoginAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteLoginAttemptStub
+	fakeReturns := fake.deleteLoginAttemptReturns
+	fake.recordInvocation("DeleteLoginAttempt", []interface{}{arg1, arg2})
+	fake.deleteLoginAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *LoginAttemptRepositoryMock) DeleteLoginAttemptCallCount() int {
+	fake.deleteLoginAttemptMutex.RLock()
+	defer fake.deleteLoginAttemptMutex.RUnlock()
+	return len(fake.deleteLoginAttemptArgsForCall)
+}
+
+func (fake *LoginAttemptRepositoryMock) DeleteLoginAttemptCalls(stub func(context.Context, string) error) {
+	fake.deleteLoginAttemptMutex.Lock()
+	defer fake.deleteLoginAttemptMutex.Unlock()
+	fake.DeleteLoginAttemptStub = stub
+}
+
+func (fake *LoginAttemptRepositoryMock) DeleteLoginAttemptArgsForCall(i int) (context.Context, string) {
+	fake.deleteLoginAttemptMutex.RLock()
+	defer fake.deleteLoginAttemptMutex.RUnlock()
+	argsForCall := fake.deleteLoginAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *LoginAttemptRepositoryMock) DeleteLoginAttemptReturns(result1 error) {
+	fake.deleteLoginAttemptMutex.Lock()
+	defer fake.deleteLoginAttemptMutex.Unlock()
+	fake.DeleteLoginAttemptStub = nil
+	fake.deleteLoginAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *LoginAttemptRepositoryMock) DeleteLoginAttemptReturnsOnCall(i int, result1 error) {
+	fake.deleteLoginAttemptMutex.Lock()
+	defer fake.deleteLoginAttemptMutex.Unlock()
+	fake.DeleteLoginAttemptStub = nil
+	if fake.deleteLoginAttemptReturnsOnCall == nil {
+		fake.deleteLoginAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteLoginAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *LoginAttemptRepositoryMock) GetLoginAttempt(arg1 context.Context, arg2 string) (*domain.LoginAttemptModel, error) {
+	fake.getLoginAttemptMutex.Lock()
+	ret, specificReturn := fake.getLoginAttemptReturnsOnCall[len(fake.getLoginAttemptArgsForCall)]
+	fake.getLoginAttemptArgsForCall = append(fake.getLoginAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetLoginAttemptStub
+	fakeReturns := fake.getLoginAttemptReturns
+	fake.recordInvocation("GetLoginAttempt", []interface{}{arg1, arg2})
+	fake.getLoginAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *LoginAttemptRepositoryMock) GetLoginAttemptCallCount() int {
+	fake.getLoginAttemptMutex.RLock()
+	defer fake.getLoginAttemptMutex.RUnlock()
+	return len(fake.getLoginAttemptArgsForCall)
+}
+
+func (fake *LoginAttemptRepositoryMock) GetLoginAttemptCalls(stub func(context.Context, string) (*domain.LoginAttemptModel, error)) {
+	fake.getLoginAttemptMutex.Lock()
+	defer fake.getLoginAttemptMutex.Unlock()
+	fake.GetLoginAttemptStub = stub
+}
+
+func (fake *LoginAttemptRepositoryMock) GetLoginAttemptArgsForCall(i int) (context.Context, string) {
+	fake.getLoginAttemptMutex.RLock()
+	defer fake.getLoginAttemptMutex.RUnlock()
+	argsForCall := fake.getLoginAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *LoginAttemptRepositoryMock) GetLoginAttemptReturns(result1 *domain.LoginAttemptModel, result2 error) {
+	fake.getLoginAttemptMutex.Lock()
+	defer fake.getLoginAttemptMutex.Unlock()
+	fake.GetLoginAttemptStub = nil
+	fake.getLoginAttemptReturns = struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LoginAttemptRepositoryMock) GetLoginAttemptReturnsOnCall(i int, result1 *domain.LoginAttemptModel, result2 error) {
+	fake.getLoginAttemptMutex.Lock()
+	defer fake.getLoginAttemptMutex.Unlock()
+	fake.GetLoginAttemptStub = nil
+	if fake.getLoginAttemptReturnsOnCall == nil {
+		fake.getLoginAttemptReturnsOnCall = make(map[int]struct {
+			result1 *domain.LoginAttemptModel
+			result2 error
+		})
+	}
+	fake.getLoginAttemptReturnsOnCall[i] = struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LoginAttemptRepositoryMock) LockLoginAttempt(arg1 context.Context, arg2 string, arg3 time.Time) error {
+	fake.lockLoginAttemptMutex.Lock()
+	ret, specificReturn := fake.lockLoginAttemptReturnsOnCall[len(fake.lockLoginAttemptArgsForCall)]
+	fake.lockLoginAttemptArgsForCall = append(fake.lockLoginAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.LockLoginAttemptStub
+	fakeReturns := fake.lockLoginAttemptReturns
+	fake.recordInvocation("LockLoginAttempt", []interface{}{arg1, arg2, arg3})
+	fake.lockLoginAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *LoginAttemptRepositoryMock) LockLoginAttemptCallCount() int {
+	fake.lockLoginAttemptMutex.RLock()
+	defer fake.lockLoginAttemptMutex.RUnlock()
+	return len(fake.lockLoginAttemptArgsForCall)
+}
+
+func (fake *LoginAttemptRepositoryMock) LockLoginAttemptCalls(stub func(context.Context, string, time.Time) error) {
+	fake.lockLoginAttemptMutex.Lock()
+	defer fake.lockLoginAttemptMutex.Unlock()
+	fake.LockLoginAttemptStub = stub
+}
+
+func (fake *LoginAttemptRepositoryMock) LockLoginAttemptArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.lockLoginAttemptMutex.RLock()
+	defer fake.lockLoginAttemptMutex.RUnlock()
+	argsForCall := fake.lockLoginAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *LoginAttemptRepositoryMock) LockLoginAttemptReturns(result1 error) {
+	fake.lockLoginAttemptMutex.Lock()
+	defer fake.lockLoginAttemptMutex.Unlock()
+	fake.LockLoginAttemptStub = nil
+	fake.lockLoginAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *LoginAttemptRepositoryMock) LockLoginAttemptReturnsOnCall(i int, result1 error) {
+	fake.lockLoginAttemptMutex.Lock()
+	defer fake.lockLoginAttemptMutex.Unlock()
+	fake.LockLoginAttemptStub = nil
+	if fake.lockLoginAttemptReturnsOnCall == nil {
+		fake.lockLoginAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.lockLoginAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *LoginAttemptRepositoryMock) RecordFailedLoginAttempt(arg1 context.Context, arg2 string, arg3 time.Time) (*domain.LoginAttemptModel, error) {
+	fake.recordFailedLoginAttemptMutex.Lock()
+	ret, specificReturn := fake.recordFailedLoginAttemptReturnsOnCall[len(fake.recordFailedLoginAttemptArgsForCall)]
+	fake.recordFailedLoginAttemptArgsForCall = append(fake.recordFailedLoginAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RecordFailedLoginAttemptStub
+	fakeReturns := fake.recordFailedLoginAttemptReturns
+	fake.recordInvocation("RecordFailedLoginAttempt", []interface{}{arg1, arg2, arg3})
+	fake.recordFailedLoginAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *LoginAttemptRepositoryMock) RecordFailedLoginAttemptCallCount() int {
+	fake.recordFailedLoginAttemptMutex.RLock()
+	defer fake.recordFailedLoginAttemptMutex.RUnlock()
+	return len(fake.recordFailedLoginAttemptArgsForCall)
+}
+
+func (fake *LoginAttemptRepositoryMock) RecordFailedLoginAttemptCalls(stub func(context.Context, string, time.Time) (*domain.LoginAttemptModel, error)) {
+	fake.recordFailedLoginAttemptMutex.Lock()
+	defer fake.recordFailedLoginAttemptMutex.Unlock()
+	fake.RecordFailedLoginAttemptStub = stub
+}
+
+func (fake *LoginAttemptRepositoryMock) RecordFailedLoginAttemptArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.recordFailedLoginAttemptMutex.RLock()
+	defer fake.recordFailedLoginAttemptMutex.RUnlock()
+	argsForCall := fake.recordFailedLoginAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *LoginAttemptRepositoryMock) RecordFailedLoginAttemptReturns(result1 *domain.LoginAttemptModel, result2 error) {
+	fake.recordFailedLoginAttemptMutex.Lock()
+	defer fake.recordFailedLoginAttemptMutex.Unlock()
+	fake.RecordFailedLoginAttemptStub = nil
+	fake.recordFailedLoginAttemptReturns = struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LoginAttemptRepositoryMock) RecordFailedLoginAttemptReturnsOnCall(i int, result1 *domain.LoginAttemptModel, result2 error) {
+	fake.recordFailedLoginAttemptMutex.Lock()
+	defer fake.recordFailedLoginAttemptMutex.Unlock()
+	fake.RecordFailedLoginAttemptStub = nil
+	if fake.recordFailedLoginAttemptReturnsOnCall == nil {
+		fake.recordFailedLoginAttemptReturnsOnCall = make(map[int]struct {
+			result1 *domain.LoginAttemptModel
+			result2 error
+		})
+	}
+	fake.recordFailedLoginAttemptReturnsOnCall[i] = struct {
+		result1 *domain.LoginAttemptModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *LoginAttemptRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteLoginAttemptMutex.RLock()
+	defer fake.deleteLoginAttemptMutex.RUnlock()
+	fake.getLoginAttemptMutex.RLock()
+	defer fake.getLoginAttemptMutex.RUnlock()
+	fake.lockLoginAttemptMutex.RLock()
+	defer fake.lockLoginAttemptMutex.RUnlock()
+	fake.recordFailedLoginAttemptMutex.RLock()
+	defer fake.recordFailedLoginAttemptMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *LoginAttemptRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.LoginAttemptRepository = new(LoginAttemptRepositoryMock)
@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type MailerUtilMock struct {
+	SendMailStub        func(context.Context, *domain.Mail) error
+	sendMailMutex       sync.RWMutex
+	sendMailArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.Mail
+	}
+	sendMailReturns struct {
+		result1 error
+	}
+	sendMailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *MailerUtilMock) SendMail(arg1 context.Context, arg2 *domain.Mail) error {
+	fake.sendMailMutex.Lock()
+	ret, specificReturn := fake.sendMailReturnsOnCall[len(fake.sendMailArgsForCall)]
+	fake.sendMailArgsForCall = append(fake.sendMailArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.Mail
+	}{arg1, arg2})
+	stub := fake.SendMailStub
+	fakeReturns := fake.sendMailReturns
+	fake.recordInvocation("SendMail", []interface{}{arg1, arg2})
+	fake.sendMailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *MailerUtilMock) SendMailCallCount() int {
+	fake.sendMailMutex.RLock()
+	defer fake.sendMailMutex.RUnlock()
+	return len(fake.sendMailArgsForCall)
+}
+
+func (fake *MailerUtilMock) SendMailCalls(stub func(context.Context, *domain.Mail) error) {
+	fake.sendMailMutex.Lock()
+	defer fake.sendMailMutex.Unlock()
+	fake.SendMailStub = stub
+}
+
+func (fake *MailerUtilMock) SendMailArgsForCall(i int) (context.Context, *domain.Mail) {
+	fake.sendMailMutex.RLock()
+	defer fake.sendMailMutex.RUnlock()
+	argsForCall := fake.sendMailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *MailerUtilMock) SendMailReturns(result1 error) {
+	fake.sendMailMutex.Lock()
+	defer fake.sendMailMutex.Unlock()
+	fake.SendMailStub = nil
+	fake.sendMailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *MailerUtilMock) SendMailReturnsOnCall(i int, result1 error) {
+	fake.sendMailMutex.Lock()
+	defer fake.sendMailMutex.Unlock()
+	fake.SendMailStub = nil
+	if fake.sendMailReturnsOnCall == nil {
+		fake.sendMailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendMailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *MailerUtilMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.sendMailMutex.RLock()
+	defer fake.sendMailMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *MailerUtilMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.MailerUtil = new(MailerUtilMock)
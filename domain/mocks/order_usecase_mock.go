@@ -0,0 +1,607 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type OrderUsecaseMock struct {
+	CancelOrderStub        func(context.Context, string, int) error
+	cancelOrderMutex       sync.RWMutex
+	cancelOrderArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	cancelOrderReturns struct {
+		result1 error
+	}
+	cancelOrderReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CheckoutStub        func(context.Context, int) (string, error)
+	checkoutMutex       sync.RWMutex
+	checkoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	checkoutReturns struct {
+		result1 string
+		result2 error
+	}
+	checkoutReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetOrderByUIDStub        func(context.Context, string) (*domain.OrderControllerResponseGetOrder, error)
+	getOrderByUIDMutex       sync.RWMutex
+	getOrderByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getOrderByUIDReturns struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}
+	getOrderByUIDReturnsOnCall map[int]struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}
+	GetOrderByUIDAndUserIDStub        func(context.Context, string, int) (*domain.OrderControllerResponseGetOrder, error)
+	getOrderByUIDAndUserIDMutex       sync.RWMutex
+	getOrderByUIDAndUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getOrderByUIDAndUserIDReturns struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}
+	getOrderByUIDAndUserIDReturnsOnCall map[int]struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}
+	ListOrdersStub        func(context.Context, int, int, string) (*domain.OrderControllerResponseListOrders, error)
+	listOrdersMutex       sync.RWMutex
+	listOrdersArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 string
+	}
+	listOrdersReturns struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}
+	listOrdersReturnsOnCall map[int]struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}
+	ListOrdersByUserIDStub        func(context.Context, int, int, int) (*domain.OrderControllerResponseListOrders, error)
+	listOrdersByUserIDMutex       sync.RWMutex
+	listOrdersByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 int
+	}
+	listOrdersByUserIDReturns struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}
+	listOrdersByUserIDReturnsOnCall map[int]struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}
+	UpdateOrderStatusStub        func(context.Context, *domain.OrderUsecasePayloadUpdateOrderStatus) error
+	updateOrderStatusMutex       sync.RWMutex
+	updateOrderStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.OrderUsecasePayloadUpdateOrderStatus
+	}
+	updateOrderStatusReturns struct {
+		result1 error
+	}
+	updateOrderStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *OrderUsecaseMock) CancelOrder(arg1 context.Context, arg2 string, arg3 int) error {
+	fake.cancelOrderMutex.Lock()
+	ret, specificReturn := fake.cancelOrderReturnsOnCall[len(fake.cancelOrderArgsForCall)]
+	fake.cancelOrderArgsForCall = append(fake.cancelOrderArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.CancelOrderStub
+	fakeReturns := fake.cancelOrderReturns
+	fake.recordInvocation("CancelOrder", []interface{}{arg1, arg2, arg3})
+	fake.cancelOrderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OrderUsecaseMock) CancelOrderCallCount() int {
+	fake.cancelOrderMutex.RLock()
+	defer fake.cancelOrderMutex.RUnlock()
+	return len(fake.cancelOrderArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) CancelOrderCalls(stub func(context.Context, string, int) error) {
+	fake.cancelOrderMutex.Lock()
+	defer fake.cancelOrderMutex.Unlock()
+	fake.CancelOrderStub = stub
+}
+
+func (fake *OrderUsecaseMock) CancelOrderArgsForCall(i int) (context.Context, string, int) {
+	fake.cancelOrderMutex.RLock()
+	defer fake.cancelOrderMutex.RUnlock()
+	argsForCall := fake.cancelOrderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *OrderUsecaseMock) CancelOrderReturns(result1 error) {
+	fake.cancelOrderMutex.Lock()
+	defer fake.cancelOrderMutex.Unlock()
+	fake.CancelOrderStub = nil
+	fake.cancelOrderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OrderUsecaseMock) CancelOrderReturnsOnCall(i int, result1 error) {
+	fake.cancelOrderMutex.Lock()
+	defer fake.cancelOrderMutex.Unlock()
+	fake.CancelOrderStub = nil
+	if fake.cancelOrderReturnsOnCall == nil {
+		fake.cancelOrderReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelOrderReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OrderUsecaseMock) Checkout(arg1 context.Context, arg2 int) (string, error) {
+	fake.checkoutMutex.Lock()
+	ret, specificReturn := fake.checkoutReturnsOnCall[len(fake.checkoutArgsForCall)]
+	fake.checkoutArgsForCall = append(fake.checkoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.CheckoutStub
+	fakeReturns := fake.checkoutReturns
+	fake.recordInvocation("Checkout", []interface{}{arg1, arg2})
+	fake.checkoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OrderUsecaseMock) CheckoutCallCount() int {
+	fake.checkoutMutex.RLock()
+	defer fake.checkoutMutex.RUnlock()
+	return len(fake.checkoutArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) CheckoutCalls(stub func(context.Context, int) (string, error)) {
+	fake.checkoutMutex.Lock()
+	defer fake.checkoutMutex.Unlock()
+	fake.CheckoutStub = stub
+}
+
+func (fake *OrderUsecaseMock) CheckoutArgsForCall(i int) (context.Context, int) {
+	fake.checkoutMutex.RLock()
+	defer fake.checkoutMutex.RUnlock()
+	argsForCall := fake.checkoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *OrderUsecaseMock) CheckoutReturns(result1 string, result2 error) {
+	fake.checkoutMutex.Lock()
+	defer fake.checkoutMutex.Unlock()
+	fake.CheckoutStub = nil
+	fake.checkoutReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) CheckoutReturnsOnCall(i int, result1 string, result2 error) {
+	fake.checkoutMutex.Lock()
+	defer fake.checkoutMutex.Unlock()
+	fake.CheckoutStub = nil
+	if fake.checkoutReturnsOnCall == nil {
+		fake.checkoutReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.checkoutReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUID(arg1 context.Context, arg2 string) (*domain.OrderControllerResponseGetOrder, error) {
+	fake.getOrderByUIDMutex.Lock()
+	ret, specificReturn := fake.getOrderByUIDReturnsOnCall[len(fake.getOrderByUIDArgsForCall)]
+	fake.getOrderByUIDArgsForCall = append(fake.getOrderByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetOrderByUIDStub
+	fakeReturns := fake.getOrderByUIDReturns
+	fake.recordInvocation("GetOrderByUID", []interface{}{arg1, arg2})
+	fake.getOrderByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDCallCount() int {
+	fake.getOrderByUIDMutex.RLock()
+	defer fake.getOrderByUIDMutex.RUnlock()
+	return len(fake.getOrderByUIDArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDCalls(stub func(context.Context, string) (*domain.OrderControllerResponseGetOrder, error)) {
+	fake.getOrderByUIDMutex.Lock()
+	defer fake.getOrderByUIDMutex.Unlock()
+	fake.GetOrderByUIDStub = stub
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDArgsForCall(i int) (context.Context, string) {
+	fake.getOrderByUIDMutex.RLock()
+	defer fake.getOrderByUIDMutex.RUnlock()
+	argsForCall := fake.getOrderByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDReturns(result1 *domain.OrderControllerResponseGetOrder, result2 error) {
+	fake.getOrderByUIDMutex.Lock()
+	defer fake.getOrderByUIDMutex.Unlock()
+	fake.GetOrderByUIDStub = nil
+	fake.getOrderByUIDReturns = struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDReturnsOnCall(i int, result1 *domain.OrderControllerResponseGetOrder, result2 error) {
+	fake.getOrderByUIDMutex.Lock()
+	defer fake.getOrderByUIDMutex.Unlock()
+	fake.GetOrderByUIDStub = nil
+	if fake.getOrderByUIDReturnsOnCall == nil {
+		fake.getOrderByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrderControllerResponseGetOrder
+			result2 error
+		})
+	}
+	fake.getOrderByUIDReturnsOnCall[i] = struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDAndUserID(arg1 context.Context, arg2 string, arg3 int) (*domain.OrderControllerResponseGetOrder, error) {
+	fake.getOrderByUIDAndUserIDMutex.Lock()
+	ret, specificReturn := fake.getOrderByUIDAndUserIDReturnsOnCall[len(fake.getOrderByUIDAndUserIDArgsForCall)]
+	fake.getOrderByUIDAndUserIDArgsForCall = append(fake.getOrderByUIDAndUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetOrderByUIDAndUserIDStub
+	fakeReturns := fake.getOrderByUIDAndUserIDReturns
+	fake.recordInvocation("GetOrderByUIDAndUserID", []interface{}{arg1, arg2, arg3})
+	fake.getOrderByUIDAndUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDAndUserIDCallCount() int {
+	fake.getOrderByUIDAndUserIDMutex.RLock()
+	defer fake.getOrderByUIDAndUserIDMutex.RUnlock()
+	return len(fake.getOrderByUIDAndUserIDArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDAndUserIDCalls(stub func(context.Context, string, int) (*domain.OrderControllerResponseGetOrder, error)) {
+	fake.getOrderByUIDAndUserIDMutex.Lock()
+	defer fake.getOrderByUIDAndUserIDMutex.Unlock()
+	fake.GetOrderByUIDAndUserIDStub = stub
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDAndUserIDArgsForCall(i int) (context.Context, string, int) {
+	fake.getOrderByUIDAndUserIDMutex.RLock()
+	defer fake.getOrderByUIDAndUserIDMutex.RUnlock()
+	argsForCall := fake.getOrderByUIDAndUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDAndUserIDReturns(result1 *domain.OrderControllerResponseGetOrder, result2 error) {
+	fake.getOrderByUIDAndUserIDMutex.Lock()
+	defer fake.getOrderByUIDAndUserIDMutex.Unlock()
+	fake.GetOrderByUIDAndUserIDStub = nil
+	fake.getOrderByUIDAndUserIDReturns = struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) GetOrderByUIDAndUserIDReturnsOnCall(i int, result1 *domain.OrderControllerResponseGetOrder, result2 error) {
+	fake.getOrderByUIDAndUserIDMutex.Lock()
+	defer fake.getOrderByUIDAndUserIDMutex.Unlock()
+	fake.GetOrderByUIDAndUserIDStub = nil
+	if fake.getOrderByUIDAndUserIDReturnsOnCall == nil {
+		fake.getOrderByUIDAndUserIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrderControllerResponseGetOrder
+			result2 error
+		})
+	}
+	fake.getOrderByUIDAndUserIDReturnsOnCall[i] = struct {
+		result1 *domain.OrderControllerResponseGetOrder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) ListOrders(arg1 context.Context, arg2 int, arg3 int, arg4 string) (*domain.OrderControllerResponseListOrders, error) {
+	fake.listOrdersMutex.Lock()
+	ret, specificReturn := fake.listOrdersReturnsOnCall[len(fake.listOrdersArgsForCall)]
+	fake.listOrdersArgsForCall = append(fake.listOrdersArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListOrdersStub
+	fakeReturns := fake.listOrdersReturns
+	fake.recordInvocation("ListOrders", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listOrdersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OrderUsecaseMock) ListOrdersCallCount() int {
+	fake.listOrdersMutex.RLock()
+	defer fake.listOrdersMutex.RUnlock()
+	return len(fake.listOrdersArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) ListOrdersCalls(stub func(context.Context, int, int, string) (*domain.OrderControllerResponseListOrders, error)) {
+	fake.listOrdersMutex.Lock()
+	defer fake.listOrdersMutex.Unlock()
+	fake.ListOrdersStub = stub
+}
+
+func (fake *OrderUsecaseMock) ListOrdersArgsForCall(i int) (context.Context, int, int, string) {
+	fake.listOrdersMutex.RLock()
+	defer fake.listOrdersMutex.RUnlock()
+	argsForCall := fake.listOrdersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *OrderUsecaseMock) ListOrdersReturns(result1 *domain.OrderControllerResponseListOrders, result2 error) {
+	fake.listOrdersMutex.Lock()
+	defer fake.listOrdersMutex.Unlock()
+	fake.ListOrdersStub = nil
+	fake.listOrdersReturns = struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) ListOrdersReturnsOnCall(i int, result1 *domain.OrderControllerResponseListOrders, result2 error) {
+	fake.listOrdersMutex.Lock()
+	defer fake.listOrdersMutex.Unlock()
+	fake.ListOrdersStub = nil
+	if fake.listOrdersReturnsOnCall == nil {
+		fake.listOrdersReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrderControllerResponseListOrders
+			result2 error
+		})
+	}
+	fake.listOrdersReturnsOnCall[i] = struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) ListOrdersByUserID(arg1 context.Context, arg2 int, arg3 int, arg4 int) (*domain.OrderControllerResponseListOrders, error) {
+	fake.listOrdersByUserIDMutex.Lock()
+	ret, specificReturn := fake.listOrdersByUserIDReturnsOnCall[len(fake.listOrdersByUserIDArgsForCall)]
+	fake.listOrdersByUserIDArgsForCall = append(fake.listOrdersByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListOrdersByUserIDStub
+	fakeReturns := fake.listOrdersByUserIDReturns
+	fake.recordInvocation("ListOrdersByUserID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listOrdersByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OrderUsecaseMock) ListOrdersByUserIDCallCount() int {
+	fake.listOrdersByUserIDMutex.RLock()
+	defer fake.listOrdersByUserIDMutex.RUnlock()
+	return len(fake.listOrdersByUserIDArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) ListOrdersByUserIDCalls(stub func(context.Context, int, int, int) (*domain.OrderControllerResponseListOrders, error)) {
+	fake.listOrdersByUserIDMutex.Lock()
+	defer fake.listOrdersByUserIDMutex.Unlock()
+	fake.ListOrdersByUserIDStub = stub
+}
+
+func (fake *OrderUsecaseMock) ListOrdersByUserIDArgsForCall(i int) (context.Context, int, int, int) {
+	fake.listOrdersByUserIDMutex.RLock()
+	defer fake.listOrdersByUserIDMutex.RUnlock()
+	argsForCall := fake.listOrdersByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *OrderUsecaseMock) ListOrdersByUserIDReturns(result1 *domain.OrderControllerResponseListOrders, result2 error) {
+	fake.listOrdersByUserIDMutex.Lock()
+	defer fake.listOrdersByUserIDMutex.Unlock()
+	fake.ListOrdersByUserIDStub = nil
+	fake.listOrdersByUserIDReturns = struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) ListOrdersByUserIDReturnsOnCall(i int, result1 *domain.OrderControllerResponseListOrders, result2 error) {
+	fake.listOrdersByUserIDMutex.Lock()
+	defer fake.listOrdersByUserIDMutex.Unlock()
+	fake.ListOrdersByUserIDStub = nil
+	if fake.listOrdersByUserIDReturnsOnCall == nil {
+		fake.listOrdersByUserIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.OrderControllerResponseListOrders
+			result2 error
+		})
+	}
+	fake.listOrdersByUserIDReturnsOnCall[i] = struct {
+		result1 *domain.OrderControllerResponseListOrders
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OrderUsecaseMock) UpdateOrderStatus(arg1 context.Context, arg2 *domain.OrderUsecasePayloadUpdateOrderStatus) error {
+	fake.updateOrderStatusMutex.Lock()
+	ret, specificReturn := fake.updateOrderStatusReturnsOnCall[len(fake.updateOrderStatusArgsForCall)]
+	fake.updateOrderStatusArgsForCall = append(fake.updateOrderStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.OrderUsecasePayloadUpdateOrderStatus
+	}{arg1, arg2})
+	stub := fake.UpdateOrderStatusStub
+	fakeReturns := fake.updateOrderStatusReturns
+	fake.recordInvocation("UpdateOrderStatus", []interface{}{arg1, arg2})
+	fake.updateOrderStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OrderUsecaseMock) UpdateOrderStatusCallCount() int {
+	fake.updateOrderStatusMutex.RLock()
+	defer fake.updateOrderStatusMutex.RUnlock()
+	return len(fake.updateOrderStatusArgsForCall)
+}
+
+func (fake *OrderUsecaseMock) UpdateOrderStatusCalls(stub func(context.Context, *domain.OrderUsecasePayloadUpdateOrderStatus) error) {
+	fake.updateOrderStatusMutex.Lock()
+	defer fake.updateOrderStatusMutex.Unlock()
+	fake.UpdateOrderStatusStub = stub
+}
+
+func (fake *OrderUsecaseMock) UpdateOrderStatusArgsForCall(i int) (context.Context, *domain.OrderUsecasePayloadUpdateOrderStatus) {
+	fake.updateOrderStatusMutex.RLock()
+	defer fake.updateOrderStatusMutex.RUnlock()
+	argsForCall := fake.updateOrderStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *OrderUsecaseMock) UpdateOrderStatusReturns(result1 error) {
+	fake.updateOrderStatusMutex.Lock()
+	defer fake.updateOrderStatusMutex.Unlock()
+	fake.UpdateOrderStatusStub = nil
+	fake.updateOrderStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OrderUsecaseMock) UpdateOrderStatusReturnsOnCall(i int, result1 error) {
+	fake.updateOrderStatusMutex.Lock()
+	defer fake.updateOrderStatusMutex.Unlock()
+	fake.UpdateOrderStatusStub = nil
+	if fake.updateOrderStatusReturnsOnCall == nil {
+		fake.updateOrderStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateOrderStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OrderUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cancelOrderMutex.RLock()
+	defer fake.cancelOrderMutex.RUnlock()
+	fake.checkoutMutex.RLock()
+	defer fake.checkoutMutex.RUnlock()
+	fake.getOrderByUIDMutex.RLock()
+	defer fake.getOrderByUIDMutex.RUnlock()
+	fake.getOrderByUIDAndUserIDMutex.RLock()
+	defer fake.getOrderByUIDAndUserIDMutex.RUnlock()
+	fake.listOrdersMutex.RLock()
+	defer fake.listOrdersMutex.RUnlock()
+	fake.listOrdersByUserIDMutex.RLock()
+	defer fake.listOrdersByUserIDMutex.RUnlock()
+	fake.updateOrderStatusMutex.RLock()
+	defer fake.updateOrderStatusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *OrderUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.OrderUsecase = new(OrderUsecaseMock)
@@ -0,0 +1,444 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type PaymentUsecaseMock struct {
+	CreatePaymentStub        func(context.Context, *domain.PaymentUsecasePayloadCreatePayment) (*domain.PaymentControllerResponseGetPayment, error)
+	createPaymentMutex       sync.RWMutex
+	createPaymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.PaymentUsecasePayloadCreatePayment
+	}
+	createPaymentReturns struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}
+	createPaymentReturnsOnCall map[int]struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}
+	GetPaymentByOrderUIDStub        func(context.Context, string, int) (*domain.PaymentControllerResponseGetPayment, error)
+	getPaymentByOrderUIDMutex       sync.RWMutex
+	getPaymentByOrderUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	getPaymentByOrderUIDReturns struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}
+	getPaymentByOrderUIDReturnsOnCall map[int]struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}
+	HandleWebhookStub        func(context.Context, http.Header, []byte) error
+	handleWebhookMutex       sync.RWMutex
+	handleWebhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 http.Header
+		arg3 []byte
+	}
+	handleWebhookReturns struct {
+		result1 error
+	}
+	handleWebhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RefundPaymentStub        func(context.Context, string, string, int) error
+	refundPaymentMutex       sync.RWMutex
+	refundPaymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	refundPaymentReturns struct {
+		result1 error
+	}
+	refundPaymentReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SimulatePaymentStub        func(context.Context, string, string) error
+	simulatePaymentMutex       sync.RWMutex
+	simulatePaymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	simulatePaymentReturns struct {
+		result1 error
+	}
+	simulatePaymentReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *PaymentUsecaseMock) CreatePayment(arg1 context.Context, arg2 *domain.PaymentUsecasePayloadCreatePayment) (*domain.PaymentControllerResponseGetPayment, error) {
+	fake.createPaymentMutex.Lock()
+	ret, specificReturn := fake.createPaymentReturnsOnCall[len(fake.createPaymentArgsForCall)]
+	fake.createPaymentArgsForCall = append(fake.createPaymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.PaymentUsecasePayloadCreatePayment
+	}{arg1, arg2})
+	stub := fake.CreatePaymentStub
+	fakeReturns := fake.createPaymentReturns
+	fake.recordInvocation("CreatePayment", []interface{}{arg1, arg2})
+	fake.createPaymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PaymentUsecaseMock) CreatePaymentCallCount() int {
+	fake.createPaymentMutex.RLock()
+	defer fake.createPaymentMutex.RUnlock()
+	return len(fake.createPaymentArgsForCall)
+}
+
+func (fake *PaymentUsecaseMock) CreatePaymentCalls(stub func(context.Context, *domain.PaymentUsecasePayloadCreatePayment) (*domain.PaymentControllerResponseGetPayment, error)) {
+	fake.createPaymentMutex.Lock()
+	defer fake.createPaymentMutex.Unlock()
+	fake.CreatePaymentStub = stub
+}
+
+func (fake *PaymentUsecaseMock) CreatePaymentArgsForCall(i int) (context.Context, *domain.PaymentUsecasePayloadCreatePayment) {
+	fake.createPaymentMutex.RLock()
+	defer fake.createPaymentMutex.RUnlock()
+	argsForCall := fake.createPaymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *PaymentUsecaseMock) CreatePaymentReturns(result1 *domain.PaymentControllerResponseGetPayment, result2 error) {
+	fake.createPaymentMutex.Lock()
+	defer fake.createPaymentMutex.Unlock()
+	fake.CreatePaymentStub = nil
+	fake.createPaymentReturns = struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PaymentUsecaseMock) CreatePaymentReturnsOnCall(i int, result1 *domain.PaymentControllerResponseGetPayment, result2 error) {
+	fake.createPaymentMutex.Lock()
+	defer fake.createPaymentMutex.Unlock()
+	fake.CreatePaymentStub = nil
+	if fake.createPaymentReturnsOnCall == nil {
+		fake.createPaymentReturnsOnCall = make(map[int]struct {
+			result1 *domain.PaymentControllerResponseGetPayment
+			result2 error
+		})
+	}
+	fake.createPaymentReturnsOnCall[i] = struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PaymentUsecaseMock) GetPaymentByOrderUID(arg1 context.Context, arg2 string, arg3 int) (*domain.PaymentControllerResponseGetPayment, error) {
+	fake.getPaymentByOrderUIDMutex.Lock()
+	ret, specificReturn := fake.getPaymentByOrderUIDReturnsOnCall[len(fake.getPaymentByOrderUIDArgsForCall)]
+	fake.getPaymentByOrderUIDArgsForCall = append(fake.getPaymentByOrderUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetPaymentByOrderUIDStub
+	fakeReturns := fake.getPaymentByOrderUIDReturns
+	fake.recordInvocation("GetPaymentByOrderUID", []interface{}{arg1, arg2, arg3})
+	fake.getPaymentByOrderUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PaymentUsecaseMock) GetPaymentByOrderUIDCallCount() int {
+	fake.getPaymentByOrderUIDMutex.RLock()
+	defer fake.getPaymentByOrderUIDMutex.RUnlock()
+	return len(fake.getPaymentByOrderUIDArgsForCall)
+}
+
+func (fake *PaymentUsecaseMock) GetPaymentByOrderUIDCalls(stub func(context.Context, string, int) (*domain.PaymentControllerResponseGetPayment, error)) {
+	fake.getPaymentByOrderUIDMutex.Lock()
+	defer fake.getPaymentByOrderUIDMutex.Unlock()
+	fake.GetPaymentByOrderUIDStub = stub
+}
+
+func (fake *PaymentUsecaseMock) GetPaymentByOrderUIDArgsForCall(i int) (context.Context, string, int) {
+	fake.getPaymentByOrderUIDMutex.RLock()
+	defer fake.getPaymentByOrderUIDMutex.RUnlock()
+	argsForCall := fake.getPaymentByOrderUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *PaymentUsecaseMock) GetPaymentByOrderUIDReturns(result1 *domain.PaymentControllerResponseGetPayment, result2 error) {
+	fake.getPaymentByOrderUIDMutex.Lock()
+	defer fake.getPaymentByOrderUIDMutex.Unlock()
+	fake.GetPaymentByOrderUIDStub = nil
+	fake.getPaymentByOrderUIDReturns = struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PaymentUsecaseMock) GetPaymentByOrderUIDReturnsOnCall(i int, result1 *domain.PaymentControllerResponseGetPayment, result2 error) {
+	fake.getPaymentByOrderUIDMutex.Lock()
+	defer fake.getPaymentByOrderUIDMutex.Unlock()
+	fake.GetPaymentByOrderUIDStub = nil
+	if fake.getPaymentByOrderUIDReturnsOnCall == nil {
+		fake.getPaymentByOrderUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.PaymentControllerResponseGetPayment
+			result2 error
+		})
+	}
+	fake.getPaymentByOrderUIDReturnsOnCall[i] = struct {
+		result1 *domain.PaymentControllerResponseGetPayment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PaymentUsecaseMock) HandleWebhook(arg1 context.Context, arg2 http.Header, arg3 []byte) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.handleWebhookMutex.Lock()
+	ret, specificReturn := fake.handleWebhookReturnsOnCall[len(fake.handleWebhookArgsForCall)]
+	fake.handleWebhookArgsForCall = append(fake.handleWebhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 http.Header
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	stub := fake.HandleWebhookStub
+	fakeReturns := fake.handleWebhookReturns
+	fake.recordInvocation("HandleWebhook", []interface{}{arg1, arg2, arg3Copy})
+	fake.handleWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *PaymentUsecaseMock) HandleWebhookCallCount() int {
+	fake.handleWebhookMutex.RLock()
+	defer fake.handleWebhookMutex.RUnlock()
+	return len(fake.handleWebhookArgsForCall)
+}
+
+func (fake *PaymentUsecaseMock) HandleWebhookCalls(stub func(context.Context, http.Header, []byte) error) {
+	fake.handleWebhookMutex.Lock()
+	defer fake.handleWebhookMutex.Unlock()
+	fake.HandleWebhookStub = stub
+}
+
+func (fake *PaymentUsecaseMock) HandleWebhookArgsForCall(i int) (context.Context, http.Header, []byte) {
+	fake.handleWebhookMutex.RLock()
+	defer fake.handleWebhookMutex.RUnlock()
+	argsForCall := fake.handleWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *PaymentUsecaseMock) HandleWebhookReturns(result1 error) {
+	fake.handleWebhookMutex.Lock()
+	defer fake.handleWebhookMutex.Unlock()
+	fake.HandleWebhookStub = nil
+	fake.handleWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *PaymentUsecaseMock) HandleWebhookReturnsOnCall(i int, result1 error) {
+	fake.handleWebhookMutex.Lock()
+	defer fake.handleWebhookMutex.Unlock()
+	fake.HandleWebhookStub = nil
+	if fake.handleWebhookReturnsOnCall == nil {
+		fake.handleWebhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.handleWebhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *PaymentUsecaseMock) RefundPayment(arg1 context.Context, arg2 string, arg3 string, arg4 int) error {
+	fake.refundPaymentMutex.Lock()
+	ret, specificReturn := fake.refundPaymentReturnsOnCall[len(fake.refundPaymentArgsForCall)]
+	fake.refundPaymentArgsForCall = append(fake.refundPaymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RefundPaymentStub
+	fakeReturns := fake.refundPaymentReturns
+	fake.recordInvocation("RefundPayment", []interface{}{arg1, arg2, arg3, arg4})
+	fake.refundPaymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *PaymentUsecaseMock) RefundPaymentCallCount() int {
+	fake.refundPaymentMutex.RLock()
+	defer fake.refundPaymentMutex.RUnlock()
+	return len(fake.refundPaymentArgsForCall)
+}
+
+func (fake *PaymentUsecaseMock) RefundPaymentCalls(stub func(context.Context, string, string, int) error) {
+	fake.refundPaymentMutex.Lock()
+	defer fake.refundPaymentMutex.Unlock()
+	fake.RefundPaymentStub = stub
+}
+
+func (fake *PaymentUsecaseMock) RefundPaymentArgsForCall(i int) (context.Context, string, string, int) {
+	fake.refundPaymentMutex.RLock()
+	defer fake.refundPaymentMutex.RUnlock()
+	argsForCall := fake.refundPaymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *PaymentUsecaseMock) RefundPaymentReturns(result1 error) {
+	fake.refundPaymentMutex.Lock()
+	defer fake.refundPaymentMutex.Unlock()
+	fake.RefundPaymentStub = nil
+	fake.refundPaymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *PaymentUsecaseMock) RefundPaymentReturnsOnCall(i int, result1 error) {
+	fake.refundPaymentMutex.Lock()
+	defer fake.refundPaymentMutex.Unlock()
+	fake.RefundPaymentStub = nil
+	if fake.refundPaymentReturnsOnCall == nil {
+		fake.refundPaymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refundPaymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *PaymentUsecaseMock) SimulatePayment(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.simulatePaymentMutex.Lock()
+	ret, specificReturn := fake.simulatePaymentReturnsOnCall[len(fake.simulatePaymentArgsForCall)]
+	fake.simulatePaymentArgsForCall = append(fake.simulatePaymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SimulatePaymentStub
+	fakeReturns := fake.simulatePaymentReturns
+	fake.recordInvocation("SimulatePayment", []interface{}{arg1, arg2, arg3})
+	fake.simulatePaymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *PaymentUsecaseMock) SimulatePaymentCallCount() int {
+	fake.simulatePaymentMutex.RLock()
+	defer fake.simulatePaymentMutex.RUnlock()
+	return len(fake.simulatePaymentArgsForCall)
+}
+
+func (fake *PaymentUsecaseMock) SimulatePaymentCalls(stub func(context.Context, string, string) error) {
+	fake.simulatePaymentMutex.Lock()
+	defer fake.simulatePaymentMutex.Unlock()
+	fake.SimulatePaymentStub = stub
+}
+
+func (fake *PaymentUsecaseMock) SimulatePaymentArgsForCall(i int) (context.Context, string, string) {
+	fake.simulatePaymentMutex.RLock()
+	defer fake.simulatePaymentMutex.RUnlock()
+	argsForCall := fake.simulatePaymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *PaymentUsecaseMock) SimulatePaymentReturns(result1 error) {
+	fake.simulatePaymentMutex.Lock()
+	defer fake.simulatePaymentMutex.Unlock()
+	fake.SimulatePaymentStub = nil
+	fake.simulatePaymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *PaymentUsecaseMock) SimulatePaymentReturnsOnCall(i int, result1 error) {
+	fake.simulatePaymentMutex.Lock()
+	defer fake.simulatePaymentMutex.Unlock()
+	fake.SimulatePaymentStub = nil
+	if fake.simulatePaymentReturnsOnCall == nil {
+		fake.simulatePaymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.simulatePaymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *PaymentUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createPaymentMutex.RLock()
+	defer fake.createPaymentMutex.RUnlock()
+	fake.getPaymentByOrderUIDMutex.RLock()
+	defer fake.getPaymentByOrderUIDMutex.RUnlock()
+	fake.handleWebhookMutex.RLock()
+	defer fake.handleWebhookMutex.RUnlock()
+	fake.refundPaymentMutex.RLock()
+	defer fake.refundPaymentMutex.RUnlock()
+	fake.simulatePaymentMutex.RLock()
+	defer fake.simulatePaymentMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *PaymentUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.PaymentUsecase = new(PaymentUsecaseMock)
@@ -0,0 +1,439 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type ProductUsecaseMock struct {
+	CreateStub        func(context.Context, *domain.ProductUsecasePayloadCreateProduct) (string, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.ProductUsecasePayloadCreateProduct
+	}
+	createReturns struct {
+		result1 string
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DeleteByUIDStub        func(context.Context, string) error
+	deleteByUIDMutex       sync.RWMutex
+	deleteByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteByUIDReturns struct {
+		result1 error
+	}
+	deleteByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetByUIDStub        func(context.Context, string) (*domain.ProductControllerResponseGetProductByUID, error)
+	getByUIDMutex       sync.RWMutex
+	getByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getByUIDReturns struct {
+		result1 *domain.ProductControllerResponseGetProductByUID
+		result2 error
+	}
+	getByUIDReturnsOnCall map[int]struct {
+		result1 *domain.ProductControllerResponseGetProductByUID
+		result2 error
+	}
+	ListStub        func(context.Context, int, string, string) (*domain.ProductControllerResponseListProducts, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 string
+	}
+	listReturns struct {
+		result1 *domain.ProductControllerResponseListProducts
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 *domain.ProductControllerResponseListProducts
+		result2 error
+	}
+	UpdateByUIDStub        func(context.Context, string, *domain.ProductUsecasePayloadUpdateProduct) error
+	updateByUIDMutex       sync.RWMutex
+	updateByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *domain.ProductUsecasePayloadUpdateProduct
+	}
+	updateByUIDReturns struct {
+		result1 error
+	}
+	updateByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *ProductUsecaseMock) Create(arg1 context.Context, arg2 *domain.ProductUsecasePayloadCreateProduct) (string, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.ProductUsecasePayloadCreateProduct
+	}{arg1, arg2})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ProductUsecaseMock) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *ProductUsecaseMock) CreateCalls(stub func(context.Context, *domain.ProductUsecasePayloadCreateProduct) (string, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *ProductUsecaseMock) CreateArgsForCall(i int) (context.Context, *domain.ProductUsecasePayloadCreateProduct) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ProductUsecaseMock) CreateReturns(result1 string, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ProductUsecaseMock) CreateReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ProductUsecaseMock) DeleteByUID(arg1 context.Context, arg2 string) error {
+	fake.deleteByUIDMutex.Lock()
+	ret, specificReturn := fake.deleteByUIDReturnsOnCall[len(fake.deleteByUIDArgsForCall)]
+	fake.deleteByUIDArgsForCall = append(fake.deleteByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteByUIDStub
+	fakeReturns := fake.deleteByUIDReturns
+	fake.recordInvocation("DeleteByUID", []interface{}{arg1, arg2})
+	fake.deleteByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *ProductUsecaseMock) DeleteByUIDCallCount() int {
+	fake.deleteByUIDMutex.RLock()
+	defer fake.deleteByUIDMutex.RUnlock()
+	return len(fake.deleteByUIDArgsForCall)
+}
+
+func (fake *ProductUsecaseMock) DeleteByUIDCalls(stub func(context.Context, string) error) {
+	fake.deleteByUIDMutex.Lock()
+	defer fake.deleteByUIDMutex.Unlock()
+	fake.DeleteByUIDStub = stub
+}
+
+func (fake *ProductUsecaseMock) DeleteByUIDArgsForCall(i int) (context.Context, string) {
+	fake.deleteByUIDMutex.RLock()
+	defer fake.deleteByUIDMutex.RUnlock()
+	argsForCall := fake.deleteByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ProductUsecaseMock) DeleteByUIDReturns(result1 error) {
+	fake.deleteByUIDMutex.Lock()
+	defer fake.deleteByUIDMutex.Unlock()
+	fake.DeleteByUIDStub = nil
+	fake.deleteByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ProductUsecaseMock) DeleteByUIDReturnsOnCall(i int, result1 error) {
+	fake.deleteByUIDMutex.Lock()
+	defer fake.deleteByUIDMutex.Unlock()
+	fake.DeleteByUIDStub = nil
+	if fake.deleteByUIDReturnsOnCall == nil {
+		fake.deleteByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ProductUsecaseMock) GetByUID(arg1 context.Context, arg2 string) (*domain.ProductControllerResponseGetProductByUID, error) {
+	fake.getByUIDMutex.Lock()
+	ret, specificReturn := fake.getByUIDReturnsOnCall[len(fake.getByUIDArgsForCall)]
+	fake.getByUIDArgsForCall = append(fake.getByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetByUIDStub
+	fakeReturns := fake.getByUIDReturns
+	fake.recordInvocation("GetByUID", []interface{}{arg1, arg2})
+	fake.getByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ProductUsecaseMock) GetByUIDCallCount() int {
+	fake.getByUIDMutex.RLock()
+	defer fake.getByUIDMutex.RUnlock()
+	return len(fake.getByUIDArgsForCall)
+}
+
+func (fake *ProductUsecaseMock) GetByUIDCalls(stub func(context.Context, string) (*domain.ProductControllerResponseGetProductByUID, error)) {
+	fake.getByUIDMutex.Lock()
+	defer fake.getByUIDMutex.Unlock()
+	fake.GetByUIDStub = stub
+}
+
+func (fake *ProductUsecaseMock) GetByUIDArgsForCall(i int) (context.Context, string) {
+	fake.getByUIDMutex.RLock()
+	defer fake.getByUIDMutex.RUnlock()
+	argsForCall := fake.getByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ProductUsecaseMock) GetByUIDReturns(result1 *domain.ProductControllerResponseGetProductByUID, result2 error) {
+	fake.getByUIDMutex.Lock()
+	defer fake.getByUIDMutex.Unlock()
+	fake.GetByUIDStub = nil
+	fake.getByUIDReturns = struct {
+		result1 *domain.ProductControllerResponseGetProductByUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ProductUsecaseMock) GetByUIDReturnsOnCall(i int, result1 *domain.ProductControllerResponseGetProductByUID, result2 error) {
+	fake.getByUIDMutex.Lock()
+	defer fake.getByUIDMutex.Unlock()
+	fake.GetByUIDStub = nil
+	if fake.getByUIDReturnsOnCall == nil {
+		fake.getByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.ProductControllerResponseGetProductByUID
+			result2 error
+		})
+	}
+	fake.getByUIDReturnsOnCall[i] = struct {
+		result1 *domain.ProductControllerResponseGetProductByUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ProductUsecaseMock) List(arg1 context.Context, arg2 int, arg3 string, arg4 string) (*domain.ProductControllerResponseListProducts, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ProductUsecaseMock) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *ProductUsecaseMock) ListCalls(stub func(context.Context, int, string, string) (*domain.ProductControllerResponseListProducts, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *ProductUsecaseMock) ListArgsForCall(i int) (context.Context, int, string, string) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *ProductUsecaseMock) ListReturns(result1 *domain.ProductControllerResponseListProducts, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 *domain.ProductControllerResponseListProducts
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ProductUsecaseMock) ListReturnsOnCall(i int, result1 *domain.ProductControllerResponseListProducts, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 *domain.ProductControllerResponseListProducts
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 *domain.ProductControllerResponseListProducts
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ProductUsecaseMock) UpdateByUID(arg1 context.Context, arg2 string, arg3 *domain.ProductUsecasePayloadUpdateProduct) error {
+	fake.updateByUIDMutex.Lock()
+	ret, specificReturn := fake.updateByUIDReturnsOnCall[len(fake.updateByUIDArgsForCall)]
+	fake.updateByUIDArgsForCall = append(fake.updateByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *domain.ProductUsecasePayloadUpdateProduct
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateByUIDStub
+	fakeReturns := fake.updateByUIDReturns
+	fake.recordInvocation("UpdateByUID", []interface{}{arg1, arg2, arg3})
+	fake.updateByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *ProductUsecaseMock) UpdateByUIDCallCount() int {
+	fake.updateByUIDMutex.RLock()
+	defer fake.updateByUIDMutex.RUnlock()
+	return len(fake.updateByUIDArgsForCall)
+}
+
+func (fake *ProductUsecaseMock) UpdateByUIDCalls(stub func(context.Context, string, *domain.ProductUsecasePayloadUpdateProduct) error) {
+	fake.updateByUIDMutex.Lock()
+	defer fake.updateByUIDMutex.Unlock()
+	fake.UpdateByUIDStub = stub
+}
+
+func (fake *ProductUsecaseMock) UpdateByUIDArgsForCall(i int) (context.Context, string, *domain.ProductUsecasePayloadUpdateProduct) {
+	fake.updateByUIDMutex.RLock()
+	defer fake.updateByUIDMutex.RUnlock()
+	argsForCall := fake.updateByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *ProductUsecaseMock) UpdateByUIDReturns(result1 error) {
+	fake.updateByUIDMutex.Lock()
+	defer fake.updateByUIDMutex.Unlock()
+	fake.UpdateByUIDStub = nil
+	fake.updateByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ProductUsecaseMock) UpdateByUIDReturnsOnCall(i int, result1 error) {
+	fake.updateByUIDMutex.Lock()
+	defer fake.updateByUIDMutex.Unlock()
+	fake.UpdateByUIDStub = nil
+	if fake.updateByUIDReturnsOnCall == nil {
+		fake.updateByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ProductUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.deleteByUIDMutex.RLock()
+	defer fake.deleteByUIDMutex.RUnlock()
+	fake.getByUIDMutex.RLock()
+	defer fake.getByUIDMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.updateByUIDMutex.RLock()
+	defer fake.updateByUIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ProductUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.ProductUsecase = new(ProductUsecaseMock)
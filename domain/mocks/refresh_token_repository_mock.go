@@ -0,0 +1,515 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type RefreshTokenRepositoryMock struct {
+	CreateRefreshTokenStub        func(context.Context, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error
+	createRefreshTokenMutex       sync.RWMutex
+	createRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.RefreshTokenRepositoryPayloadCreateRefreshToken
+	}
+	createRefreshTokenReturns struct {
+		result1 error
+	}
+	createRefreshTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRefreshTokenByHashStub        func(context.Context, string) (*domain.RefreshTokenModel, error)
+	getRefreshTokenByHashMutex       sync.RWMutex
+	getRefreshTokenByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getRefreshTokenByHashReturns struct {
+		result1 *domain.RefreshTokenModel
+		result2 error
+	}
+	getRefreshTokenByHashReturnsOnCall map[int]struct {
+		result1 *domain.RefreshTokenModel
+		result2 error
+	}
+	ListActiveSessionsByUserIDStub        func(context.Context, int, time.Time) ([]*domain.SessionModel, error)
+	listActiveSessionsByUserIDMutex       sync.RWMutex
+	listActiveSessionsByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	listActiveSessionsByUserIDReturns struct {
+		result1 []*domain.SessionModel
+		result2 error
+	}
+	listActiveSessionsByUserIDReturnsOnCall map[int]struct {
+		result1 []*domain.SessionModel
+		result2 error
+	}
+	RevokeRefreshTokenFamilyStub        func(context.Context, string, time.Time) error
+	revokeRefreshTokenFamilyMutex       sync.RWMutex
+	revokeRefreshTokenFamilyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	revokeRefreshTokenFamilyReturns struct {
+		result1 error
+	}
+	revokeRefreshTokenFamilyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeRefreshTokensByUserIDStub        func(context.Context, int, time.Time) error
+	revokeRefreshTokensByUserIDMutex       sync.RWMutex
+	revokeRefreshTokensByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	revokeRefreshTokensByUserIDReturns struct {
+		result1 error
+	}
+	revokeRefreshTokensByUserIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RotateRefreshTokenStub        func(context.Context, int, time.Time, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error
+	rotateRefreshTokenMutex       sync.RWMutex
+	rotateRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+		arg4 *domain.RefreshTokenRepositoryPayloadCreateRefreshToken
+	}
+	rotateRefreshTokenReturns struct {
+		result1 error
+	}
+	rotateRefreshTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *RefreshTokenRepositoryMock) CreateRefreshToken(arg1 context.Context, arg2 *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error {
+	fake.createRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.createRefreshTokenReturnsOnCall[len(fake.createRefreshTokenArgsForCall)]
+	fake.createRefreshTokenArgsForCall = append(fake.createRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.RefreshTokenRepositoryPayloadCreateRefreshToken
+	}{arg1, arg2})
+	stub := fake.CreateRefreshTokenStub
+	fakeReturns := fake.createRefreshTokenReturns
+	fake.recordInvocation("CreateRefreshToken", []interface{}{arg1, arg2})
+	fake.createRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RefreshTokenRepositoryMock) CreateRefreshTokenCallCount() int {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	return len(fake.createRefreshTokenArgsForCall)
+}
+
+func (fake *RefreshTokenRepositoryMock) CreateRefreshTokenCalls(stub func(context.Context, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = stub
+}
+
+func (fake *RefreshTokenRepositoryMock) CreateRefreshTokenArgsForCall(i int) (context.Context, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	argsForCall := fake.createRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *RefreshTokenRepositoryMock) CreateRefreshTokenReturns(result1 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	fake.createRefreshTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) CreateRefreshTokenReturnsOnCall(i int, result1 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	if fake.createRefreshTokenReturnsOnCall == nil {
+		fake.createRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createRefreshTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) GetRefreshTokenByHash(arg1 context.Context, arg2 string) (*domain.RefreshTokenModel, error) {
+	fake.getRefreshTokenByHashMutex.Lock()
+	ret, specificReturn := fake.getRefreshTokenByHashReturnsOnCall[len(fake.getRefreshTokenByHashArgsForCall)]
+	fake.getRefreshTokenByHashArgsForCall = append(fake.getRefreshTokenByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRefreshTokenByHashStub
+	fakeReturns := fake.getRefreshTokenByHashReturns
+	fake.recordInvocation("GetRefreshTokenByHash", []interface{}{arg1, arg2})
+	fake.getRefreshTokenByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *RefreshTokenRepositoryMock) GetRefreshTokenByHashCallCount() int {
+	fake.getRefreshTokenByHashMutex.RLock()
+	defer fake.getRefreshTokenByHashMutex.RUnlock()
+	return len(fake.getRefreshTokenByHashArgsForCall)
+}
+
+func (fake *RefreshTokenRepositoryMock) GetRefreshTokenByHashCalls(stub func(context.Context, string) (*domain.RefreshTokenModel, error)) {
+	fake.getRefreshTokenByHashMutex.Lock()
+	defer fake.getRefreshTokenByHashMutex.Unlock()
+	fake.GetRefreshTokenByHashStub = stub
+}
+
+func (fake *RefreshTokenRepositoryMock) GetRefreshTokenByHashArgsForCall(i int) (context.Context, string) {
+	fake.getRefreshTokenByHashMutex.RLock()
+	defer fake.getRefreshTokenByHashMutex.RUnlock()
+	argsForCall := fake.getRefreshTokenByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *RefreshTokenRepositoryMock) GetRefreshTokenByHashReturns(result1 *domain.RefreshTokenModel, result2 error) {
+	fake.getRefreshTokenByHashMutex.Lock()
+	defer fake.getRefreshTokenByHashMutex.Unlock()
+	fake.GetRefreshTokenByHashStub = nil
+	fake.getRefreshTokenByHashReturns = struct {
+		result1 *domain.RefreshTokenModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RefreshTokenRepositoryMock) GetRefreshTokenByHashReturnsOnCall(i int, result1 *domain.RefreshTokenModel, result2 error) {
+	fake.getRefreshTokenByHashMutex.Lock()
+	defer fake.getRefreshTokenByHashMutex.Unlock()
+	fake.GetRefreshTokenByHashStub = nil
+	if fake.getRefreshTokenByHashReturnsOnCall == nil {
+		fake.getRefreshTokenByHashReturnsOnCall = make(map[int]struct {
+			result1 *domain.RefreshTokenModel
+			result2 error
+		})
+	}
+	fake.getRefreshTokenByHashReturnsOnCall[i] = struct {
+		result1 *domain.RefreshTokenModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RefreshTokenRepositoryMock) ListActiveSessionsByUserID(arg1 context.Context, arg2 int, arg3 time.Time) ([]*domain.SessionModel, error) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	ret, specificReturn := fake.listActiveSessionsByUserIDReturnsOnCall[len(fake.listActiveSessionsByUserIDArgsForCall)]
+	fake.listActiveSessionsByUserIDArgsForCall = append(fake.listActiveSessionsByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.ListActiveSessionsByUserIDStub
+	fakeReturns := fake.listActiveSessionsByUserIDReturns
+	fake.recordInvocation("ListActiveSessionsByUserID", []interface{}{arg1, arg2, arg3})
+	fake.listActiveSessionsByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *RefreshTokenRepositoryMock) ListActiveSessionsByUserIDCallCount() int {
+	fake.listActiveSessionsByUserIDMutex.RLock()
+	defer fake.listActiveSessionsByUserIDMutex.RUnlock()
+	return len(fake.listActiveSessionsByUserIDArgsForCall)
+}
+
+func (fake *RefreshTokenRepositoryMock) ListActiveSessionsByUserIDCalls(stub func(context.Context, int, time.Time) ([]*domain.SessionModel, error)) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	defer fake.listActiveSessionsByUserIDMutex.Unlock()
+	fake.ListActiveSessionsByUserIDStub = stub
+}
+
+func (fake *RefreshTokenRepositoryMock) ListActiveSessionsByUserIDArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.listActiveSessionsByUserIDMutex.RLock()
+	defer fake.listActiveSessionsByUserIDMutex.RUnlock()
+	argsForCall := fake.listActiveSessionsByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RefreshTokenRepositoryMock) ListActiveSessionsByUserIDReturns(result1 []*domain.SessionModel, result2 error) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	defer fake.listActiveSessionsByUserIDMutex.Unlock()
+	fake.ListActiveSessionsByUserIDStub = nil
+	fake.listActiveSessionsByUserIDReturns = struct {
+		result1 []*domain.SessionModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RefreshTokenRepositoryMock) ListActiveSessionsByUserIDReturnsOnCall(i int, result1 []*domain.SessionModel, result2 error) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	defer fake.listActiveSessionsByUserIDMutex.Unlock()
+	fake.ListActiveSessionsByUserIDStub = nil
+	if fake.listActiveSessionsByUserIDReturnsOnCall == nil {
+		fake.listActiveSessionsByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []*domain.SessionModel
+			result2 error
+		})
+	}
+	fake.listActiveSessionsByUserIDReturnsOnCall[i] = struct {
+		result1 []*domain.SessionModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokenFamily(arg1 context.Context, arg2 string, arg3 time.Time) error {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	ret, specificReturn := fake.revokeRefreshTokenFamilyReturnsOnCall[len(fake.revokeRefreshTokenFamilyArgsForCall)]
+	fake.revokeRefreshTokenFamilyArgsForCall = append(fake.revokeRefreshTokenFamilyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeRefreshTokenFamilyStub
+	fakeReturns := fake.revokeRefreshTokenFamilyReturns
+	fake.recordInvocation("RevokeRefreshTokenFamily", []interface{}{arg1, arg2, arg3})
+	fake.revokeRefreshTokenFamilyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyCallCount() int {
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	return len(fake.revokeRefreshTokenFamilyArgsForCall)
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyCalls(stub func(context.Context, string, time.Time) error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = stub
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	argsForCall := fake.revokeRefreshTokenFamilyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyReturns(result1 error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = nil
+	fake.revokeRefreshTokenFamilyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyReturnsOnCall(i int, result1 error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = nil
+	if fake.revokeRefreshTokenFamilyReturnsOnCall == nil {
+		fake.revokeRefreshTokenFamilyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeRefreshTokenFamilyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserID(arg1 context.Context, arg2 int, arg3 time.Time) error {
+	fake.revokeRefreshTokensByUserIDMutex.Lock()
+	ret, specificReturn := fake.revokeRefreshTokensByUserIDReturnsOnCall[len(fake.revokeRefreshTokensByUserIDArgsForCall)]
+	fake.revokeRefreshTokensByUserIDArgsForCall = append(fake.revokeRefreshTokensByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeRefreshTokensByUserIDStub
+	fakeReturns := fake.revokeRefreshTokensByUserIDReturns
+	fake.recordInvocation("RevokeRefreshTokensByUserID", []interface{}{arg1, arg2, arg3})
+	fake.revokeRefreshTokensByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserIDCallCount() int {
+	fake.revokeRefreshTokensByUserIDMutex.RLock()
+	defer fake.revokeRefreshTokensByUserIDMutex.RUnlock()
+	return len(fake.revokeRefreshTokensByUserIDArgsForCall)
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserIDCalls(stub func(context.Context, int, time.Time) error) {
+	fake.revokeRefreshTokensByUserIDMutex.Lock()
+	defer fake.revokeRefreshTokensByUserIDMutex.Unlock()
+	fake.RevokeRefreshTokensByUserIDStub = stub
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserIDArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.revokeRefreshTokensByUserIDMutex.RLock()
+	defer fake.revokeRefreshTokensByUserIDMutex.RUnlock()
+	argsForCall := fake.revokeRefreshTokensByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserIDReturns(result1 error) {
+	fake.revokeRefreshTokensByUserIDMutex.Lock()
+	defer fake.revokeRefreshTokensByUserIDMutex.Unlock()
+	fake.RevokeRefreshTokensByUserIDStub = nil
+	fake.revokeRefreshTokensByUserIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserIDReturnsOnCall(i int, result1 error) {
+	fake.revokeRefreshTokensByUserIDMutex.Lock()
+	defer fake.revokeRefreshTokensByUserIDMutex.Unlock()
+	fake.RevokeRefreshTokensByUserIDStub = nil
+	if fake.revokeRefreshTokensByUserIDReturnsOnCall == nil {
+		fake.revokeRefreshTokensByUserIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeRefreshTokensByUserIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) RotateRefreshToken(arg1 context.Context, arg2 int, arg3 time.Time, arg4 *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error {
+	fake.rotateRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.rotateRefreshTokenReturnsOnCall[len(fake.rotateRefreshTokenArgsForCall)]
+	fake.rotateRefreshTokenArgsForCall = append(fake.rotateRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+		arg4 *domain.RefreshTokenRepositoryPayloadCreateRefreshToken
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RotateRefreshTokenStub
+	fakeReturns := fake.rotateRefreshTokenReturns
+	fake.recordInvocation("RotateRefreshToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rotateRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RefreshTokenRepositoryMock) RotateRefreshTokenCallCount() int {
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	return len(fake.rotateRefreshTokenArgsForCall)
+}
+
+func (fake *RefreshTokenRepositoryMock) RotateRefreshTokenCalls(stub func(context.Context, int, time.Time, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = stub
+}
+
+func (fake *RefreshTokenRepositoryMock) RotateRefreshTokenArgsForCall(i int) (context.Context, int, time.Time, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) {
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	argsForCall := fake.rotateRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *RefreshTokenRepositoryMock) RotateRefreshTokenReturns(result1 error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = nil
+	fake.rotateRefreshTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) RotateRefreshTokenReturnsOnCall(i int, result1 error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = nil
+	if fake.rotateRefreshTokenReturnsOnCall == nil {
+		fake.rotateRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rotateRefreshTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RefreshTokenRepositoryMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	fake.getRefreshTokenByHashMutex.RLock()
+	defer fake.getRefreshTokenByHashMutex.RUnlock()
+	fake.listActiveSessionsByUserIDMutex.RLock()
+	defer fake.listActiveSessionsByUserIDMutex.RUnlock()
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	fake.revokeRefreshTokensByUserIDMutex.RLock()
+	defer fake.revokeRefreshTokensByUserIDMutex.RUnlock()
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *RefreshTokenRepositoryMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.RefreshTokenRepository = new(RefreshTokenRepositoryMock)
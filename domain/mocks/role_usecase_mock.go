@@ -0,0 +1,433 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type RoleUsecaseMock struct {
+	AssignRoleStub        func(context.Context, *domain.RoleUsecasePayloadUpdateUserRole) error
+	assignRoleMutex       sync.RWMutex
+	assignRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.RoleUsecasePayloadUpdateUserRole
+	}
+	assignRoleReturns struct {
+		result1 error
+	}
+	assignRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	HasPermissionStub        func(context.Context, int, string) (bool, error)
+	hasPermissionMutex       sync.RWMutex
+	hasPermissionArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}
+	hasPermissionReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasPermissionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ListRolesStub        func(context.Context) ([]*domain.RoleControllerResponseRole, error)
+	listRolesMutex       sync.RWMutex
+	listRolesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listRolesReturns struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}
+	listRolesReturnsOnCall map[int]struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}
+	ListUserRolesStub        func(context.Context, string) ([]*domain.RoleControllerResponseRole, error)
+	listUserRolesMutex       sync.RWMutex
+	listUserRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listUserRolesReturns struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}
+	listUserRolesReturnsOnCall map[int]struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}
+	RemoveRoleStub        func(context.Context, *domain.RoleUsecasePayloadUpdateUserRole) error
+	removeRoleMutex       sync.RWMutex
+	removeRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.RoleUsecasePayloadUpdateUserRole
+	}
+	removeRoleReturns struct {
+		result1 error
+	}
+	removeRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *RoleUsecaseMock) AssignRole(arg1 context.Context, arg2 *domain.RoleUsecasePayloadUpdateUserRole) error {
+	fake.assignRoleMutex.Lock()
+	ret, specificReturn := fake.assignRoleReturnsOnCall[len(fake.assignRoleArgsForCall)]
+	fake.assignRoleArgsForCall = append(fake.assignRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.RoleUsecasePayloadUpdateUserRole
+	}{arg1, arg2})
+	stub := fake.AssignRoleStub
+	fakeReturns := fake.assignRoleReturns
+	fake.recordInvocation("AssignRole", []interface{}{arg1, arg2})
+	fake.assignRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RoleUsecaseMock) AssignRoleCallCount() int {
+	fake.assignRoleMutex.RLock()
+	defer fake.assignRoleMutex.RUnlock()
+	return len(fake.assignRoleArgsForCall)
+}
+
+func (fake *RoleUsecaseMock) AssignRoleCalls(stub func(context.Context, *domain.RoleUsecasePayloadUpdateUserRole) error) {
+	fake.assignRoleMutex.Lock()
+	defer fake.assignRoleMutex.Unlock()
+	fake.AssignRoleStub = stub
+}
+
+func (fake *RoleUsecaseMock) AssignRoleArgsForCall(i int) (context.Context, *domain.RoleUsecasePayloadUpdateUserRole) {
+	fake.assignRoleMutex.RLock()
+	defer fake.assignRoleMutex.RUnlock()
+	argsForCall := fake.assignRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *RoleUsecaseMock) AssignRoleReturns(result1 error) {
+	fake.assignRoleMutex.Lock()
+	defer fake.assignRoleMutex.Unlock()
+	fake.AssignRoleStub = nil
+	fake.assignRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RoleUsecaseMock) AssignRoleReturnsOnCall(i int, result1 error) {
+	fake.assignRoleMutex.Lock()
+	defer fake.assignRoleMutex.Unlock()
+	fake.AssignRoleStub = nil
+	if fake.assignRoleReturnsOnCall == nil {
+		fake.assignRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.assignRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RoleUsecaseMock) HasPermission(arg1 context.Context, arg2 int, arg3 string) (bool, error) {
+	fake.hasPermissionMutex.Lock()
+	ret, specificReturn := fake.hasPermissionReturnsOnCall[len(fake.hasPermissionArgsForCall)]
+	fake.hasPermissionArgsForCall = append(fake.hasPermissionArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.HasPermissionStub
+	fakeReturns := fake.hasPermissionReturns
+	fake.recordInvocation("HasPermission", []interface{}{arg1, arg2, arg3})
+	fake.hasPermissionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *RoleUsecaseMock) HasPermissionCallCount() int {
+	fake.hasPermissionMutex.RLock()
+	defer fake.hasPermissionMutex.RUnlock()
+	return len(fake.hasPermissionArgsForCall)
+}
+
+func (fake *RoleUsecaseMock) HasPermissionCalls(stub func(context.Context, int, string) (bool, error)) {
+	fake.hasPermissionMutex.Lock()
+	defer fake.hasPermissionMutex.Unlock()
+	fake.HasPermissionStub = stub
+}
+
+func (fake *RoleUsecaseMock) HasPermissionArgsForCall(i int) (context.Context, int, string) {
+	fake.hasPermissionMutex.RLock()
+	defer fake.hasPermissionMutex.RUnlock()
+	argsForCall := fake.hasPermissionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RoleUsecaseMock) HasPermissionReturns(result1 bool, result2 error) {
+	fake.hasPermissionMutex.Lock()
+	defer fake.hasPermissionMutex.Unlock()
+	fake.HasPermissionStub = nil
+	fake.hasPermissionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RoleUsecaseMock) HasPermissionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasPermissionMutex.Lock()
+	defer fake.hasPermissionMutex.Unlock()
+	fake.HasPermissionStub = nil
+	if fake.hasPermissionReturnsOnCall == nil {
+		fake.hasPermissionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasPermissionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RoleUsecaseMock) ListRoles(arg1 context.Context) ([]*domain.RoleControllerResponseRole, error) {
+	fake.listRolesMutex.Lock()
+	ret, specificReturn := fake.listRolesReturnsOnCall[len(fake.listRolesArgsForCall)]
+	fake.listRolesArgsForCall = append(fake.listRolesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListRolesStub
+	fakeReturns := fake.listRolesReturns
+	fake.recordInvocation("ListRoles", []interface{}{arg1})
+	fake.listRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *RoleUsecaseMock) ListRolesCallCount() int {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	return len(fake.listRolesArgsForCall)
+}
+
+func (fake *RoleUsecaseMock) ListRolesCalls(stub func(context.Context) ([]*domain.RoleControllerResponseRole, error)) {
+	fake.listRolesMutex.Lock()
+	defer fake.listRolesMutex.Unlock()
+	fake.ListRolesStub = stub
+}
+
+func (fake *RoleUsecaseMock) ListRolesArgsForCall(i int) context.Context {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	argsForCall := fake.listRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *RoleUsecaseMock) ListRolesReturns(result1 []*domain.RoleControllerResponseRole, result2 error) {
+	fake.listRolesMutex.Lock()
+	defer fake.listRolesMutex.Unlock()
+	fake.ListRolesStub = nil
+	fake.listRolesReturns = struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RoleUsecaseMock) ListRolesReturnsOnCall(i int, result1 []*domain.RoleControllerResponseRole, result2 error) {
+	fake.listRolesMutex.Lock()
+	defer fake.listRolesMutex.Unlock()
+	fake.ListRolesStub = nil
+	if fake.listRolesReturnsOnCall == nil {
+		fake.listRolesReturnsOnCall = make(map[int]struct {
+			result1 []*domain.RoleControllerResponseRole
+			result2 error
+		})
+	}
+	fake.listRolesReturnsOnCall[i] = struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RoleUsecaseMock) ListUserRoles(arg1 context.Context, arg2 string) ([]*domain.RoleControllerResponseRole, error) {
+	fake.listUserRolesMutex.Lock()
+	ret, specificReturn := fake.listUserRolesReturnsOnCall[len(fake.listUserRolesArgsForCall)]
+	fake.listUserRolesArgsForCall = append(fake.listUserRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListUserRolesStub
+	fakeReturns := fake.listUserRolesReturns
+	fake.recordInvocation("ListUserRoles", []interface{}{arg1, arg2})
+	fake.listUserRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *RoleUsecaseMock) ListUserRolesCallCount() int {
+	fake.listUserRolesMutex.RLock()
+	defer fake.listUserRolesMutex.RUnlock()
+	return len(fake.listUserRolesArgsForCall)
+}
+
+func (fake *RoleUsecaseMock) ListUserRolesCalls(stub func(context.Context, string) ([]*domain.RoleControllerResponseRole, error)) {
+	fake.listUserRolesMutex.Lock()
+	defer fake.listUserRolesMutex.Unlock()
+	fake.ListUserRolesStub = stub
+}
+
+func (fake *RoleUsecaseMock) ListUserRolesArgsForCall(i int) (context.Context, string) {
+	fake.listUserRolesMutex.RLock()
+	defer fake.listUserRolesMutex.RUnlock()
+	argsForCall := fake.listUserRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *RoleUsecaseMock) ListUserRolesReturns(result1 []*domain.RoleControllerResponseRole, result2 error) {
+	fake.listUserRolesMutex.Lock()
+	defer fake.listUserRolesMutex.Unlock()
+	fake.ListUserRolesStub = nil
+	fake.listUserRolesReturns = struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RoleUsecaseMock) ListUserRolesReturnsOnCall(i int, result1 []*domain.RoleControllerResponseRole, result2 error) {
+	fake.listUserRolesMutex.Lock()
+	defer fake.listUserRolesMutex.Unlock()
+	fake.ListUserRolesStub = nil
+	if fake.listUserRolesReturnsOnCall == nil {
+		fake.listUserRolesReturnsOnCall = make(map[int]struct {
+			result1 []*domain.RoleControllerResponseRole
+			result2 error
+		})
+	}
+	fake.listUserRolesReturnsOnCall[i] = struct {
+		result1 []*domain.RoleControllerResponseRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RoleUsecaseMock) RemoveRole(arg1 context.Context, arg2 *domain.RoleUsecasePayloadUpdateUserRole) error {
+	fake.removeRoleMutex.Lock()
+	ret, specificReturn := fake.removeRoleReturnsOnCall[len(fake.removeRoleArgsForCall)]
+	fake.removeRoleArgsForCall = append(fake.removeRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.RoleUsecasePayloadUpdateUserRole
+	}{arg1, arg2})
+	stub := fake.RemoveRoleStub
+	fakeReturns := fake.removeRoleReturns
+	fake.recordInvocation("RemoveRole", []interface{}{arg1, arg2})
+	fake.removeRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RoleUsecaseMock) RemoveRoleCallCount() int {
+	fake.removeRoleMutex.RLock()
+	defer fake.removeRoleMutex.RUnlock()
+	return len(fake.removeRoleArgsForCall)
+}
+
+func (fake *RoleUsecaseMock) RemoveRoleCalls(stub func(context.Context, *domain.RoleUsecasePayloadUpdateUserRole) error) {
+	fake.removeRoleMutex.Lock()
+	defer fake.removeRoleMutex.Unlock()
+	fake.RemoveRoleStub = stub
+}
+
+func (fake *RoleUsecaseMock) RemoveRoleArgsForCall(i int) (context.Context, *domain.RoleUsecasePayloadUpdateUserRole) {
+	fake.removeRoleMutex.RLock()
+	defer fake.removeRoleMutex.RUnlock()
+	argsForCall := fake.removeRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *RoleUsecaseMock) RemoveRoleReturns(result1 error) {
+	fake.removeRoleMutex.Lock()
+	defer fake.removeRoleMutex.Unlock()
+	fake.RemoveRoleStub = nil
+	fake.removeRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RoleUsecaseMock) RemoveRoleReturnsOnCall(i int, result1 error) {
+	fake.removeRoleMutex.Lock()
+	defer fake.removeRoleMutex.Unlock()
+	fake.RemoveRoleStub = nil
+	if fake.removeRoleReturnsOnCall == nil {
+		fake.removeRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RoleUsecaseMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.assignRoleMutex.RLock()
+	defer fake.assignRoleMutex.RUnlock()
+	fake.hasPermissionMutex.RLock()
+	defer fake.hasPermissionMutex.RUnlock()
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	fake.listUserRolesMutex.RLock()
+	defer fake.listUserRolesMutex.RUnlock()
+	fake.removeRoleMutex.RLock()
+	defer fake.removeRoleMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *RoleUsecaseMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.RoleUsecase = new(RoleUsecaseMock)
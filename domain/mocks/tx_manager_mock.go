@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type TxManagerMock struct {
+	WithinTransactionStub        func(context.Context, func(ctx context.Context) error) error
+	withinTransactionMutex       sync.RWMutex
+	withinTransactionArgsForCall []struct {
+		arg1 context.Context
+		arg2 func(ctx context.Context) error
+	}
+	withinTransactionReturns struct {
+		result1 error
+	}
+	withinTransactionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *TxManagerMock) WithinTransaction(arg1 context.Context, arg2 func(ctx context.Context) error) error {
+	fake.withinTransactionMutex.Lock()
+	ret, specificReturn := fake.withinTransactionReturnsOnCall[len(fake.withinTransactionArgsForCall)]
+	fake.withinTransactionArgsForCall = append(fake.withinTransactionArgsForCall, struct {
+		arg1 context.Context
+		arg2 func(ctx context.Context) error
+	}{arg1, arg2})
+	stub := fake.WithinTransactionStub
+	fakeReturns := fake.withinTransactionReturns
+	fake.recordInvocation("WithinTransaction", []interface{}{arg1, arg2})
+	fake.withinTransactionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *TxManagerMock) WithinTransactionCallCount() int {
+	fake.withinTransactionMutex.RLock()
+	defer fake.withinTransactionMutex.RUnlock()
+	return len(fake.withinTransactionArgsForCall)
+}
+
+func (fake *TxManagerMock) WithinTransactionCalls(stub func(context.Context, func(ctx context.Context) error) error) {
+	fake.withinTransactionMutex.Lock()
+	defer fake.withinTransactionMutex.Unlock()
+	fake.WithinTransactionStub = stub
+}
+
+func (fake *TxManagerMock) WithinTransactionArgsForCall(i int) (context.Context, func(ctx context.Context) error) {
+	fake.withinTransactionMutex.RLock()
+	defer fake.withinTransactionMutex.RUnlock()
+	argsForCall := fake.withinTransactionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *TxManagerMock) WithinTransactionReturns(result1 error) {
+	fake.withinTransactionMutex.Lock()
+	defer fake.withinTransactionMutex.Unlock()
+	fake.WithinTransactionStub = nil
+	fake.withinTransactionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *TxManagerMock) WithinTransactionReturnsOnCall(i int, result1 error) {
+	fake.withinTransactionMutex.Lock()
+	defer fake.withinTransactionMutex.Unlock()
+	fake.WithinTransactionStub = nil
+	if fake.withinTransactionReturnsOnCall == nil {
+		fake.withinTransactionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.withinTransactionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *TxManagerMock) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.withinTransactionMutex.RLock()
+	defer fake.withinTransactionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *TxManagerMock) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ domain.TxManager = new(TxManagerMock)
@@ -2,16 +2,32 @@
 package mocks
 
 import (
+	"context"
 	"sync"
+	"time"
 
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
 )
 
 type UserRepositoryMock struct {
-	CreateAdminStub        func(*domain.UserRepositoryPayloadCreateAdmin) error
+	AnonymizeUserStub        func(context.Context, int, time.Time) error
+	anonymizeUserMutex       sync.RWMutex
+	anonymizeUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	anonymizeUserReturns struct {
+		result1 error
+	}
+	anonymizeUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateAdminStub        func(context.Context, *domain.UserRepositoryPayloadCreateAdmin) error
 	createAdminMutex       sync.RWMutex
 	createAdminArgsForCall []struct {
-		arg1 *domain.UserRepositoryPayloadCreateAdmin
+		arg1 context.Context
+		arg2 *domain.UserRepositoryPayloadCreateAdmin
 	}
 	createAdminReturns struct {
 		result1 error
@@ -19,10 +35,11 @@ type UserRepositoryMock struct {
 	createAdminReturnsOnCall map[int]struct {
 		result1 error
 	}
-	CreateUserStub        func(*domain.UserRepositoryPayloadCreateUser) (int, error)
+	CreateUserStub        func(context.Context, *domain.UserRepositoryPayloadCreateUser) (int, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
-		arg1 *domain.UserRepositoryPayloadCreateUser
+		arg1 context.Context
+		arg2 *domain.UserRepositoryPayloadCreateUser
 	}
 	createUserReturns struct {
 		result1 int
@@ -32,10 +49,49 @@ type UserRepositoryMock struct {
 		result1 int
 		result2 error
 	}
-	GetAdminByUserIDStub        func(int) (*domain.AdminModel, error)
+	DeleteAdminByUIDStub        func(context.Context, string) error
+	deleteAdminByUIDMutex       sync.RWMutex
+	deleteAdminByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAdminByUIDReturns struct {
+		result1 error
+	}
+	deleteAdminByUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAuthUserDeletionStub        func(context.Context, string) error
+	deleteAuthUserDeletionMutex       sync.RWMutex
+	deleteAuthUserDeletionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAuthUserDeletionReturns struct {
+		result1 error
+	}
+	deleteAuthUserDeletionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAdminByUIDStub        func(context.Context, string) (*domain.AdminModel, error)
+	getAdminByUIDMutex       sync.RWMutex
+	getAdminByUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAdminByUIDReturns struct {
+		result1 *domain.AdminModel
+		result2 error
+	}
+	getAdminByUIDReturnsOnCall map[int]struct {
+		result1 *domain.AdminModel
+		result2 error
+	}
+	GetAdminByUserIDStub        func(context.Context, int) (*domain.AdminModel, error)
 	getAdminByUserIDMutex       sync.RWMutex
 	getAdminByUserIDArgsForCall []struct {
-		arg1 int
+		arg1 context.Context
+		arg2 int
 	}
 	getAdminByUserIDReturns struct {
 		result1 *domain.AdminModel
@@ -45,10 +101,11 @@ type UserRepositoryMock struct {
 		result1 *domain.AdminModel
 		result2 error
 	}
-	GetUserByEmailStub        func(string) (*domain.UserModel, error)
+	GetUserByEmailStub        func(context.Context, string) (*domain.UserModel, error)
 	getUserByEmailMutex       sync.RWMutex
 	getUserByEmailArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getUserByEmailReturns struct {
 		result1 *domain.UserModel
@@ -58,10 +115,11 @@ type UserRepositoryMock struct {
 		result1 *domain.UserModel
 		result2 error
 	}
-	GetUserByFirebaseUIDStub        func(string) (*domain.UserModel, error)
+	GetUserByFirebaseUIDStub        func(context.Context, string) (*domain.UserModel, error)
 	getUserByFirebaseUIDMutex       sync.RWMutex
 	getUserByFirebaseUIDArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getUserByFirebaseUIDReturns struct {
 		result1 *domain.UserModel
@@ -71,10 +129,25 @@ type UserRepositoryMock struct {
 		result1 *domain.UserModel
 		result2 error
 	}
-	GetUserByUIDStub        func(string) (*domain.UserModel, error)
+	GetUserByIDStub        func(context.Context, int) (*domain.UserModel, error)
+	getUserByIDMutex       sync.RWMutex
+	getUserByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getUserByIDReturns struct {
+		result1 *domain.UserModel
+		result2 error
+	}
+	getUserByIDReturnsOnCall map[int]struct {
+		result1 *domain.UserModel
+		result2 error
+	}
+	GetUserByUIDStub        func(context.Context, string) (*domain.UserModel, error)
 	getUserByUIDMutex       sync.RWMutex
 	getUserByUIDArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	getUserByUIDReturns struct {
 		result1 *domain.UserModel
@@ -84,22 +157,152 @@ type UserRepositoryMock struct {
 		result1 *domain.UserModel
 		result2 error
 	}
+	ListAdminsStub        func(context.Context) ([]*domain.AdminModel, error)
+	listAdminsMutex       sync.RWMutex
+	listAdminsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listAdminsReturns struct {
+		result1 []*domain.AdminModel
+		result2 error
+	}
+	listAdminsReturnsOnCall map[int]struct {
+		result1 []*domain.AdminModel
+		result2 error
+	}
+	ListAuthUserDeletionsStub        func(context.Context) ([]string, error)
+	listAuthUserDeletionsMutex       sync.RWMutex
+	listAuthUserDeletionsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listAuthUserDeletionsReturns struct {
+		result1 []string
+		result2 error
+	}
+	listAuthUserDeletionsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	MarkEmailVerifiedStub        func(context.Context, string, time.Time) error
+	markEmailVerifiedMutex       sync.RWMutex
+	markEmailVerifiedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	markEmailVerifiedReturns struct {
+		result1 error
+	}
+	markEmailVerifiedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeTokensStub        func(context.Context, int, time.Time) error
+	revokeTokensMutex       sync.RWMutex
+	revokeTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}
+	revokeTokensReturns struct {
+		result1 error
+	}
+	revokeTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateProfileStub        func(context.Context, *domain.UserRepositoryPayloadUpdateProfile) (*domain.UserModel, error)
+	updateProfileMutex       sync.RWMutex
+	updateProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserRepositoryPayloadUpdateProfile
+	}
+	updateProfileReturns struct {
+		result1 *domain.UserModel
+		result2 error
+	}
+	updateProfileReturnsOnCall map[int]struct {
+		result1 *domain.UserModel
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *UserRepositoryMock) CreateAdmin(arg1 *domain.UserRepositoryPayloadCreateAdmin) error {
+func (fake *UserRepositoryMock) AnonymizeUser(arg1 context.Context, arg2 int, arg3 time.Time) error {
+	fake.anonymizeUserMutex.Lock()
+	ret, specificReturn := fake.anonymizeUserReturnsOnCall[len(fake.anonymizeUserArgsForCall)]
+	fake.anonymizeUserArgsForCall = append(fake.anonymizeUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.AnonymizeUserStub
+	fakeReturns := fake.anonymizeUserReturns
+	fake.recordInvocation("AnonymizeUser", []interface{}{arg1, arg2, arg3})
+	fake.anonymizeUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *UserRepositoryMock) AnonymizeUserCallCount() int {
+	fake.anonymizeUserMutex.RLock()
+	defer fake.anonymizeUserMutex.RUnlock()
+	return len(fake.anonymizeUserArgsForCall)
+}
+
+func (fake *UserRepositoryMock) AnonymizeUserCalls(stub func(context.Context, int, time.Time) error) {
+	fake.anonymizeUserMutex.Lock()
+	defer fake.anonymizeUserMutex.Unlock()
+	fake.AnonymizeUserStub = stub
+}
+
+func (fake *UserRepositoryMock) AnonymizeUserArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.anonymizeUserMutex.RLock()
+	defer fake.anonymizeUserMutex.RUnlock()
+	argsForCall := fake.anonymizeUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *UserRepositoryMock) AnonymizeUserReturns(result1 error) {
+	fake.anonymizeUserMutex.Lock()
+	defer fake.anonymizeUserMutex.Unlock()
+	fake.AnonymizeUserStub = nil
+	fake.anonymizeUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) AnonymizeUserReturnsOnCall(i int, result1 error) {
+	fake.anonymizeUserMutex.Lock()
+	defer fake.anonymizeUserMutex.Unlock()
+	fake.AnonymizeUserStub = nil
+	if fake.anonymizeUserReturnsOnCall == nil {
+		fake.anonymizeUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.anonymizeUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) CreateAdmin(arg1 context.Context, arg2 *domain.UserRepositoryPayloadCreateAdmin) error {
 	fake.createAdminMutex.Lock()
 	ret, specificReturn := fake.createAdminReturnsOnCall[len(fake.createAdminArgsForCall)]
 	fake.createAdminArgsForCall = append(fake.createAdminArgsForCall, struct {
-		arg1 *domain.UserRepositoryPayloadCreateAdmin
-	}{arg1})
+		arg1 context.Context
+		arg2 *domain.UserRepositoryPayloadCreateAdmin
+	}{arg1, arg2})
 	stub := fake.CreateAdminStub
 	fakeReturns := fake.createAdminReturns
-	fake.recordInvocation("CreateAdmin", []interface{}{arg1})
+	fake.recordInvocation("CreateAdmin", []interface{}{arg1, arg2})
 	fake.createAdminMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -113,17 +316,17 @@ func (fake *UserRepositoryMock) CreateAdminCallCount() int {
 	return len(fake.createAdminArgsForCall)
 }
 
-func (fake *UserRepositoryMock) CreateAdminCalls(stub func(*domain.UserRepositoryPayloadCreateAdmin) error) {
+func (fake *UserRepositoryMock) CreateAdminCalls(stub func(context.Context, *domain.UserRepositoryPayloadCreateAdmin) error) {
 	fake.createAdminMutex.Lock()
 	defer fake.createAdminMutex.Unlock()
 	fake.CreateAdminStub = stub
 }
 
-func (fake *UserRepositoryMock) CreateAdminArgsForCall(i int) *domain.UserRepositoryPayloadCreateAdmin {
+func (fake *UserRepositoryMock) CreateAdminArgsForCall(i int) (context.Context, *domain.UserRepositoryPayloadCreateAdmin) {
 	fake.createAdminMutex.RLock()
 	defer fake.createAdminMutex.RUnlock()
 	argsForCall := fake.createAdminArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *UserRepositoryMock) CreateAdminReturns(result1 error) {
@@ -149,18 +352,19 @@ func (fake *UserRepositoryMock) CreateAdminReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *UserRepositoryMock) CreateUser(arg1 *domain.UserRepositoryPayloadCreateUser) (int, error) {
+func (fake *UserRepositoryMock) CreateUser(arg1 context.Context, arg2 *domain.UserRepositoryPayloadCreateUser) (int, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
 	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
-		arg1 *domain.UserRepositoryPayloadCreateUser
-	}{arg1})
+		arg1 context.Context
+		arg2 *domain.UserRepositoryPayloadCreateUser
+	}{arg1, arg2})
 	stub := fake.CreateUserStub
 	fakeReturns := fake.createUserReturns
-	fake.recordInvocation("CreateUser", []interface{}{arg1})
+	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2})
 	fake.createUserMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -174,17 +378,17 @@ func (fake *UserRepositoryMock) CreateUserCallCount() int {
 	return len(fake.createUserArgsForCall)
 }
 
-func (fake *UserRepositoryMock) CreateUserCalls(stub func(*domain.UserRepositoryPayloadCreateUser) (int, error)) {
+func (fake *UserRepositoryMock) CreateUserCalls(stub func(context.Context, *domain.UserRepositoryPayloadCreateUser) (int, error)) {
 	fake.createUserMutex.Lock()
 	defer fake.createUserMutex.Unlock()
 	fake.CreateUserStub = stub
 }
 
-func (fake *UserRepositoryMock) CreateUserArgsForCall(i int) *domain.UserRepositoryPayloadCreateUser {
+func (fake *UserRepositoryMock) CreateUserArgsForCall(i int) (context.Context, *domain.UserRepositoryPayloadCreateUser) {
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	argsForCall := fake.createUserArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *UserRepositoryMock) CreateUserReturns(result1 int, result2 error) {
@@ -213,18 +417,208 @@ func (fake *UserRepositoryMock) CreateUserReturnsOnCall(i int, result1 int, resu
 	}{result1, result2}
 }
 
-func (fake *UserRepositoryMock) GetAdminByUserID(arg1 int) (*domain.AdminModel, error) {
+func (fake *UserRepositoryMock) DeleteAdminByUID(arg1 context.Context, arg2 string) error {
+	fake.deleteAdminByUIDMutex.Lock()
+	ret, specificReturn := fake.deleteAdminByUIDReturnsOnCall[len(fake.deleteAdminByUIDArgsForCall)]
+	fake.deleteAdminByUIDArgsForCall = append(fake.deleteAdminByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAdminByUIDStub
+	fakeReturns := fake.deleteAdminByUIDReturns
+	fake.recordInvocation("DeleteAdminByUID", []interface{}{arg1, arg2})
+	fake.deleteAdminByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *UserRepositoryMock) DeleteAdminByUIDCallCount() int {
+	fake.deleteAdminByUIDMutex.RLock()
+	defer fake.deleteAdminByUIDMutex.RUnlock()
+	return len(fake.deleteAdminByUIDArgsForCall)
+}
+
+func (fake *UserRepositoryMock) DeleteAdminByUIDCalls(stub func(context.Context, string) error) {
+	fake.deleteAdminByUIDMutex.Lock()
+	defer fake.deleteAdminByUIDMutex.Unlock()
+	fake.DeleteAdminByUIDStub = stub
+}
+
+func (fake *UserRepositoryMock) DeleteAdminByUIDArgsForCall(i int) (context.Context, string) {
+	fake.deleteAdminByUIDMutex.RLock()
+	defer fake.deleteAdminByUIDMutex.RUnlock()
+	argsForCall := fake.deleteAdminByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *UserRepositoryMock) DeleteAdminByUIDReturns(result1 error) {
+	fake.deleteAdminByUIDMutex.Lock()
+	defer fake.deleteAdminByUIDMutex.Unlock()
+	fake.DeleteAdminByUIDStub = nil
+	fake.deleteAdminByUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) DeleteAdminByUIDReturnsOnCall(i int, result1 error) {
+	fake.deleteAdminByUIDMutex.Lock()
+	defer fake.deleteAdminByUIDMutex.Unlock()
+	fake.DeleteAdminByUIDStub = nil
+	if fake.deleteAdminByUIDReturnsOnCall == nil {
+		fake.deleteAdminByUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAdminByUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) DeleteAuthUserDeletion(arg1 context.Context, arg2 string) error {
+	fake.deleteAuthUserDeletionMutex.Lock()
+	ret, specificReturn := fake.deleteAuthUserDeletionReturnsOnCall[len(fake.deleteAuthUserDeletionArgsForCall)]
+	fake.deleteAuthUserDeletionArgsForCall = append(fake.deleteAuthUserDeletionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAuthUserDeletionStub
+	fakeReturns := fake.deleteAuthUserDeletionReturns
+	fake.recordInvocation("DeleteAuthUserDeletion", []interface{}{arg1, arg2})
+	fake.deleteAuthUserDeletionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *UserRepositoryMock) DeleteAuthUserDeletionCallCount() int {
+	fake.deleteAuthUserDeletionMutex.RLock()
+	defer fake.deleteAuthUserDeletionMutex.RUnlock()
+	return len(fake.deleteAuthUserDeletionArgsForCall)
+}
+
+func (fake *UserRepositoryMock) DeleteAuthUserDeletionCalls(stub func(context.Context, string) error) {
+	fake.deleteAuthUserDeletionMutex.Lock()
+	defer fake.deleteAuthUserDeletionMutex.Unlock()
+	fake.DeleteAuthUserDeletionStub = stub
+}
+
+func (fake *UserRepositoryMock) DeleteAuthUserDeletionArgsForCall(i int) (context.Context, string) {
+	fake.deleteAuthUserDeletionMutex.RLock()
+	defer fake.deleteAuthUserDeletionMutex.RUnlock()
+	argsForCall := fake.deleteAuthUserDeletionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *UserRepositoryMock) DeleteAuthUserDeletionReturns(result1 error) {
+	fake.deleteAuthUserDeletionMutex.Lock()
+	defer fake.deleteAuthUserDeletionMutex.Unlock()
+	fake.DeleteAuthUserDeletionStub = nil
+	fake.deleteAuthUserDeletionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) DeleteAuthUserDeletionReturnsOnCall(i int, result1 error) {
+	fake.deleteAuthUserDeletionMutex.Lock()
+	defer fake.deleteAuthUserDeletionMutex.Unlock()
+	fake.DeleteAuthUserDeletionStub = nil
+	if fake.deleteAuthUserDeletionReturnsOnCall == nil {
+		fake.deleteAuthUserDeletionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAuthUserDeletionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) GetAdminByUID(arg1 context.Context, arg2 string) (*domain.AdminModel, error) {
+	fake.getAdminByUIDMutex.Lock()
+	ret, specificReturn := fake.getAdminByUIDReturnsOnCall[len(fake.getAdminByUIDArgsForCall)]
+	fake.getAdminByUIDArgsForCall = append(fake.getAdminByUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAdminByUIDStub
+	fakeReturns := fake.getAdminByUIDReturns
+	fake.recordInvocation("GetAdminByUID", []interface{}{arg1, arg2})
+	fake.getAdminByUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserRepositoryMock) GetAdminByUIDCallCount() int {
+	fake.getAdminByUIDMutex.RLock()
+	defer fake.getAdminByUIDMutex.RUnlock()
+	return len(fake.getAdminByUIDArgsForCall)
+}
+
+func (fake *UserRepositoryMock) GetAdminByUIDCalls(stub func(context.Context, string) (*domain.AdminModel, error)) {
+	fake.getAdminByUIDMutex.Lock()
+	defer fake.getAdminByUIDMutex.Unlock()
+	fake.GetAdminByUIDStub = stub
+}
+
+func (fake *UserRepositoryMock) GetAdminByUIDArgsForCall(i int) (context.Context, string) {
+	fake.getAdminByUIDMutex.RLock()
+	defer fake.getAdminByUIDMutex.RUnlock()
+	argsForCall := fake.getAdminByUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *UserRepositoryMock) GetAdminByUIDReturns(result1 *domain.AdminModel, result2 error) {
+	fake.getAdminByUIDMutex.Lock()
+	defer fake.getAdminByUIDMutex.Unlock()
+	fake.GetAdminByUIDStub = nil
+	fake.getAdminByUIDReturns = struct {
+		result1 *domain.AdminModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) GetAdminByUIDReturnsOnCall(i int, result1 *domain.AdminModel, result2 error) {
+	fake.getAdminByUIDMutex.Lock()
+	defer fake.getAdminByUIDMutex.Unlock()
+	fake.GetAdminByUIDStub = nil
+	if fake.getAdminByUIDReturnsOnCall == nil {
+		fake.getAdminByUIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.AdminModel
+			result2 error
+		})
+	}
+	fake.getAdminByUIDReturnsOnCall[i] = struct {
+		result1 *domain.AdminModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) GetAdminByUserID(arg1 context.Context, arg2 int) (*domain.AdminModel, error) {
 	fake.getAdminByUserIDMutex.Lock()
 	ret, specificReturn := fake.getAdminByUserIDReturnsOnCall[len(fake.getAdminByUserIDArgsForCall)]
 	fake.getAdminByUserIDArgsForCall = append(fake.getAdminByUserIDArgsForCall, struct {
-		arg1 int
-	}{arg1})
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
 	stub := fake.GetAdminByUserIDStub
 	fakeReturns := fake.getAdminByUserIDReturns
-	fake.recordInvocation("GetAdminByUserID", []interface{}{arg1})
+	fake.recordInvocation("GetAdminByUserID", []interface{}{arg1, arg2})
 	fake.getAdminByUserIDMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -238,17 +632,17 @@ func (fake *UserRepositoryMock) GetAdminByUserIDCallCount() int {
 	return len(fake.getAdminByUserIDArgsForCall)
 }
 
-func (fake *UserRepositoryMock) GetAdminByUserIDCalls(stub func(int) (*domain.AdminModel, error)) {
+func (fake *UserRepositoryMock) GetAdminByUserIDCalls(stub func(context.Context, int) (*domain.AdminModel, error)) {
 	fake.getAdminByUserIDMutex.Lock()
 	defer fake.getAdminByUserIDMutex.Unlock()
 	fake.GetAdminByUserIDStub = stub
 }
 
-func (fake *UserRepositoryMock) GetAdminByUserIDArgsForCall(i int) int {
+func (fake *UserRepositoryMock) GetAdminByUserIDArgsForCall(i int) (context.Context, int) {
 	fake.getAdminByUserIDMutex.RLock()
 	defer fake.getAdminByUserIDMutex.RUnlock()
 	argsForCall := fake.getAdminByUserIDArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *UserRepositoryMock) GetAdminByUserIDReturns(result1 *domain.AdminModel, result2 error) {
@@ -277,18 +671,19 @@ func (fake *UserRepositoryMock) GetAdminByUserIDReturnsOnCall(i int, result1 *do
 	}{result1, result2}
 }
 
-func (fake *UserRepositoryMock) GetUserByEmail(arg1 string) (*domain.UserModel, error) {
+func (fake *UserRepositoryMock) GetUserByEmail(arg1 context.Context, arg2 string) (*domain.UserModel, error) {
 	fake.getUserByEmailMutex.Lock()
 	ret, specificReturn := fake.getUserByEmailReturnsOnCall[len(fake.getUserByEmailArgsForCall)]
 	fake.getUserByEmailArgsForCall = append(fake.getUserByEmailArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetUserByEmailStub
 	fakeReturns := fake.getUserByEmailReturns
-	fake.recordInvocation("GetUserByEmail", []interface{}{arg1})
+	fake.recordInvocation("GetUserByEmail", []interface{}{arg1, arg2})
 	fake.getUserByEmailMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -302,17 +697,17 @@ func (fake *UserRepositoryMock) GetUserByEmailCallCount() int {
 	return len(fake.getUserByEmailArgsForCall)
 }
 
-func (fake *UserRepositoryMock) GetUserByEmailCalls(stub func(string) (*domain.UserModel, error)) {
+func (fake *UserRepositoryMock) GetUserByEmailCalls(stub func(context.Context, string) (*domain.UserModel, error)) {
 	fake.getUserByEmailMutex.Lock()
 	defer fake.getUserByEmailMutex.Unlock()
 	fake.GetUserByEmailStub = stub
 }
 
-func (fake *UserRepositoryMock) GetUserByEmailArgsForCall(i int) string {
+func (fake *UserRepositoryMock) GetUserByEmailArgsForCall(i int) (context.Context, string) {
 	fake.getUserByEmailMutex.RLock()
 	defer fake.getUserByEmailMutex.RUnlock()
 	argsForCall := fake.getUserByEmailArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *UserRepositoryMock) GetUserByEmailReturns(result1 *domain.UserModel, result2 error) {
@@ -341,18 +736,19 @@ func (fake *UserRepositoryMock) GetUserByEmailReturnsOnCall(i int, result1 *doma
 	}{result1, result2}
 }
 
-func (fake *UserRepositoryMock) GetUserByFirebaseUID(arg1 string) (*domain.UserModel, error) {
+func (fake *UserRepositoryMock) GetUserByFirebaseUID(arg1 context.Context, arg2 string) (*domain.UserModel, error) {
 	fake.getUserByFirebaseUIDMutex.Lock()
 	ret, specificReturn := fake.getUserByFirebaseUIDReturnsOnCall[len(fake.getUserByFirebaseUIDArgsForCall)]
 	fake.getUserByFirebaseUIDArgsForCall = append(fake.getUserByFirebaseUIDArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetUserByFirebaseUIDStub
 	fakeReturns := fake.getUserByFirebaseUIDReturns
-	fake.recordInvocation("GetUserByFirebaseUID", []interface{}{arg1})
+	fake.recordInvocation("GetUserByFirebaseUID", []interface{}{arg1, arg2})
 	fake.getUserByFirebaseUIDMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -366,17 +762,17 @@ func (fake *UserRepositoryMock) GetUserByFirebaseUIDCallCount() int {
 	return len(fake.getUserByFirebaseUIDArgsForCall)
 }
 
-func (fake *UserRepositoryMock) GetUserByFirebaseUIDCalls(stub func(string) (*domain.UserModel, error)) {
+func (fake *UserRepositoryMock) GetUserByFirebaseUIDCalls(stub func(context.Context, string) (*domain.UserModel, error)) {
 	fake.getUserByFirebaseUIDMutex.Lock()
 	defer fake.getUserByFirebaseUIDMutex.Unlock()
 	fake.GetUserByFirebaseUIDStub = stub
 }
 
-func (fake *UserRepositoryMock) GetUserByFirebaseUIDArgsForCall(i int) string {
+func (fake *UserRepositoryMock) GetUserByFirebaseUIDArgsForCall(i int) (context.Context, string) {
 	fake.getUserByFirebaseUIDMutex.RLock()
 	defer fake.getUserByFirebaseUIDMutex.RUnlock()
 	argsForCall := fake.getUserByFirebaseUIDArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *UserRepositoryMock) GetUserByFirebaseUIDReturns(result1 *domain.UserModel, result2 error) {
@@ -405,18 +801,84 @@ func (fake *UserRepositoryMock) GetUserByFirebaseUIDReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *UserRepositoryMock) GetUserByUID(arg1 string) (*domain.UserModel, error) {
+func (fake *UserRepositoryMock) GetUserByID(arg1 context.Context, arg2 int) (*domain.UserModel, error) {
+	fake.getUserByIDMutex.Lock()
+	ret, specificReturn := fake.getUserByIDReturnsOnCall[len(fake.getUserByIDArgsForCall)]
+	fake.getUserByIDArgsForCall = append(fake.getUserByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetUserByIDStub
+	fakeReturns := fake.getUserByIDReturns
+	fake.recordInvocation("GetUserByID", []interface{}{arg1, arg2})
+	fake.getUserByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserRepositoryMock) GetUserByIDCallCount() int {
+	fake.getUserByIDMutex.RLock()
+	defer fake.getUserByIDMutex.RUnlock()
+	return len(fake.getUserByIDArgsForCall)
+}
+
+func (fake *UserRepositoryMock) GetUserByIDCalls(stub func(context.Context, int) (*domain.UserModel, error)) {
+	fake.getUserByIDMutex.Lock()
+	defer fake.getUserByIDMutex.Unlock()
+	fake.GetUserByIDStub = stub
+}
+
+func (fake *UserRepositoryMock) GetUserByIDArgsForCall(i int) (context.Context, int) {
+	fake.getUserByIDMutex.RLock()
+	defer fake.getUserByIDMutex.RUnlock()
+	argsForCall := fake.getUserByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *UserRepositoryMock) GetUserByIDReturns(result1 *domain.UserModel, result2 error) {
+	fake.getUserByIDMutex.Lock()
+	defer fake.getUserByIDMutex.Unlock()
+	fake.GetUserByIDStub = nil
+	fake.getUserByIDReturns = struct {
+		result1 *domain.UserModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) GetUserByIDReturnsOnCall(i int, result1 *domain.UserModel, result2 error) {
+	fake.getUserByIDMutex.Lock()
+	defer fake.getUserByIDMutex.Unlock()
+	fake.GetUserByIDStub = nil
+	if fake.getUserByIDReturnsOnCall == nil {
+		fake.getUserByIDReturnsOnCall = make(map[int]struct {
+			result1 *domain.UserModel
+			result2 error
+		})
+	}
+	fake.getUserByIDReturnsOnCall[i] = struct {
+		result1 *domain.UserModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) GetUserByUID(arg1 context.Context, arg2 string) (*domain.UserModel, error) {
 	fake.getUserByUIDMutex.Lock()
 	ret, specificReturn := fake.getUserByUIDReturnsOnCall[len(fake.getUserByUIDArgsForCall)]
 	fake.getUserByUIDArgsForCall = append(fake.getUserByUIDArgsForCall, struct {
-		arg1 string
-	}{arg1})
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.GetUserByUIDStub
 	fakeReturns := fake.getUserByUIDReturns
-	fake.recordInvocation("GetUserByUID", []interface{}{arg1})
+	fake.recordInvocation("GetUserByUID", []interface{}{arg1, arg2})
 	fake.getUserByUIDMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -430,17 +892,17 @@ func (fake *UserRepositoryMock) GetUserByUIDCallCount() int {
 	return len(fake.getUserByUIDArgsForCall)
 }
 
-func (fake *UserRepositoryMock) GetUserByUIDCalls(stub func(string) (*domain.UserModel, error)) {
+func (fake *UserRepositoryMock) GetUserByUIDCalls(stub func(context.Context, string) (*domain.UserModel, error)) {
 	fake.getUserByUIDMutex.Lock()
 	defer fake.getUserByUIDMutex.Unlock()
 	fake.GetUserByUIDStub = stub
 }
 
-func (fake *UserRepositoryMock) GetUserByUIDArgsForCall(i int) string {
+func (fake *UserRepositoryMock) GetUserByUIDArgsForCall(i int) (context.Context, string) {
 	fake.getUserByUIDMutex.RLock()
 	defer fake.getUserByUIDMutex.RUnlock()
 	argsForCall := fake.getUserByUIDArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *UserRepositoryMock) GetUserByUIDReturns(result1 *domain.UserModel, result2 error) {
@@ -469,21 +931,360 @@ func (fake *UserRepositoryMock) GetUserByUIDReturnsOnCall(i int, result1 *domain
 	}{result1, result2}
 }
 
+func (fake *UserRepositoryMock) ListAdmins(arg1 context.Context) ([]*domain.AdminModel, error) {
+	fake.listAdminsMutex.Lock()
+	ret, specificReturn := fake.listAdminsReturnsOnCall[len(fake.listAdminsArgsForCall)]
+	fake.listAdminsArgsForCall = append(fake.listAdminsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListAdminsStub
+	fakeReturns := fake.listAdminsReturns
+	fake.recordInvocation("ListAdmins", []interface{}{arg1})
+	fake.listAdminsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserRepositoryMock) ListAdminsCallCount() int {
+	fake.listAdminsMutex.RLock()
+	defer fake.listAdminsMutex.RUnlock()
+	return len(fake.listAdminsArgsForCall)
+}
+
+func (fake *UserRepositoryMock) ListAdminsCalls(stub func(context.Context) ([]*domain.AdminModel, error)) {
+	fake.listAdminsMutex.Lock()
+	defer fake.listAdminsMutex.Unlock()
+	fake.ListAdminsStub = stub
+}
+
+func (fake *UserRepositoryMock) ListAdminsArgsForCall(i int) context.Context {
+	fake.listAdminsMutex.RLock()
+	defer fake.listAdminsMutex.RUnlock()
+	argsForCall := fake.listAdminsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *UserRepositoryMock) ListAdminsReturns(result1 []*domain.AdminModel, result2 error) {
+	fake.listAdminsMutex.Lock()
+	defer fake.listAdminsMutex.Unlock()
+	fake.ListAdminsStub = nil
+	fake.listAdminsReturns = struct {
+		result1 []*domain.AdminModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) ListAdminsReturnsOnCall(i int, result1 []*domain.AdminModel, result2 error) {
+	fake.listAdminsMutex.Lock()
+	defer fake.listAdminsMutex.Unlock()
+	fake.ListAdminsStub = nil
+	if fake.listAdminsReturnsOnCall == nil {
+		fake.listAdminsReturnsOnCall = make(map[int]struct {
+			result1 []*domain.AdminModel
+			result2 error
+		})
+	}
+	fake.listAdminsReturnsOnCall[i] = struct {
+		result1 []*domain.AdminModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) ListAuthUserDeletions(arg1 context.Context) ([]string, error) {
+	fake.listAuthUserDeletionsMutex.Lock()
+	ret, specificReturn := fake.listAuthUserDeletionsReturnsOnCall[len(fake.listAuthUserDeletionsArgsForCall)]
+	fake.listAuthUserDeletionsArgsForCall = append(fake.listAuthUserDeletionsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListAuthUserDeletionsStub
+	fakeReturns := fake.listAuthUserDeletionsReturns
+	fake.recordInvocation("ListAuthUserDeletions", []interface{}{arg1})
+	fake.listAuthUserDeletionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserRepositoryMock) ListAuthUserDeletionsCallCount() int {
+	fake.listAuthUserDeletionsMutex.RLock()
+	defer fake.listAuthUserDeletionsMutex.RUnlock()
+	return len(fake.listAuthUserDeletionsArgsForCall)
+}
+
+func (fake *UserRepositoryMock) ListAuthUserDeletionsCalls(stub func(context.Context) ([]string, error)) {
+	fake.listAuthUserDeletionsMutex.Lock()
+	defer fake.listAuthUserDeletionsMutex.Unlock()
+	fake.ListAuthUserDeletionsStub = stub
+}
+
+func (fake *UserRepositoryMock) ListAuthUserDeletionsArgsForCall(i int) context.Context {
+	fake.listAuthUserDeletionsMutex.RLock()
+	defer fake.listAuthUserDeletionsMutex.RUnlock()
+	argsForCall := fake.listAuthUserDeletionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *UserRepositoryMock) ListAuthUserDeletionsReturns(result1 []string, result2 error) {
+	fake.listAuthUserDeletionsMutex.Lock()
+	defer fake.listAuthUserDeletionsMutex.Unlock()
+	fake.ListAuthUserDeletionsStub = nil
+	fake.listAuthUserDeletionsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) ListAuthUserDeletionsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listAuthUserDeletionsMutex.Lock()
+	defer fake.listAuthUserDeletionsMutex.Unlock()
+	fake.ListAuthUserDeletionsStub = nil
+	if fake.listAuthUserDeletionsReturnsOnCall == nil {
+		fake.listAuthUserDeletionsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listAuthUserDeletionsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) MarkEmailVerified(arg1 context.Context, arg2 string, arg3 time.Time) error {
+	fake.markEmailVerifiedMutex.Lock()
+	ret, specificReturn := fake.markEmailVerifiedReturnsOnCall[len(fake.markEmailVerifiedArgsForCall)]
+	fake.markEmailVerifiedArgsForCall = append(fake.markEmailVerifiedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.MarkEmailVerifiedStub
+	fakeReturns := fake.markEmailVerifiedReturns
+	fake.recordInvocation("MarkEmailVerified", []interface{}{arg1, arg2, arg3})
+	fake.markEmailVerifiedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *UserRepositoryMock) MarkEmailVerifiedCallCount() int {
+	fake.markEmailVerifiedMutex.RLock()
+	defer fake.markEmailVerifiedMutex.RUnlock()
+	return len(fake.markEmailVerifiedArgsForCall)
+}
+
+func (fake *UserRepositoryMock) MarkEmailVerifiedCalls(stub func(context.Context, string, time.Time) error) {
+	fake.markEmailVerifiedMutex.Lock()
+	defer fake.markEmailVerifiedMutex.Unlock()
+	fake.MarkEmailVerifiedStub = stub
+}
+
+func (fake *UserRepositoryMock) MarkEmailVerifiedArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.markEmailVerifiedMutex.RLock()
+	defer fake.markEmailVerifiedMutex.RUnlock()
+	argsForCall := fake.markEmailVerifiedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *UserRepositoryMock) MarkEmailVerifiedReturns(result1 error) {
+	fake.markEmailVerifiedMutex.Lock()
+	defer fake.markEmailVerifiedMutex.Unlock()
+	fake.MarkEmailVerifiedStub = nil
+	fake.markEmailVerifiedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) MarkEmailVerifiedReturnsOnCall(i int, result1 error) {
+	fake.markEmailVerifiedMutex.Lock()
+	defer fake.markEmailVerifiedMutex.Unlock()
+	fake.MarkEmailVerifiedStub = nil
+	if fake.markEmailVerifiedReturnsOnCall == nil {
+		fake.markEmailVerifiedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markEmailVerifiedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) RevokeTokens(arg1 context.Context, arg2 int, arg3 time.Time) error {
+	fake.revokeTokensMutex.Lock()
+	ret, specificReturn := fake.revokeTokensReturnsOnCall[len(fake.revokeTokensArgsForCall)]
+	fake.revokeTokensArgsForCall = append(fake.revokeTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeTokensStub
+	fakeReturns := fake.revokeTokensReturns
+	fake.recordInvocation("RevokeTokens", []interface{}{arg1, arg2, arg3})
+	fake.revokeTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *UserRepositoryMock) RevokeTokensCallCount() int {
+	fake.revokeTokensMutex.RLock()
+	defer fake.revokeTokensMutex.RUnlock()
+	return len(fake.revokeTokensArgsForCall)
+}
+
+func (fake *UserRepositoryMock) RevokeTokensCalls(stub func(context.Context, int, time.Time) error) {
+	fake.revokeTokensMutex.Lock()
+	defer fake.revokeTokensMutex.Unlock()
+	fake.RevokeTokensStub = stub
+}
+
+func (fake *UserRepositoryMock) RevokeTokensArgsForCall(i int) (context.Context, int, time.Time) {
+	fake.revokeTokensMutex.RLock()
+	defer fake.revokeTokensMutex.RUnlock()
+	argsForCall := fake.revokeTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *UserRepositoryMock) RevokeTokensReturns(result1 error) {
+	fake.revokeTokensMutex.Lock()
+	defer fake.revokeTokensMutex.Unlock()
+	fake.RevokeTokensStub = nil
+	fake.revokeTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) RevokeTokensReturnsOnCall(i int, result1 error) {
+	fake.revokeTokensMutex.Lock()
+	defer fake.revokeTokensMutex.Unlock()
+	fake.RevokeTokensStub = nil
+	if fake.revokeTokensReturnsOnCall == nil {
+		fake.revokeTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *UserRepositoryMock) UpdateProfile(arg1 context.Context, arg2 *domain.UserRepositoryPayloadUpdateProfile) (*domain.UserModel, error) {
+	fake.updateProfileMutex.Lock()
+	ret, specificReturn := fake.updateProfileReturnsOnCall[len(fake.updateProfileArgsForCall)]
+	fake.updateProfileArgsForCall = append(fake.updateProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserRepositoryPayloadUpdateProfile
+	}{arg1, arg2})
+	stub := fake.UpdateProfileStub
+	fakeReturns := fake.updateProfileReturns
+	fake.recordInvocation("UpdateProfile", []interface{}{arg1, arg2})
+	fake.updateProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserRepositoryMock) UpdateProfileCallCount() int {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	return len(fake.updateProfileArgsForCall)
+}
+
+func (fake *UserRepositoryMock) UpdateProfileCalls(stub func(context.Context, *domain.UserRepositoryPayloadUpdateProfile) (*domain.UserModel, error)) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = stub
+}
+
+func (fake *UserRepositoryMock) UpdateProfileArgsForCall(i int) (context.Context, *domain.UserRepositoryPayloadUpdateProfile) {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	argsForCall := fake.updateProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *UserRepositoryMock) UpdateProfileReturns(result1 *domain.UserModel, result2 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	fake.updateProfileReturns = struct {
+		result1 *domain.UserModel
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserRepositoryMock) UpdateProfileReturnsOnCall(i int, result1 *domain.UserModel, result2 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	if fake.updateProfileReturnsOnCall == nil {
+		fake.updateProfileReturnsOnCall = make(map[int]struct {
+			result1 *domain.UserModel
+			result2 error
+		})
+	}
+	fake.updateProfileReturnsOnCall[i] = struct {
+		result1 *domain.UserModel
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *UserRepositoryMock) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.anonymizeUserMutex.RLock()
+	defer fake.anonymizeUserMutex.RUnlock()
 	fake.createAdminMutex.RLock()
 	defer fake.createAdminMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteAdminByUIDMutex.RLock()
+	defer fake.deleteAdminByUIDMutex.RUnlock()
+	fake.deleteAuthUserDeletionMutex.RLock()
+	defer fake.deleteAuthUserDeletionMutex.RUnlock()
+	fake.getAdminByUIDMutex.RLock()
+	defer fake.getAdminByUIDMutex.RUnlock()
 	fake.getAdminByUserIDMutex.RLock()
 	defer fake.getAdminByUserIDMutex.RUnlock()
 	fake.getUserByEmailMutex.RLock()
 	defer fake.getUserByEmailMutex.RUnlock()
 	fake.getUserByFirebaseUIDMutex.RLock()
 	defer fake.getUserByFirebaseUIDMutex.RUnlock()
+	fake.getUserByIDMutex.RLock()
+	defer fake.getUserByIDMutex.RUnlock()
 	fake.getUserByUIDMutex.RLock()
 	defer fake.getUserByUIDMutex.RUnlock()
+	fake.listAdminsMutex.RLock()
+	defer fake.listAdminsMutex.RUnlock()
+	fake.listAuthUserDeletionsMutex.RLock()
+	defer fake.listAuthUserDeletionsMutex.RUnlock()
+	fake.markEmailVerifiedMutex.RLock()
+	defer fake.markEmailVerifiedMutex.RUnlock()
+	fake.revokeTokensMutex.RLock()
+	defer fake.revokeTokensMutex.RUnlock()
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
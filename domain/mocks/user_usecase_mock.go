@@ -3,6 +3,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
@@ -23,6 +24,18 @@ type UserUsecaseMock struct {
 		result1 *domain.AdminModel
 		result2 error
 	}
+	GetProfileStub        func(context.Context, *domain.UserModel) *domain.UserControllerResponseProfile
+	getProfileMutex       sync.RWMutex
+	getProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+	}
+	getProfileReturns struct {
+		result1 *domain.UserControllerResponseProfile
+	}
+	getProfileReturnsOnCall map[int]struct {
+		result1 *domain.UserControllerResponseProfile
+	}
 	GetUserByFirebaseUIDStub        func(context.Context, string) (*domain.UserModel, error)
 	getUserByFirebaseUIDMutex       sync.RWMutex
 	getUserByFirebaseUIDArgsForCall []struct {
@@ -51,6 +64,36 @@ type UserUsecaseMock struct {
 		result1 *domain.UserModel
 		result2 error
 	}
+	UpdateProfileStub        func(context.Context, *domain.UserModel, *domain.UserUsecasePayloadUpdateProfile) (*domain.UserControllerResponseProfile, error)
+	updateProfileMutex       sync.RWMutex
+	updateProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 *domain.UserUsecasePayloadUpdateProfile
+	}
+	updateProfileReturns struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}
+	updateProfileReturnsOnCall map[int]struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}
+	UploadProfileImageStub        func(context.Context, *domain.UserModel, io.Reader) (*domain.UserControllerResponseProfile, error)
+	uploadProfileImageMutex       sync.RWMutex
+	uploadProfileImageArgsForCall []struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 io.Reader
+	}
+	uploadProfileImageReturns struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}
+	uploadProfileImageReturnsOnCall map[int]struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -120,6 +163,68 @@ func (fake *UserUsecaseMock) GetAdminByUserIDReturnsOnCall(i int, result1 *domai
 	}{result1, result2}
 }
 
+func (fake *UserUsecaseMock) GetProfile(arg1 context.Context, arg2 *domain.UserModel) *domain.UserControllerResponseProfile {
+	fake.getProfileMutex.Lock()
+	ret, specificReturn := fake.getProfileReturnsOnCall[len(fake.getProfileArgsForCall)]
+	fake.getProfileArgsForCall = append(fake.getProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+	}{arg1, arg2})
+	stub := fake.GetProfileStub
+	fakeReturns := fake.getProfileReturns
+	fake.recordInvocation("GetProfile", []interface{}{arg1, arg2})
+	fake.getProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *UserUsecaseMock) GetProfileCallCount() int {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	return len(fake.getProfileArgsForCall)
+}
+
+func (fake *UserUsecaseMock) GetProfileCalls(stub func(context.Context, *domain.UserModel) *domain.UserControllerResponseProfile) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = stub
+}
+
+func (fake *UserUsecaseMock) GetProfileArgsForCall(i int) (context.Context, *domain.UserModel) {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	argsForCall := fake.getProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *UserUsecaseMock) GetProfileReturns(result1 *domain.UserControllerResponseProfile) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	fake.getProfileReturns = struct {
+		result1 *domain.UserControllerResponseProfile
+	}{result1}
+}
+
+func (fake *UserUsecaseMock) GetProfileReturnsOnCall(i int, result1 *domain.UserControllerResponseProfile) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	if fake.getProfileReturnsOnCall == nil {
+		fake.getProfileReturnsOnCall = make(map[int]struct {
+			result1 *domain.UserControllerResponseProfile
+		})
+	}
+	fake.getProfileReturnsOnCall[i] = struct {
+		result1 *domain.UserControllerResponseProfile
+	}{result1}
+}
+
 func (fake *UserUsecaseMock) GetUserByFirebaseUID(arg1 context.Context, arg2 string) (*domain.UserModel, error) {
 	fake.getUserByFirebaseUIDMutex.Lock()
 	ret, specificReturn := fake.getUserByFirebaseUIDReturnsOnCall[len(fake.getUserByFirebaseUIDArgsForCall)]
@@ -250,15 +355,153 @@ func (fake *UserUsecaseMock) GetUserByUIDReturnsOnCall(i int, result1 *domain.Us
 	}{result1, result2}
 }
 
+func (fake *UserUsecaseMock) UpdateProfile(arg1 context.Context, arg2 *domain.UserModel, arg3 *domain.UserUsecasePayloadUpdateProfile) (*domain.UserControllerResponseProfile, error) {
+	fake.updateProfileMutex.Lock()
+	ret, specificReturn := fake.updateProfileReturnsOnCall[len(fake.updateProfileArgsForCall)]
+	fake.updateProfileArgsForCall = append(fake.updateProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 *domain.UserUsecasePayloadUpdateProfile
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateProfileStub
+	fakeReturns := fake.updateProfileReturns
+	fake.recordInvocation("UpdateProfile", []interface{}{arg1, arg2, arg3})
+	fake.updateProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserUsecaseMock) UpdateProfileCallCount() int {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	return len(fake.updateProfileArgsForCall)
+}
+
+func (fake *UserUsecaseMock) UpdateProfileCalls(stub func(context.Context, *domain.UserModel, *domain.UserUsecasePayloadUpdateProfile) (*domain.UserControllerResponseProfile, error)) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = stub
+}
+
+func (fake *UserUsecaseMock) UpdateProfileArgsForCall(i int) (context.Context, *domain.UserModel, *domain.UserUsecasePayloadUpdateProfile) {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	argsForCall := fake.updateProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *UserUsecaseMock) UpdateProfileReturns(result1 *domain.UserControllerResponseProfile, result2 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	fake.updateProfileReturns = struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserUsecaseMock) UpdateProfileReturnsOnCall(i int, result1 *domain.UserControllerResponseProfile, result2 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	if fake.updateProfileReturnsOnCall == nil {
+		fake.updateProfileReturnsOnCall = make(map[int]struct {
+			result1 *domain.UserControllerResponseProfile
+			result2 error
+		})
+	}
+	fake.updateProfileReturnsOnCall[i] = struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserUsecaseMock) UploadProfileImage(arg1 context.Context, arg2 *domain.UserModel, arg3 io.Reader) (*domain.UserControllerResponseProfile, error) {
+	fake.uploadProfileImageMutex.Lock()
+	ret, specificReturn := fake.uploadProfileImageReturnsOnCall[len(fake.uploadProfileImageArgsForCall)]
+	fake.uploadProfileImageArgsForCall = append(fake.uploadProfileImageArgsForCall, struct {
+		arg1 context.Context
+		arg2 *domain.UserModel
+		arg3 io.Reader
+	}{arg1, arg2, arg3})
+	stub := fake.UploadProfileImageStub
+	fakeReturns := fake.uploadProfileImageReturns
+	fake.recordInvocation("UploadProfileImage", []interface{}{arg1, arg2, arg3})
+	fake.uploadProfileImageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *UserUsecaseMock) UploadProfileImageCallCount() int {
+	fake.uploadProfileImageMutex.RLock()
+	defer fake.uploadProfileImageMutex.RUnlock()
+	return len(fake.uploadProfileImageArgsForCall)
+}
+
+func (fake *UserUsecaseMock) UploadProfileImageCalls(stub func(context.Context, *domain.UserModel, io.Reader) (*domain.UserControllerResponseProfile, error)) {
+	fake.uploadProfileImageMutex.Lock()
+	defer fake.uploadProfileImageMutex.Unlock()
+	fake.UploadProfileImageStub = stub
+}
+
+func (fake *UserUsecaseMock) UploadProfileImageArgsForCall(i int) (context.Context, *domain.UserModel, io.Reader) {
+	fake.uploadProfileImageMutex.RLock()
+	defer fake.uploadProfileImageMutex.RUnlock()
+	argsForCall := fake.uploadProfileImageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *UserUsecaseMock) UploadProfileImageReturns(result1 *domain.UserControllerResponseProfile, result2 error) {
+	fake.uploadProfileImageMutex.Lock()
+	defer fake.uploadProfileImageMutex.Unlock()
+	fake.UploadProfileImageStub = nil
+	fake.uploadProfileImageReturns = struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *UserUsecaseMock) UploadProfileImageReturnsOnCall(i int, result1 *domain.UserControllerResponseProfile, result2 error) {
+	fake.uploadProfileImageMutex.Lock()
+	defer fake.uploadProfileImageMutex.Unlock()
+	fake.UploadProfileImageStub = nil
+	if fake.uploadProfileImageReturnsOnCall == nil {
+		fake.uploadProfileImageReturnsOnCall = make(map[int]struct {
+			result1 *domain.UserControllerResponseProfile
+			result2 error
+		})
+	}
+	fake.uploadProfileImageReturnsOnCall[i] = struct {
+		result1 *domain.UserControllerResponseProfile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *UserUsecaseMock) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getAdminByUserIDMutex.RLock()
 	defer fake.getAdminByUserIDMutex.RUnlock()
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
 	fake.getUserByFirebaseUIDMutex.RLock()
 	defer fake.getUserByFirebaseUIDMutex.RUnlock()
 	fake.getUserByUIDMutex.RLock()
 	defer fake.getUserByUIDMutex.RUnlock()
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	fake.uploadProfileImageMutex.RLock()
+	defer fake.uploadProfileImageMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,275 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/order_usecase_mock.go --fake-name OrderUsecaseMock . OrderUsecase
+
+const (
+	OrderStatusPendingPayment = "PENDING_PAYMENT"
+	OrderStatusPaid           = "PAID"
+	OrderStatusProcessing     = "PROCESSING"
+	OrderStatusShipped        = "SHIPPED"
+	OrderStatusDelivered      = "DELIVERED"
+	OrderStatusCancelled      = "CANCELLED"
+	OrderStatusRefunded       = "REFUNDED"
+)
+
+var (
+	ErrCartEmpty          = NewValidationError("CART_EMPTY", "cart is empty")
+	ErrProductUnavailable = NewConflictError("PRODUCT_UNAVAILABLE", "product is unavailable")
+	ErrInsufficientStock  = NewConflictError("INSUFFICIENT_STOCK", "insufficient stock")
+	ErrPriceChanged       = NewConflictError("PRICE_CHANGED", "product price has changed")
+	ErrOrderNotFound      = NewNotFoundError("ORDER_NOT_FOUND", "order not found")
+	ErrOrderStatusChanged = NewConflictError("ORDER_STATUS_CHANGED", "order status has been changed by another request")
+	// ErrIllegalOrderTransition is wrapped by every OrderTransitionError.
+	ErrIllegalOrderTransition = NewConflictError("ILLEGAL_ORDER_TRANSITION", "illegal order transition")
+)
+
+// OrderTransitionError is returned when an order can not move from its current status to the requested one.
+type OrderTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *OrderTransitionError) Error() string {
+	return fmt.Sprintf("cannot transition order from %s to %s", e.From, e.To)
+}
+
+// Unwrap makes a transition error an ErrIllegalOrderTransition, which is a Conflict error.
+func (e *OrderTransitionError) Unwrap() error {
+	return ErrIllegalOrderTransition
+}
+
+// Controller
+type OrderController interface {
+	Checkout(c echo.Context) error
+	ListOrders(c echo.Context) error
+	GetOrderByUID(c echo.Context) error
+	CancelOrder(c echo.Context) error
+
+	// Admin
+	AdminListOrders(c echo.Context) error
+	AdminGetOrderByUID(c echo.Context) error
+	AdminUpdateOrderStatus(c echo.Context) error
+}
+
+type OrderControllerPayloadUpdateOrderStatus struct {
+	Status string `json:"status" validate:"required,oneof=PENDING_PAYMENT PAID PROCESSING SHIPPED DELIVERED CANCELLED REFUNDED"`
+	Note   string `json:"note" validate:"max=500"`
+}
+
+type OrderControllerQueryListOrders struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Page   int    `query:"page" validate:"omitempty,min=1"`
+	Status string `query:"status" validate:"omitempty,oneof=PENDING_PAYMENT PAID PROCESSING SHIPPED DELIVERED CANCELLED REFUNDED"`
+}
+
+type OrderControllerResponseGetOrder struct {
+	UID              string                                         `json:"uid"`
+	Status           string                                         `json:"status"`
+	Quantity         int                                            `json:"quantity"`
+	TotalPrice       string                                         `json:"total_price"`
+	TotalPriceValue  int                                            `json:"total_price_value"`
+	TotalWeight      string                                         `json:"total_weight"`
+	TotalWeightValue float64                                        `json:"total_weight_value"`
+	OrderItems       []ControllerResponsePropertyOrderItem          `json:"order_items,omitempty"`
+	StatusHistory    []ControllerResponsePropertyOrderStatusHistory `json:"status_history,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ControllerResponsePropertyOrderStatusHistory struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ControllerResponsePropertyOrderItem struct {
+	UID              string  `json:"uid"`
+	Quantity         int     `json:"quantity"`
+	TotalPrice       string  `json:"total_price"`
+	TotalPriceValue  int     `json:"total_price_value"`
+	TotalWeight      string  `json:"total_weight"`
+	TotalWeightValue float64 `json:"total_weight_value"`
+
+	// Product information
+	ProductName        string  `json:"product_name"`
+	ProductSlug        string  `json:"product_slug"`
+	ProductImage       string  `json:"product_image"`
+	ProductWeight      string  `json:"product_weight"`
+	ProductWeightValue float64 `json:"product_weight_value"`
+	BasePrice          string  `json:"base_price"`
+	BasePriceValue     int     `json:"base_price_value"`
+	OfferPrice         string  `json:"offer_price"`
+	OfferPriceValue    int     `json:"offer_price_value"`
+	Discount           int     `json:"discount"`
+}
+
+type OrderControllerResponseListOrders struct {
+	Orders []*OrderControllerResponseGetOrder `json:"orders"`
+	Page   int                                `json:"page"`
+	Limit  int                                `json:"limit"`
+}
+
+// Usecase
+type OrderUsecase interface {
+	Checkout(ctx context.Context, userID int) (string, error)
+	ListOrdersByUserID(ctx context.Context, userID, limit, page int) (*OrderControllerResponseListOrders, error)
+	GetOrderByUIDAndUserID(ctx context.Context, UID string, userID int) (*OrderControllerResponseGetOrder, error)
+	CancelOrder(ctx context.Context, UID string, userID int) error
+
+	// Admin
+	ListOrders(ctx context.Context, limit, page int, status string) (*OrderControllerResponseListOrders, error)
+	GetOrderByUID(ctx context.Context, UID string) (*OrderControllerResponseGetOrder, error)
+	UpdateOrderStatus(ctx context.Context, payload *OrderUsecasePayloadUpdateOrderStatus) error
+}
+
+type OrderUsecasePayloadUpdateOrderStatus struct {
+	UID             string `json:"uid"`
+	Status          string `json:"status"`
+	Note            string `json:"note"`
+	ChangedByUserID int    `json:"changed_by_user_id"`
+}
+
+// Repository
+type OrderModel struct {
+	ID               int     `db:"id" json:"id"`
+	UID              string  `db:"uid" json:"uid"`
+	Status           string  `db:"status" json:"status"`
+	Quantity         int     `db:"quantity" json:"quantity"`
+	TotalPrice       string  `db:"total_price" json:"total_price"`
+	TotalPriceValue  int     `db:"total_price_value" json:"total_price_value"`
+	TotalWeight      string  `db:"total_weight" json:"total_weight"`
+	TotalWeightValue float64 `db:"total_weight_value" json:"total_weight_value"`
+
+	// Relationship
+	OrderItems    []OrderItemModel          `db:"order_items" json:"order_items"`
+	StatusHistory []OrderStatusHistoryModel `db:"status_history" json:"status_history"`
+	UserID        int                       `db:"user_id" json:"user_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type OrderItemModel struct {
+	ID               int     `db:"id" json:"id"`
+	UID              string  `db:"uid" json:"uid"`
+	Quantity         int     `db:"quantity" json:"quantity"`
+	TotalPrice       string  `db:"total_price" json:"total_price"`
+	TotalPriceValue  int     `db:"total_price_value" json:"total_price_value"`
+	TotalWeight      string  `db:"total_weight" json:"total_weight"`
+	TotalWeightValue float64 `db:"total_weight_value" json:"total_weight_value"`
+
+	// Product information
+	ProductName        string  `db:"product_name" json:"product_name"`
+	ProductSlug        string  `db:"product_slug" json:"product_slug"`
+	ProductImage       string  `db:"product_image" json:"product_image"`
+	ProductWeight      string  `db:"product_weight" json:"product_weight"`
+	ProductWeightValue float64 `db:"product_weight_value" json:"product_weight_value"`
+	BasePrice          string  `db:"base_price" json:"base_price"`
+	BasePriceValue     int     `db:"base_price_value" json:"base_price_value"`
+	OfferPrice         string  `db:"offer_price" json:"offer_price"`
+	OfferPriceValue    int     `db:"offer_price_value" json:"offer_price_value"`
+	Discount           int     `db:"discount" json:"discount"`
+
+	// Relationship
+	OrderID   int           `db:"order_id" json:"order_id"`
+	ProductID sql.NullInt64 `db:"product_id" json:"product_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type OrderStatusHistoryModel struct {
+	ID         int            `db:"id" json:"id"`
+	UID        string         `db:"uid" json:"uid"`
+	FromStatus sql.NullString `db:"from_status" json:"from_status"`
+	ToStatus   string         `db:"to_status" json:"to_status"`
+	Note       string         `db:"note" json:"note"`
+
+	// Relationship
+	OrderID         int           `db:"order_id" json:"order_id"`
+	ChangedByUserID sql.NullInt64 `db:"changed_by_user_id" json:"changed_by_user_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+type OrderRepository interface {
+	CreateOrder(ctx context.Context, orderPayload *OrderRepositoryPayloadCreateOrder) (string, error)
+	GetOrderByUID(ctx context.Context, UID string) (*OrderModel, error)
+	GetOrderByUIDAndUserID(ctx context.Context, UID string, userID int) (*OrderModel, error)
+	// LockOrder locks the order row until the transaction of ctx ends.
+	LockOrder(ctx context.Context, orderID int) error
+	ListOrdersByUserID(ctx context.Context, userID, limit, offset int) ([]*OrderModel, error)
+	ListOrders(ctx context.Context, limit, offset int, status string) ([]*OrderModel, error)
+	UpdateOrderStatus(ctx context.Context, payload *OrderRepositoryPayloadUpdateOrderStatus) error
+}
+
+type OrderRepositoryPayloadCreateOrder struct {
+	UID              string  `db:"uid" json:"uid"`
+	Status           string  `db:"status" json:"status"`
+	Quantity         int     `db:"quantity" json:"quantity"`
+	TotalPrice       string  `db:"total_price" json:"total_price"`
+	TotalPriceValue  int     `db:"total_price_value" json:"total_price_value"`
+	TotalWeight      string  `db:"total_weight" json:"total_weight"`
+	TotalWeightValue float64 `db:"total_weight_value" json:"total_weight_value"`
+
+	// Relationship
+	OrderItems []OrderRepositoryPayloadCreateOrderItem `db:"-" json:"order_items"`
+	UserID     int                                     `db:"user_id" json:"user_id"`
+	CartID     int                                     `db:"-" json:"cart_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type OrderRepositoryPayloadCreateOrderItem struct {
+	UID              string  `db:"uid" json:"uid"`
+	Quantity         int     `db:"quantity" json:"quantity"`
+	TotalPrice       string  `db:"total_price" json:"total_price"`
+	TotalPriceValue  int     `db:"total_price_value" json:"total_price_value"`
+	TotalWeight      string  `db:"total_weight" json:"total_weight"`
+	TotalWeightValue float64 `db:"total_weight_value" json:"total_weight_value"`
+
+	// Product information
+	ProductName        string  `db:"product_name" json:"product_name"`
+	ProductSlug        string  `db:"product_slug" json:"product_slug"`
+	ProductImage       string  `db:"product_image" json:"product_image"`
+	ProductWeight      string  `db:"product_weight" json:"product_weight"`
+	ProductWeightValue float64 `db:"product_weight_value" json:"product_weight_value"`
+	BasePrice          string  `db:"base_price" json:"base_price"`
+	BasePriceValue     int     `db:"base_price_value" json:"base_price_value"`
+	OfferPrice         string  `db:"offer_price" json:"offer_price"`
+	OfferPriceValue    int     `db:"offer_price_value" json:"offer_price_value"`
+	Discount           int     `db:"discount" json:"discount"`
+
+	// Relationship
+	OrderID   int `db:"order_id" json:"order_id"`
+	ProductID int `db:"product_id" json:"product_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type OrderRepositoryPayloadUpdateOrderStatus struct {
+	FromStatus string `db:"from_status" json:"from_status"`
+	ToStatus   string `db:"to_status" json:"to_status"`
+	Note       string `db:"note" json:"note"`
+	Restock    bool   `db:"-" json:"restock"`
+
+	// Relationship
+	OrderID         int `db:"order_id" json:"order_id"`
+	ChangedByUserID int `db:"changed_by_user_id" json:"changed_by_user_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/payment_usecase_mock.go --fake-name PaymentUsecaseMock . PaymentUsecase
+
+const (
+	PaymentMethodVirtualAccount = "VIRTUAL_ACCOUNT"
+	PaymentMethodQRIS           = "QRIS"
+	PaymentMethodEWallet        = "EWALLET"
+)
+
+const (
+	PaymentStatusPending  = "PENDING"
+	PaymentStatusPaid     = "PAID"
+	PaymentStatusFailed   = "FAILED"
+	PaymentStatusExpired  = "EXPIRED"
+	PaymentStatusRefunded = "REFUNDED"
+)
+
+const (
+	PaymentGatewayMidtrans  = "midtrans"
+	PaymentGatewaySimulator = "simulator"
+)
+
+const PaymentCurrencyIDR = "IDR"
+
+var (
+	ErrPaymentNotFound           = NewNotFoundError("PAYMENT_NOT_FOUND", "payment not found")
+	ErrPaymentAmountMismatch     = NewValidationError("PAYMENT_AMOUNT_MISMATCH", "payment amount does not match")
+	ErrInvalidWebhookSignature   = NewForbiddenError("INVALID_WEBHOOK_SIGNATURE", "invalid webhook signature")
+	ErrPaymentSimulatorDisabled  = NewForbiddenError("PAYMENT_SIMULATOR_DISABLED", "payment simulator is not enabled")
+	ErrOrderNotPayable           = NewConflictError("ORDER_NOT_PAYABLE", "order is not waiting for payment")
+	ErrPaymentNotRefundable      = NewConflictError("PAYMENT_NOT_REFUNDABLE", "payment is not refundable")
+	ErrPaymentSuperseded         = NewConflictError("PAYMENT_SUPERSEDED", "payment has been superseded by a newer payment")
+	ErrUnsupportedPaymentChannel = NewValidationError("UNSUPPORTED_PAYMENT_CHANNEL", "unsupported payment channel")
+)
+
+// Controller
+type PaymentController interface {
+	CreatePayment(c echo.Context) error
+	GetPaymentByOrderUID(c echo.Context) error
+	HandleWebhook(c echo.Context) error
+	SimulatePayment(c echo.Context) error
+
+	// Admin
+	AdminRefundPayment(c echo.Context) error
+}
+
+type PaymentControllerPayloadCreatePayment struct {
+	Method  string `json:"method" validate:"required,oneof=VIRTUAL_ACCOUNT QRIS EWALLET"`
+	Channel string `json:"channel" validate:"required_unless=Method QRIS,omitempty,oneof=bca bni bri permata gopay shopeepay"`
+}
+
+type PaymentControllerPayloadSimulatePayment struct {
+	Status string `json:"status" validate:"required,oneof=PAID FAILED EXPIRED"`
+}
+
+type PaymentControllerPayloadRefundPayment struct {
+	Reason string `json:"reason" validate:"max=255"`
+}
+
+type PaymentControllerResponseGetPayment struct {
+	UID           string     `json:"uid"`
+	Gateway       string     `json:"gateway"`
+	Method        string     `json:"method"`
+	Channel       string     `json:"channel"`
+	Amount        int        `json:"amount"`
+	Currency      string     `json:"currency"`
+	Status        string     `json:"status"`
+	TransactionID string     `json:"transaction_id"`
+	VANumber      string     `json:"va_number,omitempty"`
+	QRString      string     `json:"qr_string,omitempty"`
+	ActionURL     string     `json:"action_url,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	PaidAt        *time.Time `json:"paid_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Usecase
+type PaymentUsecase interface {
+	CreatePayment(ctx context.Context, payload *PaymentUsecasePayloadCreatePayment) (*PaymentControllerResponseGetPayment, error)
+	GetPaymentByOrderUID(ctx context.Context, orderUID string, userID int) (*PaymentControllerResponseGetPayment, error)
+	HandleWebhook(ctx context.Context, header http.Header, body []byte) error
+	SimulatePayment(ctx context.Context, transactionID, status string) error
+
+	// Admin
+	RefundPayment(ctx context.Context, orderUID, reason string, changedByUserID int) error
+}
+
+type PaymentUsecasePayloadCreatePayment struct {
+	OrderUID string `json:"order_uid"`
+	Method   string `json:"method"`
+	Channel  string `json:"channel"`
+	User     *UserModel
+}
+
+// Gateway
+type PaymentGateway interface {
+	Name() string
+	CreateCharge(ctx context.Context, payload *PaymentGatewayPayloadCreateCharge) (*PaymentGatewayCharge, error)
+	GetChargeStatus(ctx context.Context, transactionID string) (*PaymentGatewayCharge, error)
+	// VerifyWebhook checks the notification signature and returns the parsed notification.
+	VerifyWebhook(ctx context.Context, header http.Header, body []byte) (*PaymentGatewayNotification, error)
+	Refund(ctx context.Context, payload *PaymentGatewayPayloadRefund) error
+}
+
+// PaymentSimulator is implemented by gateways that can settle charges locally, so the
+// pay -> webhook -> order PAID flow can be exercised without a real gateway.
+type PaymentSimulator interface {
+	// Simulate settles the charge and returns a signed webhook request for it.
+	Simulate(ctx context.Context, transactionID, status string) (header http.Header, body []byte, err error)
+}
+
+type PaymentGatewayPayloadCreateCharge struct {
+	ReferenceID   string
+	Amount        int
+	Method        string
+	Channel       string
+	CustomerName  string
+	CustomerEmail string
+	CustomerPhone string
+	Items         []PaymentGatewayPropertyItem
+}
+
+type PaymentGatewayPropertyItem struct {
+	ID       string
+	Name     string
+	Price    int
+	Quantity int
+}
+
+type PaymentGatewayPayloadRefund struct {
+	TransactionID string
+	ReferenceID   string
+	Amount        int
+	Reason        string
+}
+
+type PaymentGatewayCharge struct {
+	TransactionID string
+	ReferenceID   string
+	Status        string
+	Amount        int
+	VANumber      string
+	QRString      string
+	ActionURL     string
+	ExpiresAt     *time.Time
+}
+
+type PaymentGatewayNotification struct {
+	TransactionID string
+	ReferenceID   string
+	Status        string
+	Amount        int
+}
+
+// Repository
+type PaymentModel struct {
+	ID            int            `db:"id" json:"id"`
+	UID           string         `db:"uid" json:"uid"`
+	Gateway       string         `db:"gateway" json:"gateway"`
+	Method        string         `db:"method" json:"method"`
+	Channel       string         `db:"channel" json:"channel"`
+	Amount        int            `db:"amount" json:"amount"`
+	Currency      string         `db:"currency" json:"currency"`
+	Status        string         `db:"status" json:"status"`
+	TransactionID string         `db:"transaction_id" json:"transaction_id"`
+	VANumber      sql.NullString `db:"va_number" json:"va_number"`
+	QRString      sql.NullString `db:"qr_string" json:"qr_string"`
+	ActionURL     sql.NullString `db:"action_url" json:"action_url"`
+	ExpiresAt     sql.NullTime   `db:"expires_at" json:"expires_at"`
+	PaidAt        sql.NullTime   `db:"paid_at" json:"paid_at"`
+
+	// Relationship
+	OrderID int `db:"order_id" json:"order_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type PaymentRepository interface {
+	CreatePayment(ctx context.Context, paymentPayload *PaymentRepositoryPayloadCreatePayment) (string, error)
+	GetActivePaymentByOrderID(ctx context.Context, orderID int) (*PaymentModel, error)
+	// ExpirePayment marks a pending payment as expired, it does nothing once the payment is no longer pending.
+	ExpirePayment(ctx context.Context, paymentID int, updatedAt time.Time) error
+	// ApplyNotification updates the payment and, once paid, its order. Repeated notifications for a
+	// transaction ID that is already in the notified status are ignored, a payment notification for a
+	// payment that was superseded by a newer one fails with ErrPaymentSuperseded.
+	ApplyNotification(ctx context.Context, payload *PaymentRepositoryPayloadApplyNotification) error
+	RefundPayment(ctx context.Context, payload *PaymentRepositoryPayloadRefundPayment) error
+}
+
+type PaymentRepositoryPayloadCreatePayment struct {
+	UID           string         `db:"uid" json:"uid"`
+	Gateway       string         `db:"gateway" json:"gateway"`
+	Method        string         `db:"method" json:"method"`
+	Channel       string         `db:"channel" json:"channel"`
+	Amount        int            `db:"amount" json:"amount"`
+	Currency      string         `db:"currency" json:"currency"`
+	Status        string         `db:"status" json:"status"`
+	TransactionID string         `db:"transaction_id" json:"transaction_id"`
+	VANumber      sql.NullString `db:"va_number" json:"va_number"`
+	QRString      sql.NullString `db:"qr_string" json:"qr_string"`
+	ActionURL     sql.NullString `db:"action_url" json:"action_url"`
+	ExpiresAt     sql.NullTime   `db:"expires_at" json:"expires_at"`
+
+	// Relationship
+	OrderID int `db:"order_id" json:"order_id"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type PaymentRepositoryPayloadApplyNotification struct {
+	TransactionID string    `db:"transaction_id" json:"transaction_id"`
+	Status        string    `db:"status" json:"status"`
+	Amount        int       `db:"amount" json:"amount"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type PaymentRepositoryPayloadRefundPayment struct {
+	PaymentID       int       `db:"payment_id" json:"payment_id"`
+	OrderID         int       `db:"order_id" json:"order_id"`
+	OrderFromStatus string    `db:"order_from_status" json:"order_from_status"`
+	Note            string    `db:"note" json:"note"`
+	ChangedByUserID int       `db:"changed_by_user_id" json:"changed_by_user_id"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -21,36 +21,50 @@ func (s *StringSlice) Scan(value interface{}) error {
 	return json.Unmarshal(b, &s)
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/product_usecase_mock.go --fake-name ProductUsecaseMock . ProductUsecase
+
+var (
+	ErrProductNotFound = NewNotFoundError("PRODUCT_NOT_FOUND", "product not found")
+	ErrInvalidCursor   = NewValidationError("INVALID_CURSOR", "invalid cursor")
+)
+
 // Controller
 type ProductController interface {
 	Create(c echo.Context) error
+	List(c echo.Context) error
 	GetByUID(c echo.Context) error
 	UpdateByUID(c echo.Context) error
 	DeleteByUID(c echo.Context) error
 }
 
 type ProductControllerPayloadCreateProduct struct {
-	Name           string      `json:"name" validate:"required, min=5"`
+	Name           string      `json:"name" validate:"required,min=5"`
 	SKU            string      `json:"sku"`
-	Description    string      `json:"description" validate:"required, min=30"`
-	Images         StringSlice `json:"images" validate:"required, min=1"`
-	WeightValue    float64     `json:"weight_value" validate:"required, min=100"`
-	BasePriceValue int         `json:"base_price_value" validate:"required, min=5000"`
-	Discount       *int        `json:"discount" validate:"required, max=100"`
-	Stock          *int        `json:"stock" validate:"required"`
-	Status         string      `json:"status" validate:"required, oneof=ACTIVE INACTIVE"`
+	Description    string      `json:"description" validate:"required,min=30"`
+	Images         StringSlice `json:"images" validate:"required,min=1"`
+	WeightValue    float64     `json:"weight_value" validate:"required,min=100"`
+	BasePriceValue int         `json:"base_price_value" validate:"required,min=5000"`
+	Discount       *int        `json:"discount" validate:"required,min=0,max=100"`
+	Stock          *int        `json:"stock" validate:"required,min=0"`
+	Status         string      `json:"status" validate:"required,oneof=ACTIVE INACTIVE"`
 }
 
 type ProductControllerPayloadUpdateProduct struct {
-	Name           string      `json:"name" validate:"required, min=5"`
+	Name           string      `json:"name" validate:"required,min=5"`
 	SKU            string      `json:"sku"`
-	Description    string      `json:"description" validate:"required, min=30"`
-	Images         StringSlice `json:"images" validate:"required, min=1"`
-	WeightValue    float64     `json:"weight_value" validate:"required, min=100"`
-	BasePriceValue int         `json:"base_price_value" validate:"required, min=5000"`
-	Discount       *int        `json:"discount" validate:"required, max=100"`
-	Stock          *int        `json:"stock" validate:"required"`
-	Status         string      `json:"status" validate:"required, oneof=ACTIVE INACTIVE"`
+	Description    string      `json:"description" validate:"required,min=30"`
+	Images         StringSlice `json:"images" validate:"required,min=1"`
+	WeightValue    float64     `json:"weight_value" validate:"required,min=100"`
+	BasePriceValue int         `json:"base_price_value" validate:"required,min=5000"`
+	Discount       *int        `json:"discount" validate:"required,min=0,max=100"`
+	Stock          *int        `json:"stock" validate:"required,min=0"`
+	Status         string      `json:"status" validate:"required,oneof=ACTIVE INACTIVE"`
+}
+
+type ProductControllerQueryListProducts struct {
+	Limit     int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor    string `query:"cursor" validate:"required_with=Direction"`
+	Direction string `query:"direction" validate:"required_with=Cursor,omitempty,oneof=next prev"`
 }
 
 type ProductControllerResponseGetProductByUID struct {
@@ -75,11 +89,11 @@ type ProductControllerResponseGetProductByUID struct {
 }
 
 type ProductControllerResponseListProducts struct {
-	Products    []*ProductControllerResponseGetProductByUID
-	IsFirstPage bool
-	Limit       int
-	PrevCursor  string
-	NextCursor  string
+	Products    []*ProductControllerResponseGetProductByUID `json:"products"`
+	IsFirstPage bool                                        `json:"is_first_page"`
+	Limit       int                                         `json:"limit"`
+	PrevCursor  string                                      `json:"prev_cursor"`
+	NextCursor  string                                      `json:"next_cursor"`
 }
 
 // Usecase
@@ -139,11 +153,12 @@ type ProductModel struct {
 }
 
 type ProductRepository interface {
-	Create(productPayload *ProductRepositoryPayloadCreateProduct) (string, error)
-	List(limit, cursor int, direction string) ([]*ProductModel, error)
-	GetByUID(UID string) (*ProductModel, error)
-	UpdateByUID(productPayload *ProductRepositoryPayloadUpdateProduct) error
-	DeleteByUID(UID string) error
+	Create(ctx context.Context, productPayload *ProductRepositoryPayloadCreateProduct) (string, error)
+	List(ctx context.Context, limit, cursor int, direction string) ([]*ProductModel, error)
+	GetByID(ctx context.Context, ID int) (*ProductModel, error)
+	GetByUID(ctx context.Context, UID string) (*ProductModel, error)
+	UpdateByUID(ctx context.Context, productPayload *ProductRepositoryPayloadUpdateProduct) error
+	DeleteByUID(ctx context.Context, UID string) error
 }
 
 type ProductRepositoryPayloadCreateProduct struct {
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/role_usecase_mock.go --fake-name RoleUsecaseMock . RoleUsecase
+
+// Permissions and roles are seeded by the create_roles_and_permissions_tables migration.
+const (
+	PermissionProductWrite  = "product:write"
+	PermissionOrderRead     = "order:read"
+	PermissionOrderWrite    = "order:write"
+	PermissionPaymentRefund = "payment:refund"
+	PermissionAdminManage   = "admin:manage"
+	PermissionRoleManage    = "role:manage"
+
+	RoleSuperAdmin = "super_admin"
+)
+
+var (
+	ErrRoleNotFound       = NewNotFoundError("ROLE_NOT_FOUND", "role not found")
+	ErrRoleAlreadyExist   = NewConflictError("ROLE_ALREADY_EXIST", "user already has the role")
+	ErrUserRoleNotFound   = NewNotFoundError("USER_ROLE_NOT_FOUND", "user does not have the role")
+	ErrRoleManagedByAdmin = NewValidationError("ROLE_MANAGED_BY_ADMIN", "super_admin role is managed through the admin API")
+)
+
+// Controller
+type RoleController interface {
+	ListRoles(c echo.Context) error
+	ListUserRoles(c echo.Context) error
+	AssignRole(c echo.Context) error
+	RemoveRole(c echo.Context) error
+}
+
+type RoleControllerPayloadAssignRole struct {
+	Role string `json:"role" validate:"required"`
+}
+
+type RoleControllerResponseRole struct {
+	UID         string   `json:"uid"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// Usecase
+type RoleUsecase interface {
+	ListRoles(ctx context.Context) ([]*RoleControllerResponseRole, error)
+	ListUserRoles(ctx context.Context, userUID string) ([]*RoleControllerResponseRole, error)
+	AssignRole(ctx context.Context, payload *RoleUsecasePayloadUpdateUserRole) error
+	RemoveRole(ctx context.Context, payload *RoleUsecasePayloadUpdateUserRole) error
+	HasPermission(ctx context.Context, userID int, permission string) (bool, error)
+}
+
+// ActorUserID and IPAddress are recorded in the audit log.
+type RoleUsecasePayloadUpdateUserRole struct {
+	UserUID     string
+	Role        string
+	ActorUserID int
+	IPAddress   string
+}
+
+// Repository
+type RoleModel struct {
+	ID          int    `db:"id" json:"id"`
+	UID         string `db:"uid" json:"uid"`
+	Name        string `db:"name" json:"name"`
+	Description string `db:"description" json:"description"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type RoleRepository interface {
+	ListRoles(ctx context.Context) ([]*RoleModel, error)
+	ListRolesByUserID(ctx context.Context, userID int) ([]*RoleModel, error)
+	GetRoleByName(ctx context.Context, name string) (*RoleModel, error)
+	ListPermissionsByRoleID(ctx context.Context, roleID int) ([]string, error)
+	HasPermission(ctx context.Context, userID int, permission string) (bool, error)
+	AssignRole(ctx context.Context, userID, roleID int) error
+	RemoveRole(ctx context.Context, userID, roleID int) error
+}
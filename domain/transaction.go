@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/tx_manager_mock.go --fake-name TxManagerMock . TxManager
+
+// TxManager runs repository calls of a usecase atomically. Repositories called with the ctx passed to fn
+// use its transaction, a nested WithinTransaction joins the outer transaction.
+type TxManager interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -2,17 +2,72 @@ package domain
 
 import (
 	"context"
+	"database/sql"
+	"io"
 	"time"
+
+	"github.com/labstack/echo/v4"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/user_usecase_mock.go --fake-name UserUsecaseMock . UserUsecase
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/user_repository_mock.go --fake-name UserRepositoryMock . UserRepository
 
+const MaxProfileImageSize = 2 << 20
+
+var (
+	ErrUserAlreadyExist        = NewConflictError("USER_ALREADY_EXIST", "user already exist")
+	ErrInvalidPhone            = NewValidationError("INVALID_PHONE", "phone must be an Indonesian number starting with +62")
+	ErrEmailAlreadyExist       = NewConflictError("EMAIL_ALREADY_EXIST", "email already exist")
+	ErrCurrentPasswordRequired = NewValidationError("CURRENT_PASSWORD_REQUIRED", "current password is required to change the email")
+	ErrInvalidCurrentPassword  = NewForbiddenError("INVALID_CURRENT_PASSWORD", "current password is invalid")
+	ErrProfileImageRequired    = NewValidationError("PROFILE_IMAGE_REQUIRED", "image is required")
+	ErrProfileImageTooLarge    = NewValidationError("PROFILE_IMAGE_TOO_LARGE", "profile image must not be larger than 2 MB")
+	ErrUnsupportedProfileImage = NewValidationError("UNSUPPORTED_PROFILE_IMAGE", "profile image must be a JPEG, PNG or WebP image")
+)
+
+// Controller
+type UserController interface {
+	GetProfile(c echo.Context) error
+	UpdateProfile(c echo.Context) error
+	UploadProfileImage(c echo.Context) error
+}
+
+// UserControllerPayloadUpdateProfile only updates the fields that are sent, changing the email requires
+// the current password.
+type UserControllerPayloadUpdateProfile struct {
+	Name            *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Phone           *string `json:"phone" validate:"omitempty"`
+	Email           *string `json:"email" validate:"omitempty,email"`
+	CurrentPassword *string `json:"current_password" validate:"omitempty"`
+}
+
+type UserControllerResponseProfile struct {
+	UID           string `json:"uid"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Phone         string `json:"phone"`
+	ProfileImage  string `json:"profile_image"`
+	EmailVerified bool   `json:"email_verified"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 // Usecase
 type UserUsecase interface {
 	GetUserByFirebaseUID(ctx context.Context, UID string) (*UserModel, error)
 	GetUserByUID(ctx context.Context, UID string) (*UserModel, error)
 	GetAdminByUserID(ctx context.Context, UserID int) (*AdminModel, error)
+	GetProfile(ctx context.Context, user *UserModel) *UserControllerResponseProfile
+	UpdateProfile(ctx context.Context, user *UserModel, payload *UserUsecasePayloadUpdateProfile) (*UserControllerResponseProfile, error)
+	UploadProfileImage(ctx context.Context, user *UserModel, image io.Reader) (*UserControllerResponseProfile, error)
+}
+
+type UserUsecasePayloadUpdateProfile struct {
+	Name            *string
+	Phone           *string
+	Email           *string
+	CurrentPassword *string
 }
 
 // Repository
@@ -24,6 +79,10 @@ type UserModel struct {
 	Name         string `db:"name" json:"name"`
 	Phone        string `db:"phone" json:"phone"`
 	ProfileImage string `db:"profile_image" json:"profile_image"`
+	// Access tokens issued at or before TokensRevokedAt are rejected by AuthMiddleware
+	TokensRevokedAt sql.NullTime `db:"tokens_revoked_at" json:"-"`
+	EmailVerifiedAt sql.NullTime `db:"email_verified_at" json:"-"`
+	DeletedAt       sql.NullTime `db:"deleted_at" json:"-"`
 
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
@@ -40,12 +99,26 @@ type AdminModel struct {
 }
 
 type UserRepository interface {
-	CreateUser(userPayload *UserRepositoryPayloadCreateUser) (int, error)
-	CreateAdmin(adminPayload *UserRepositoryPayloadCreateAdmin) error
-	GetUserByEmail(email string) (*UserModel, error)
-	GetUserByFirebaseUID(UID string) (*UserModel, error)
-	GetUserByUID(UID string) (*UserModel, error)
-	GetAdminByUserID(UserID int) (*AdminModel, error)
+	CreateUser(ctx context.Context, userPayload *UserRepositoryPayloadCreateUser) (int, error)
+	CreateAdmin(ctx context.Context, adminPayload *UserRepositoryPayloadCreateAdmin) error
+	GetUserByEmail(ctx context.Context, email string) (*UserModel, error)
+	GetUserByFirebaseUID(ctx context.Context, UID string) (*UserModel, error)
+	GetUserByUID(ctx context.Context, UID string) (*UserModel, error)
+	GetUserByID(ctx context.Context, ID int) (*UserModel, error)
+	GetAdminByUserID(ctx context.Context, UserID int) (*AdminModel, error)
+	GetAdminByUID(ctx context.Context, UID string) (*AdminModel, error)
+	ListAdmins(ctx context.Context) ([]*AdminModel, error)
+	DeleteAdminByUID(ctx context.Context, UID string) error
+	RevokeTokens(ctx context.Context, userID int, revokedAt time.Time) error
+	// UpdateProfile only writes the fields that are set and returns the updated user, email_verified_at is
+	// cleared when the email changes.
+	UpdateProfile(ctx context.Context, userPayload *UserRepositoryPayloadUpdateProfile) (*UserModel, error)
+	MarkEmailVerified(ctx context.Context, email string, verifiedAt time.Time) error
+	// AnonymizeUser clears the PII of the user and deletes its addresses, cart, sessions, roles and data exports.
+	// The auth provider user is queued for deletion, it is removed from the queue with DeleteAuthUserDeletion.
+	AnonymizeUser(ctx context.Context, userID int, anonymizedAt time.Time) error
+	ListAuthUserDeletions(ctx context.Context) ([]string, error)
+	DeleteAuthUserDeletion(ctx context.Context, authUID string) error
 }
 
 type UserRepositoryPayloadCreateUser struct {
@@ -55,7 +128,6 @@ type UserRepositoryPayloadCreateUser struct {
 	Name         string `db:"name" json:"name"`
 	Phone        string `db:"phone" json:"phone"`
 	ProfileImage string `db:"profile_image" json:"profile_image"`
-	IsAdmin      bool   `json:"is_admin"`
 
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
@@ -69,3 +141,13 @@ type UserRepositoryPayloadCreateAdmin struct {
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
+
+type UserRepositoryPayloadUpdateProfile struct {
+	ID           int     `db:"id" json:"id"`
+	Email        *string `db:"email" json:"email"`
+	Name         *string `db:"name" json:"name"`
+	Phone        *string `db:"phone" json:"phone"`
+	ProfileImage *string `db:"profile_image" json:"profile_image"`
+
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
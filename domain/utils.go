@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"context"
+	"io"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -9,30 +11,98 @@ import (
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/auth_util_mock.go --fake-name AuthUtilMock . AuthUtil
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/storage_util_mock.go --fake-name StorageUtilMock . StorageUtil
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/mailer_util_mock.go --fake-name MailerUtilMock . MailerUtil
+
+const (
+	MailerSMTP = "smtp"
+	MailerFile = "file"
+)
 
 type Env struct {
-	AppEnv                    string `mapstructure:"APP_ENV"`
-	Host                      string `mapstructure:"HOST"`
-	Port                      string `mapstructure:"PORT"`
-	FirebaseCredentialPath    string `mapstructure:"FIREBASE_CREDENTIAL_PATH"`
-	FirebaseVerifyPasswordURL string `mapstructure:"FIREBASE_VERIFY_PASSWORD_URL"`
-	ContextTimeout            int    `mapstructure:"CONTEXT_TIMEOUT"`
-	TestDBUrl                 string `mapstructure:"TEST_DB_URL"`
-	TestDBUser                string `mapstructure:"TEST_DB_USER"`
-	TestDBPassword            string `mapstructure:"TEST_DB_PASSWORD"`
-	DBUrl                     string `mapstructure:"DB_URL"`
-	DBName                    string `mapstructure:"DB_NAME"`
-	AesSecret                 string `mapstructure:"AES_SECRET"`
-	AccessTokenExpiryHour     int    `mapstructure:"ACCESS_TOKEN_EXPIRY_HOUR"`
-	RefreshTokenExpiryHour    int    `mapstructure:"REFRESH_TOKEN_EXPIRY_HOUR"`
-	AccessTokenSecret         string `mapstructure:"ACCESS_TOKEN_SECRET"`
-	RefreshTokenSecret        string `mapstructure:"REFRESH_TOKEN_SECRET"`
+	AppEnv                           string `mapstructure:"APP_ENV"`
+	Host                             string `mapstructure:"HOST"`
+	Port                             string `mapstructure:"PORT"`
+	FirebaseCredentialPath           string `mapstructure:"FIREBASE_CREDENTIAL_PATH"`
+	FirebaseVerifyPasswordURL        string `mapstructure:"FIREBASE_VERIFY_PASSWORD_URL"`
+	ContextTimeout                   int    `mapstructure:"CONTEXT_TIMEOUT"`
+	ShutdownTimeout                  int    `mapstructure:"SHUTDOWN_TIMEOUT"`
+	ShutdownDelay                    int    `mapstructure:"SHUTDOWN_DELAY"`
+	TestDBUrl                        string `mapstructure:"TEST_DB_URL"`
+	TestDBUser                       string `mapstructure:"TEST_DB_USER"`
+	TestDBPassword                   string `mapstructure:"TEST_DB_PASSWORD"`
+	DBUrl                            string `mapstructure:"DB_URL"`
+	DBName                           string `mapstructure:"DB_NAME"`
+	AesSecret                        string `mapstructure:"AES_SECRET"`
+	AccessTokenExpiryHour            int    `mapstructure:"ACCESS_TOKEN_EXPIRY_HOUR"`
+	RefreshTokenExpiryHour           int    `mapstructure:"REFRESH_TOKEN_EXPIRY_HOUR"`
+	AccessTokenSecret                string `mapstructure:"ACCESS_TOKEN_SECRET"`
+	RefreshTokenSecret               string `mapstructure:"REFRESH_TOKEN_SECRET"`
+	PaymentGateway                   string `mapstructure:"PAYMENT_GATEWAY"`
+	PaymentSimulatorSecret           string `mapstructure:"PAYMENT_SIMULATOR_SECRET"`
+	MidtransBaseURL                  string `mapstructure:"MIDTRANS_BASE_URL"`
+	MidtransServerKey                string `mapstructure:"MIDTRANS_SERVER_KEY"`
+	CartReservationTTLMinutes        int    `mapstructure:"CART_RESERVATION_TTL_MINUTES"`
+	GuestCartMaxAgeHours             int    `mapstructure:"GUEST_CART_MAX_AGE_HOURS"`
+	AuthProvider                     string `mapstructure:"AUTH_PROVIDER"`
+	StorageDir                       string `mapstructure:"STORAGE_DIR"`
+	StorageBaseURL                   string `mapstructure:"STORAGE_BASE_URL"`
+	FirebaseResetPasswordURL         string `mapstructure:"FIREBASE_RESET_PASSWORD_URL"`
+	FirebaseUpdateAccountURL         string `mapstructure:"FIREBASE_UPDATE_ACCOUNT_URL"`
+	FirebaseSignInWithCustomTokenURL string `mapstructure:"FIREBASE_SIGN_IN_WITH_CUSTOM_TOKEN_URL"`
+	AuthActionURL                    string `mapstructure:"AUTH_ACTION_URL"`
+	AuthActionTokenSecret            string `mapstructure:"AUTH_ACTION_TOKEN_SECRET"`
+	Mailer                           string `mapstructure:"MAILER"`
+	MailFrom                         string `mapstructure:"MAIL_FROM"`
+	MailDir                          string `mapstructure:"MAIL_DIR"`
+	SMTPHost                         string `mapstructure:"SMTP_HOST"`
+	SMTPPort                         string `mapstructure:"SMTP_PORT"`
+	SMTPUsername                     string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword                     string `mapstructure:"SMTP_PASSWORD"`
+	LoginAttemptStore                string `mapstructure:"LOGIN_ATTEMPT_STORE"`
+	MigrationsDir                    string `mapstructure:"MIGRATIONS_DIR"`
+	HealthCheckAuthProvider          bool   `mapstructure:"HEALTH_CHECK_AUTH_PROVIDER"`
+	TrustedProxies                   string `mapstructure:"TRUSTED_PROXIES"`
+}
+
+// IsProduction reports whether APP_ENV is production, prod is accepted as well.
+func (e *Env) IsProduction() bool {
+	return e.AppEnv == "production" || e.AppEnv == "prod"
 }
 
 type AuthUtil interface {
-	CreateUser(email, password string) (authUID string, err error)
-	VerifyToken(token string) (authUID string, err error)
-	GetAccessToken(email, password string) (accessToken string, err error)
+	CreateUser(ctx context.Context, email, password string) (authUID string, err error)
+	VerifyToken(ctx context.Context, token string) (authUID string, err error)
+	GetAccessToken(ctx context.Context, email, password string) (accessToken string, err error)
+	// IssueAccessToken returns an access token of the provider for the user, the only kind AuthMiddleware accepts.
+	IssueAccessToken(ctx context.Context, authUID string) (accessToken string, expiresAt time.Time, err error)
+	RevokeTokens(ctx context.Context, authUID string) error
+	UpdateEmail(ctx context.Context, authUID, email string) error
+	// Links point to AUTH_ACTION_URL with the mode and oobCode query parameters.
+	GeneratePasswordResetLink(ctx context.Context, email string) (link string, err error)
+	GenerateEmailVerificationLink(ctx context.Context, email string) (link string, err error)
+	// ResetPassword and VerifyEmail return ErrInvalidActionCode given an invalid, expired or used code.
+	ResetPassword(ctx context.Context, code, newPassword string) (email string, err error)
+	VerifyEmail(ctx context.Context, code string) (email string, err error)
+	DeleteUser(ctx context.Context, authUID string) error
+	// Ping returns an error if the auth provider can not be reached.
+	Ping(ctx context.Context) error
+}
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// MailerUtil sends plain text mails, MAILER selects SMTP or the file mailer used for local testing.
+type MailerUtil interface {
+	SendMail(ctx context.Context, mail *Mail) error
+}
+
+// StorageUtil stores uploaded files under key and returns the URL they are served from.
+type StorageUtil interface {
+	Upload(ctx context.Context, key, contentType string, body io.Reader) (url string, err error)
 }
 
 type AesEncryptUtil interface {
@@ -43,10 +113,18 @@ type AesEncryptUtil interface {
 type HashUtil interface {
 	HashPassword(password string) (string, error)
 	ValidatePassword(password, hash string) bool
+	HashToken(token string) string
 }
 
+// Access and refresh tokens carry their type, so one is never accepted as the other even if the secrets leak into each other.
+const (
+	JWTTokenTypeAccess  = "access"
+	JWTTokenTypeRefresh = "refresh"
+)
+
 type JWTAccessTokenClaims struct {
-	UserUID string `json:"user_uid"`
+	UserUID   string `json:"user_uid"`
+	TokenType string `json:"typ"`
 	jwt.RegisteredClaims
 }
 
@@ -54,6 +132,7 @@ type JWTUtil interface {
 	GenerateAccessToken(userUID string) (string, time.Time, error)
 	GenerateRefreshToken(userUID string) (string, time.Time, error)
 	ParseUserUID(tokenString string, isAccessToken bool) (string, error)
+	ParseClaims(tokenString string, isAccessToken bool) (*JWTAccessTokenClaims, error)
 	Refresh(refreshToken string) (string, time.Time, error)
 }
 
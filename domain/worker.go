@@ -0,0 +1,23 @@
+package domain
+
+import "context"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o mocks/lifecycle_mock.go --fake-name LifecycleMock . Lifecycle
+
+// Worker is a background job that runs until ctx is cancelled.
+type Worker interface {
+	Name() string
+	Run(ctx context.Context)
+}
+
+// Lifecycle starts the registered workers in order and stops them in reverse order. The service is not ready
+// to receive traffic once it starts draining.
+type Lifecycle interface {
+	Register(workers ...Worker)
+	Start(ctx context.Context)
+	// Drain flips readiness, in-flight requests and workers keep running until Stop.
+	Drain()
+	// Stop drains and cancels the workers one by one, it returns an error if a worker does not return before ctx is done.
+	Stop(ctx context.Context) error
+	Ready() bool
+}
@@ -0,0 +1,225 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseFirebaseAuthUtil struct {
+	env          *domain.Env
+	firebaseAuth *auth.Client
+}
+
+func NewFirebaseAuthUtil(env *domain.Env, firebaseAuth *auth.Client) domain.AuthUtil {
+	return &baseFirebaseAuthUtil{env: env, firebaseAuth: firebaseAuth}
+}
+
+func (b *baseFirebaseAuthUtil) CreateUser(ctx context.Context, email, password string) (authUID string, err error) {
+	params := (&auth.UserToCreate{}).
+		Email(email).
+		Password(password)
+	firebaseUserRecord, err := b.firebaseAuth.CreateUser(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	return firebaseUserRecord.UID, nil
+}
+
+func (b *baseFirebaseAuthUtil) VerifyToken(ctx context.Context, token string) (authUID string, err error) {
+	parsedToken, err := b.firebaseAuth.VerifyIDTokenAndCheckRevoked(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	return parsedToken.UID, nil
+}
+
+// RevokeTokens revokes the Firebase refresh tokens of the user, VerifyToken rejects ID tokens issued before it.
+func (b *baseFirebaseAuthUtil) RevokeTokens(ctx context.Context, authUID string) error {
+	return b.firebaseAuth.RevokeRefreshTokens(ctx, authUID)
+}
+
+func (b *baseFirebaseAuthUtil) UpdateEmail(ctx context.Context, authUID, email string) error {
+	_, err := b.firebaseAuth.UpdateUser(ctx, authUID, (&auth.UserToUpdate{}).Email(email))
+	return err
+}
+
+func (b *baseFirebaseAuthUtil) DeleteUser(ctx context.Context, authUID string) error {
+	err := b.firebaseAuth.DeleteUser(ctx, authUID)
+	if err != nil && !auth.IsUserNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Ping looks up a user that does not exist, Firebase answering that it is not found means it is reachable.
+func (b *baseFirebaseAuthUtil) Ping(ctx context.Context) error {
+	_, err := b.firebaseAuth.GetUser(ctx, "health-check")
+	if err != nil && !auth.IsUserNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// IssueAccessToken signs a custom token for the user and exchanges it for a Firebase ID token, the same kind of
+// token the client SDKs get, so the middleware only has to accept tokens Firebase verifies.
+func (b *baseFirebaseAuthUtil) IssueAccessToken(ctx context.Context, authUID string) (accessToken string, expiresAt time.Time, err error) {
+	customToken, err := b.firebaseAuth.CustomToken(ctx, authUID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var resBody struct {
+		IdToken   string `json:"idToken"`
+		ExpiresIn string `json:"expiresIn"`
+	}
+	err = b.postIdentityToolkit(ctx, b.env.FirebaseSignInWithCustomTokenURL, map[string]interface{}{
+		"token":             customToken,
+		"returnSecureToken": true,
+	}, &resBody)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresIn, err := strconv.Atoi(resBody.ExpiresIn)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return resBody.IdToken, time.Now().Add(time.Duration(expiresIn) * time.Second), nil
+}
+
+// GeneratePasswordResetLink returns a link to the action handler configured in the Firebase console,
+// it should be set to AUTH_ACTION_URL so both providers share the same page.
+func (b *baseFirebaseAuthUtil) GeneratePasswordResetLink(ctx context.Context, email string) (link string, err error) {
+	return b.firebaseAuth.PasswordResetLink(ctx, email)
+}
+
+func (b *baseFirebaseAuthUtil) GenerateEmailVerificationLink(ctx context.Context, email string) (link string, err error) {
+	return b.firebaseAuth.EmailVerificationLink(ctx, email)
+}
+
+func (b *baseFirebaseAuthUtil) ResetPassword(ctx context.Context, code, newPassword string) (email string, err error) {
+	var resBody struct {
+		Email string `json:"email"`
+	}
+	err = b.postIdentityToolkit(ctx, b.env.FirebaseResetPasswordURL, map[string]string{
+		"oobCode":     code,
+		"newPassword": newPassword,
+	}, &resBody)
+	if err != nil {
+		return "", err
+	}
+
+	return resBody.Email, nil
+}
+
+func (b *baseFirebaseAuthUtil) VerifyEmail(ctx context.Context, code string) (email string, err error) {
+	var resBody struct {
+		Email string `json:"email"`
+	}
+	err = b.postIdentityToolkit(ctx, b.env.FirebaseUpdateAccountURL, map[string]string{
+		"oobCode": code,
+	}, &resBody)
+	if err != nil {
+		return "", err
+	}
+
+	return resBody.Email, nil
+}
+
+// postIdentityToolkit calls an Identity Toolkit REST endpoint, errors about the oobCode are returned as ErrInvalidActionCode.
+func (b *baseFirebaseAuthUtil) postIdentityToolkit(ctx context.Context, url string, reqBody interface{}, resBody interface{}) error {
+	reqBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(reqBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	resBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		json.Unmarshal(resBytes, &errBody)
+		if strings.Contains(errBody.Error.Message, "OOB_CODE") {
+			return domain.ErrInvalidActionCode
+		}
+
+		return fmt.Errorf("identity toolkit request failed with status %d: %s", resp.StatusCode, errBody.Error.Message)
+	}
+
+	return json.Unmarshal(resBytes, resBody)
+}
+
+func (b *baseFirebaseAuthUtil) GetAccessToken(ctx context.Context, email, password string) (accessToken string, err error) {
+	reqBody := map[string]string{
+		"email":             email,
+		"password":          password,
+		"returnSecureToken": "true",
+	}
+	reqBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		fmt.Println("Error marshaling request body:", err)
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", b.env.FirebaseVerifyPasswordURL, bytes.NewBuffer(reqBytes))
+	if err != nil {
+		fmt.Println("Error creating request:", err)
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		fmt.Println("Error sending request:", err)
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	_resBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Println("Error reading response body:", err)
+		return "", err
+	}
+	var resBody struct {
+		IdToken string `json:"idToken"`
+	}
+	err = json.Unmarshal(_resBody, &resBody)
+	if err != nil {
+		fmt.Println("Error unmarshalling response body:", err)
+		return "", err
+	}
+
+	return resBody.IdToken, nil
+}
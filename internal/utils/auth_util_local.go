@@ -0,0 +1,253 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+var actionTokenTTL = map[string]time.Duration{
+	domain.ActionModeResetPassword: time.Hour,
+	domain.ActionModeVerifyEmail:   24 * time.Hour,
+}
+
+// dummyPasswordHash is compared against for unknown emails, so they take as long as a wrong password. It has
+// the cost of HashUtil.HashPassword.
+const dummyPasswordHash = "$2a$14$eaWNbOz1c3je/COtpAUDQOwXtO.S0gn41NKncdg8TbdsxukcpEuCG"
+
+// actionTokenClaims binds a verify email token to the email it was sent to.
+type actionTokenClaims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+type baseLocalAuthUtil struct {
+	env                   *domain.Env
+	credentialRepository  domain.CredentialRepository
+	actionTokenRepository domain.ActionTokenRepository
+	hashUtil              domain.HashUtil
+	jwtUtil               domain.JWTUtil
+}
+
+// NewLocalAuthUtil stores bcrypt hashes in the credentials table and issues access tokens with JWTUtil,
+// so the API can run without Firebase.
+func NewLocalAuthUtil(env *domain.Env, credentialRepository domain.CredentialRepository, actionTokenRepository domain.ActionTokenRepository, hashUtil domain.HashUtil, jwtUtil domain.JWTUtil) domain.AuthUtil {
+	if env.AuthActionTokenSecret == "" {
+		log.Fatal("AUTH_ACTION_TOKEN_SECRET is required for the local auth provider")
+	}
+	if env.AuthActionTokenSecret == env.AccessTokenSecret || env.AuthActionTokenSecret == env.RefreshTokenSecret {
+		log.Fatal("AUTH_ACTION_TOKEN_SECRET must be different from ACCESS_TOKEN_SECRET and REFRESH_TOKEN_SECRET")
+	}
+
+	return &baseLocalAuthUtil{
+		env:                   env,
+		credentialRepository:  credentialRepository,
+		actionTokenRepository: actionTokenRepository,
+		hashUtil:              hashUtil,
+		jwtUtil:               jwtUtil,
+	}
+}
+
+func (b *baseLocalAuthUtil) CreateUser(ctx context.Context, email, password string) (authUID string, err error) {
+	credential, err := b.credentialRepository.GetCredentialByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	if credential != nil {
+		return "", domain.ErrEmailAlreadyExist
+	}
+
+	passwordHash, err := b.hashUtil.HashPassword(password)
+	if err != nil {
+		return "", err
+	}
+
+	metadata := GenerateMetadata()
+	credentialPayload := &domain.CredentialRepositoryPayloadCreateCredential{
+		UID:          metadata.UID(),
+		Email:        email,
+		PasswordHash: passwordHash,
+		CreatedAt:    metadata.CreatedAt,
+		UpdatedAt:    metadata.UpdatedAt,
+	}
+	err = b.credentialRepository.CreateCredential(ctx, credentialPayload)
+	if err != nil {
+		return "", err
+	}
+
+	return credentialPayload.UID, nil
+}
+
+func (b *baseLocalAuthUtil) VerifyToken(ctx context.Context, token string) (authUID string, err error) {
+	return b.jwtUtil.ParseUserUID(token, true)
+}
+
+// GetAccessToken returns an empty token given a wrong password, the same as the Firebase provider.
+func (b *baseLocalAuthUtil) GetAccessToken(ctx context.Context, email, password string) (accessToken string, err error) {
+	credential, err := b.credentialRepository.GetCredentialByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	if credential == nil {
+		b.hashUtil.ValidatePassword(password, dummyPasswordHash)
+		return "", nil
+	}
+	if !b.hashUtil.ValidatePassword(password, credential.PasswordHash) {
+		return "", nil
+	}
+
+	accessToken, _, err = b.jwtUtil.GenerateAccessToken(credential.UID)
+	if err != nil {
+		return "", err
+	}
+
+	return accessToken, nil
+}
+
+func (b *baseLocalAuthUtil) IssueAccessToken(ctx context.Context, authUID string) (accessToken string, expiresAt time.Time, err error) {
+	return b.jwtUtil.GenerateAccessToken(authUID)
+}
+
+func (b *baseLocalAuthUtil) UpdateEmail(ctx context.Context, authUID, email string) error {
+	credential, err := b.credentialRepository.GetCredentialByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if credential != nil {
+		return domain.ErrEmailAlreadyExist
+	}
+
+	return b.credentialRepository.UpdateEmailByUID(ctx, authUID, email, time.Now().UTC())
+}
+
+// RevokeTokens is a no-op, tokens of the local provider are the refresh_tokens revoked by AuthUsecase
+// and the access tokens rejected by AuthMiddleware after users.tokens_revoked_at.
+func (b *baseLocalAuthUtil) RevokeTokens(ctx context.Context, authUID string) error {
+	return nil
+}
+
+func (b *baseLocalAuthUtil) DeleteUser(ctx context.Context, authUID string) error {
+	return b.credentialRepository.DeleteCredentialByUID(ctx, authUID)
+}
+
+// Ping has nothing to reach, credentials are stored in Postgres which readiness checks already.
+func (b *baseLocalAuthUtil) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (b *baseLocalAuthUtil) GeneratePasswordResetLink(ctx context.Context, email string) (link string, err error) {
+	return b.generateActionLink(ctx, email, domain.ActionModeResetPassword)
+}
+
+func (b *baseLocalAuthUtil) GenerateEmailVerificationLink(ctx context.Context, email string) (link string, err error) {
+	return b.generateActionLink(ctx, email, domain.ActionModeVerifyEmail)
+}
+
+func (b *baseLocalAuthUtil) ResetPassword(ctx context.Context, code, newPassword string) (email string, err error) {
+	credential, _, err := b.useActionToken(ctx, code, domain.ActionModeResetPassword)
+	if err != nil {
+		return "", err
+	}
+
+	passwordHash, err := b.hashUtil.HashPassword(newPassword)
+	if err != nil {
+		return "", err
+	}
+	err = b.credentialRepository.UpdatePasswordByUID(ctx, credential.UID, passwordHash, time.Now().UTC())
+	if err != nil {
+		return "", err
+	}
+
+	return credential.Email, nil
+}
+
+// VerifyEmail rejects the code when the email has changed since the link was sent.
+func (b *baseLocalAuthUtil) VerifyEmail(ctx context.Context, code string) (email string, err error) {
+	credential, claims, err := b.useActionToken(ctx, code, domain.ActionModeVerifyEmail)
+	if err != nil {
+		return "", err
+	}
+	if credential.Email != claims.Email {
+		return "", domain.ErrInvalidActionCode
+	}
+
+	return credential.Email, nil
+}
+
+// generateActionLink signs a single use token, only its ID is stored so it can be marked as used.
+func (b *baseLocalAuthUtil) generateActionLink(ctx context.Context, email, mode string) (string, error) {
+	credential, err := b.credentialRepository.GetCredentialByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	if credential == nil {
+		return "", errors.New("credential not found")
+	}
+
+	metadata := GenerateMetadata()
+	actionTokenPayload := &domain.ActionTokenRepositoryPayloadCreateActionToken{
+		UID:           metadata.UID(),
+		CredentialUID: credential.UID,
+		Mode:          mode,
+		ExpiresAt:     metadata.CreatedAt.Add(actionTokenTTL[mode]),
+		CreatedAt:     metadata.CreatedAt,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &actionTokenClaims{
+		Email: credential.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        actionTokenPayload.UID,
+			Subject:   credential.UID,
+			Audience:  jwt.ClaimStrings{mode},
+			IssuedAt:  jwt.NewNumericDate(actionTokenPayload.CreatedAt),
+			ExpiresAt: jwt.NewNumericDate(actionTokenPayload.ExpiresAt),
+		},
+	})
+	tokenString, err := token.SignedString([]byte(b.env.AuthActionTokenSecret))
+	if err != nil {
+		return "", err
+	}
+	err = b.actionTokenRepository.CreateActionToken(ctx, actionTokenPayload)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("mode", mode)
+	query.Set("oobCode", tokenString)
+
+	return b.env.AuthActionURL + "?" + query.Encode(), nil
+}
+
+func (b *baseLocalAuthUtil) useActionToken(ctx context.Context, code, mode string) (*domain.CredentialModel, *actionTokenClaims, error) {
+	claims := &actionTokenClaims{}
+	token, err := jwt.ParseWithClaims(code, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+
+		return []byte(b.env.AuthActionTokenSecret), nil
+	})
+	if err != nil || !token.Valid || !claims.VerifyAudience(mode, true) {
+		return nil, nil, domain.ErrInvalidActionCode
+	}
+
+	err = b.actionTokenRepository.UseActionToken(ctx, claims.ID, mode, time.Now().UTC())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	credential, err := b.credentialRepository.GetCredentialByUID(ctx, claims.Subject)
+	if err != nil {
+		return nil, nil, err
+	}
+	if credential == nil {
+		return nil, nil, domain.ErrInvalidActionCode
+	}
+
+	return credential, claims, nil
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -21,3 +24,9 @@ func (b *baseHashUtil) ValidatePassword(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// HashToken uses SHA-256 instead of bcrypt, tokens are random enough and have to be looked up by their hash.
+func (b *baseHashUtil) HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
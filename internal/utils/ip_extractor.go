@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"log"
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+// NewIPExtractor returns the extractor behind c.RealIP, which keys the login lockout and audit logs.
+// X-Forwarded-For is only read when the request comes from one of the comma separated TRUSTED_PROXIES
+// ranges, otherwise the address of the connection is used so clients can't pick their own IP.
+func NewIPExtractor(env *domain.Env) echo.IPExtractor {
+	if env.TrustedProxies == "" {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, cidr := range strings.Split(env.TrustedProxies, ",") {
+		_, ipRange, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			log.Fatalf("TRUSTED_PROXIES must be a comma separated list of CIDR ranges, got %q", cidr)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
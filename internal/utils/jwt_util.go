@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -15,15 +18,29 @@ type baseJWTUtil struct {
 	refreshTokenExpiryHour int
 }
 
+// NewJWTUtil refuses to start without both secrets, jwt signs and verifies with an empty key, and with the
+// same secret for both tokens, which would let a leaked refresh token secret sign access tokens.
 func NewJWTUtil(accessTokenSecretKey, refreshTokenSecretKey []byte, accessTokenExpiryHour, refreshTokenExpiryHour int) domain.JWTUtil {
+	if len(accessTokenSecretKey) == 0 || len(refreshTokenSecretKey) == 0 {
+		log.Fatal("ACCESS_TOKEN_SECRET and REFRESH_TOKEN_SECRET are required")
+	}
+	if bytes.Equal(accessTokenSecretKey, refreshTokenSecretKey) {
+		log.Fatal("ACCESS_TOKEN_SECRET and REFRESH_TOKEN_SECRET must be different")
+	}
+
 	return &baseJWTUtil{accessTokenSecretKey: accessTokenSecretKey, refreshTokenSecretKey: refreshTokenSecretKey, accessTokenExpiryHour: accessTokenExpiryHour, refreshTokenExpiryHour: refreshTokenExpiryHour}
 }
 
-func (b *baseJWTUtil) generateToken(userUID string, tokenSecretKey []byte, tokenExpiryHour int) (string, time.Time, error) {
-	expirationTime := time.Now().Add(time.Duration(tokenExpiryHour) * time.Hour)
+func (b *baseJWTUtil) generateToken(userUID, tokenType string, tokenSecretKey []byte, tokenExpiryHour int) (string, time.Time, error) {
+	now := time.Now()
+	expirationTime := now.Add(time.Duration(tokenExpiryHour) * time.Hour)
 	claims := &domain.JWTAccessTokenClaims{
-		UserUID: userUID,
+		UserUID:   userUID,
+		TokenType: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
+			// ID keeps tokens of the same user issued within the same second unique
+			ID:        GenerateMetadata().UID(),
+			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
@@ -38,7 +55,7 @@ func (b *baseJWTUtil) generateToken(userUID string, tokenSecretKey []byte, token
 }
 
 func (b *baseJWTUtil) GenerateAccessToken(userUID string) (string, time.Time, error) {
-	token, expirationTime, err := b.generateToken(userUID, b.accessTokenSecretKey, b.accessTokenExpiryHour)
+	token, expirationTime, err := b.generateToken(userUID, domain.JWTTokenTypeAccess, b.accessTokenSecretKey, b.accessTokenExpiryHour)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -47,7 +64,7 @@ func (b *baseJWTUtil) GenerateAccessToken(userUID string) (string, time.Time, er
 }
 
 func (b *baseJWTUtil) GenerateRefreshToken(userUID string) (string, time.Time, error) {
-	token, expirationTime, err := b.generateToken(userUID, b.refreshTokenSecretKey, b.refreshTokenExpiryHour)
+	token, expirationTime, err := b.generateToken(userUID, domain.JWTTokenTypeRefresh, b.refreshTokenSecretKey, b.refreshTokenExpiryHour)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -56,55 +73,54 @@ func (b *baseJWTUtil) GenerateRefreshToken(userUID string) (string, time.Time, e
 }
 
 func (b *baseJWTUtil) ParseUserUID(tokenString string, isAccessToken bool) (string, error) {
+	claims, err := b.ParseClaims(tokenString, isAccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.UserUID, nil
+}
+
+func (b *baseJWTUtil) ParseClaims(tokenString string, isAccessToken bool) (*domain.JWTAccessTokenClaims, error) {
 	claims := &domain.JWTAccessTokenClaims{}
+	tokenType, secretKey := domain.JWTTokenTypeRefresh, b.refreshTokenSecretKey
+	if isAccessToken {
+		tokenType, secretKey = domain.JWTTokenTypeAccess, b.accessTokenSecretKey
+	}
 
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
-		if isAccessToken {
-			return b.accessTokenSecretKey, nil
+		// Only HS256 is issued, accepting what the token header asks for would allow none or a key confusion.
+		if t.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %s", t.Header["alg"])
 		}
 
-		return b.refreshTokenSecretKey, nil
+		return secretKey, nil
 	})
 	if err != nil {
 		if err == jwt.ErrSignatureInvalid {
-			return "", errors.New("invalid token signature")
+			return nil, errors.New("invalid token signature")
 		}
 
-		return "", err
+		return nil, err
 	}
 	if !token.Valid {
-		return "", err
+		return nil, errors.New("invalid token")
 	}
-
-	if time.Until(claims.ExpiresAt.Time) < 30*time.Second {
-		return "", errors.New("token already expired")
+	if claims.TokenType != tokenType {
+		return nil, errors.New("invalid token type")
+	}
+	if claims.ExpiresAt == nil || time.Until(claims.ExpiresAt.Time) < 30*time.Second {
+		return nil, errors.New("token already expired")
 	}
 
-	return claims.UserUID, nil
+	return claims, nil
 }
 
 func (b *baseJWTUtil) Refresh(refreshToken string) (string, time.Time, error) {
-	// Validate
-	claims := &domain.JWTAccessTokenClaims{}
-	token, err := jwt.ParseWithClaims(refreshToken, claims, func(t *jwt.Token) (interface{}, error) {
-		return b.refreshTokenSecretKey, nil
-	})
-	if err != nil {
-		if err == jwt.ErrSignatureInvalid {
-			return "", time.Time{}, errors.New("invalid token signature")
-		}
-
-		return "", time.Time{}, err
-	}
-	if !token.Valid {
-		return "", time.Time{}, err
-	}
-
-	// Generate
-	accessToken, expirationTime, err := b.generateToken(claims.UserUID, b.accessTokenSecretKey, b.accessTokenExpiryHour)
+	claims, err := b.ParseClaims(refreshToken, false)
 	if err != nil {
 		return "", time.Time{}, err
 	}
 
-	return accessToken, expirationTime, nil
+	return b.GenerateAccessToken(claims.UserUID)
 }
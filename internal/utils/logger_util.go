@@ -14,7 +14,7 @@ type loggerUtil struct {
 
 func NewLoggerUtil(env *domain.Env) domain.LoggerUtil {
 	logger := logrus.New()
-	if env.AppEnv != "prod" {
+	if !env.IsProduction() {
 		logger.SetFormatter(&logrus.TextFormatter{FullTimestamp: true, TimestampFormat: "2006-01-02 15:04:05"})
 		logger.SetLevel(logrus.DebugLevel)
 	} else {
@@ -48,7 +48,7 @@ func (b *loggerUtil) Debugf(format string, args ...interface{}) {
 }
 
 func (b *loggerUtil) Infoln(args ...interface{}) {
-	b.logger.Infoln(args)
+	b.logger.Infoln(args...)
 }
 
 func (b *loggerUtil) Infof(format string, args ...interface{}) {
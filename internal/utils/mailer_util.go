@@ -0,0 +1,14 @@
+package utils
+
+import (
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+// NewMailerUtil returns the mailer selected by MAILER, defaulting to the file mailer.
+func NewMailerUtil(env *domain.Env, loggerUtil domain.LoggerUtil) domain.MailerUtil {
+	if env.Mailer == domain.MailerSMTP {
+		return NewSMTPMailerUtil(env)
+	}
+
+	return NewFileMailerUtil(env.MailDir, env.MailFrom, loggerUtil)
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lucsky/cuid"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseFileMailerUtil struct {
+	dir        string
+	from       string
+	loggerUtil domain.LoggerUtil
+}
+
+// NewFileMailerUtil writes every mail as an .eml file to dir for local testing,
+// mails are only logged when dir is empty.
+func NewFileMailerUtil(dir, from string, loggerUtil domain.LoggerUtil) domain.MailerUtil {
+	return &baseFileMailerUtil{dir: dir, from: from, loggerUtil: loggerUtil}
+}
+
+func (b *baseFileMailerUtil) SendMail(ctx context.Context, mail *domain.Mail) error {
+	if b.dir == "" {
+		b.loggerUtil.Infof("mail to %s with subject %q:\n%s", mail.To, mail.Subject, mail.Body)
+		return nil
+	}
+
+	err := os.MkdirAll(b.dir, 0o755)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(b.dir, fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102150405"), cuid.New()))
+	err = os.WriteFile(path, buildMessage(b.from, mail), 0o644)
+	if err != nil {
+		return err
+	}
+	b.loggerUtil.Infof("mail to %s written to %s", mail.To, path)
+
+	return nil
+}
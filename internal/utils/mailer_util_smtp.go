@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseSMTPMailerUtil struct {
+	env *domain.Env
+}
+
+func NewSMTPMailerUtil(env *domain.Env) domain.MailerUtil {
+	return &baseSMTPMailerUtil{env: env}
+}
+
+func (b *baseSMTPMailerUtil) SendMail(ctx context.Context, mail *domain.Mail) error {
+	var auth smtp.Auth
+	if b.env.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", b.env.SMTPUsername, b.env.SMTPPassword, b.env.SMTPHost)
+	}
+
+	addr := net.JoinHostPort(b.env.SMTPHost, b.env.SMTPPort)
+	err := smtp.SendMail(addr, auth, b.env.MailFrom, []string{mail.To}, buildMessage(b.env.MailFrom, mail))
+	if err != nil {
+		return fmt.Errorf("send mail to %s: %w", mail.To, err)
+	}
+
+	return nil
+}
+
+// buildMessage returns an RFC 5322 plain text message, header values are stripped of line breaks.
+func buildMessage(from string, mail *domain.Mail) []byte {
+	stripper := strings.NewReplacer("\r", "", "\n", "")
+
+	var msg strings.Builder
+	msg.WriteString("From: " + stripper.Replace(from) + "\r\n")
+	msg.WriteString("To: " + stripper.Replace(mail.To) + "\r\n")
+	msg.WriteString("Subject: " + stripper.Replace(mail.Subject) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+
+	return []byte(msg.String())
+}
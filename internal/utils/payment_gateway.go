@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+// NewPaymentGateway returns the gateway selected by PAYMENT_GATEWAY. The webhook is public, so the simulator,
+// which lets anyone holding PAYMENT_SIMULATOR_SECRET mark an order as paid, is refused in production.
+func NewPaymentGateway(env *domain.Env) domain.PaymentGateway {
+	switch env.PaymentGateway {
+	case domain.PaymentGatewayMidtrans:
+		if env.MidtransServerKey == "" {
+			log.Fatal("MIDTRANS_SERVER_KEY is required when PAYMENT_GATEWAY is midtrans")
+		}
+		return NewMidtransPaymentGateway(env, &http.Client{Timeout: 30 * time.Second})
+	case domain.PaymentGatewaySimulator:
+		if env.IsProduction() {
+			log.Fatal("PAYMENT_GATEWAY simulator can't be used in production")
+		}
+		if env.PaymentSimulatorSecret == "" {
+			log.Fatal("PAYMENT_SIMULATOR_SECRET is required when PAYMENT_GATEWAY is simulator")
+		}
+		return NewSimulatorPaymentGateway(env)
+	default:
+		log.Fatalf("PAYMENT_GATEWAY must be %s or %s, got %q", domain.PaymentGatewayMidtrans, domain.PaymentGatewaySimulator, env.PaymentGateway)
+		return nil
+	}
+}
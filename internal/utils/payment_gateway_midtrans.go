@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+const midtransDefaultBaseURL = "https://api.sandbox.midtrans.com"
+
+// Midtrans returns expiry times in Western Indonesia Time without an offset.
+var midtransLocation = time.FixedZone("WIB", 7*60*60)
+
+type midtransResponse struct {
+	StatusCode        string `json:"status_code"`
+	StatusMessage     string `json:"status_message"`
+	TransactionID     string `json:"transaction_id"`
+	OrderID           string `json:"order_id"`
+	GrossAmount       string `json:"gross_amount"`
+	TransactionStatus string `json:"transaction_status"`
+	FraudStatus       string `json:"fraud_status"`
+	SignatureKey      string `json:"signature_key"`
+	PermataVANumber   string `json:"permata_va_number"`
+	QRString          string `json:"qr_string"`
+	ExpiryTime        string `json:"expiry_time"`
+	VANumbers         []struct {
+		Bank     string `json:"bank"`
+		VANumber string `json:"va_number"`
+	} `json:"va_numbers"`
+	Actions []struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"actions"`
+}
+
+type baseMidtransPaymentGateway struct {
+	baseURL    string
+	serverKey  string
+	httpClient *http.Client
+}
+
+// NewMidtransPaymentGateway returns a gateway backed by the Midtrans Core API. Amounts are sent
+// as whole rupiah, which is what Midtrans expects for IDR.
+func NewMidtransPaymentGateway(env *domain.Env, httpClient *http.Client) domain.PaymentGateway {
+	baseURL := env.MidtransBaseURL
+	if baseURL == "" {
+		baseURL = midtransDefaultBaseURL
+	}
+
+	return &baseMidtransPaymentGateway{
+		baseURL:    baseURL,
+		serverKey:  env.MidtransServerKey,
+		httpClient: httpClient,
+	}
+}
+
+func (b *baseMidtransPaymentGateway) Name() string {
+	return domain.PaymentGatewayMidtrans
+}
+
+func (b *baseMidtransPaymentGateway) do(ctx context.Context, method, path string, reqBody interface{}) (*midtransResponse, error) {
+	var body io.Reader
+	if reqBody != nil {
+		reqBytes, err := json.Marshal(reqBody)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(reqBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, b.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(b.serverKey, "")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	var midtransRes midtransResponse
+	err = json.Unmarshal(resBytes, &midtransRes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Midtrans reports failures in the body status code, sometimes with HTTP 200.
+	if len(midtransRes.StatusCode) == 0 || midtransRes.StatusCode[0] != '2' {
+		return nil, fmt.Errorf("midtrans error %s: %s", midtransRes.StatusCode, midtransRes.StatusMessage)
+	}
+
+	return &midtransRes, nil
+}
+
+func (b *baseMidtransPaymentGateway) toStatus(transactionStatus, fraudStatus string) string {
+	switch transactionStatus {
+	case "settlement":
+		return domain.PaymentStatusPaid
+	case "capture":
+		if fraudStatus == "accept" || fraudStatus == "" {
+			return domain.PaymentStatusPaid
+		}
+		return domain.PaymentStatusPending
+	case "expire":
+		return domain.PaymentStatusExpired
+	case "deny", "cancel", "failure":
+		return domain.PaymentStatusFailed
+	case "refund", "partial_refund":
+		return domain.PaymentStatusRefunded
+	default:
+		return domain.PaymentStatusPending
+	}
+}
+
+func (b *baseMidtransPaymentGateway) toCharge(res *midtransResponse) *domain.PaymentGatewayCharge {
+	charge := &domain.PaymentGatewayCharge{
+		TransactionID: res.TransactionID,
+		ReferenceID:   res.OrderID,
+		Status:        b.toStatus(res.TransactionStatus, res.FraudStatus),
+		QRString:      res.QRString,
+		VANumber:      res.PermataVANumber,
+	}
+	if len(res.VANumbers) > 0 {
+		charge.VANumber = res.VANumbers[0].VANumber
+	}
+	for _, action := range res.Actions {
+		if action.Name == "deeplink-redirect" || (action.Name == "generate-qr-code" && charge.ActionURL == "") {
+			charge.ActionURL = action.URL
+		}
+	}
+	if amount, err := strconv.ParseFloat(res.GrossAmount, 64); err == nil {
+		charge.Amount = int(math.Round(amount))
+	}
+	if expiresAt, err := time.ParseInLocation("2006-01-02 15:04:05", res.ExpiryTime, midtransLocation); err == nil {
+		expiresAt = expiresAt.UTC()
+		charge.ExpiresAt = &expiresAt
+	}
+
+	return charge
+}
+
+func (b *baseMidtransPaymentGateway) CreateCharge(ctx context.Context, payload *domain.PaymentGatewayPayloadCreateCharge) (*domain.PaymentGatewayCharge, error) {
+	items := []map[string]interface{}{}
+	for _, item := range payload.Items {
+		items = append(items, map[string]interface{}{
+			"id":       item.ID,
+			"name":     item.Name,
+			"price":    item.Price,
+			"quantity": item.Quantity,
+		})
+	}
+	reqBody := map[string]interface{}{
+		"transaction_details": map[string]interface{}{
+			"order_id":     payload.ReferenceID,
+			"gross_amount": payload.Amount,
+		},
+		"item_details": items,
+		"customer_details": map[string]string{
+			"first_name": payload.CustomerName,
+			"email":      payload.CustomerEmail,
+			"phone":      payload.CustomerPhone,
+		},
+		"custom_expiry": map[string]interface{}{
+			"expiry_duration": 24,
+			"unit":            "hour",
+		},
+	}
+
+	switch payload.Method {
+	case domain.PaymentMethodVirtualAccount:
+		if _, ok := vaPrefixes[payload.Channel]; !ok {
+			return nil, domain.ErrUnsupportedPaymentChannel
+		}
+		reqBody["payment_type"] = "bank_transfer"
+		reqBody["bank_transfer"] = map[string]string{"bank": payload.Channel}
+	case domain.PaymentMethodQRIS:
+		reqBody["payment_type"] = "qris"
+	case domain.PaymentMethodEWallet:
+		if payload.Channel != "gopay" && payload.Channel != "shopeepay" {
+			return nil, domain.ErrUnsupportedPaymentChannel
+		}
+		reqBody["payment_type"] = payload.Channel
+	default:
+		return nil, domain.ErrUnsupportedPaymentChannel
+	}
+
+	res, err := b.do(ctx, http.MethodPost, "/v2/charge", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toCharge(res), nil
+}
+
+func (b *baseMidtransPaymentGateway) GetChargeStatus(ctx context.Context, transactionID string) (*domain.PaymentGatewayCharge, error) {
+	res, err := b.do(ctx, http.MethodGet, "/v2/"+transactionID+"/status", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toCharge(res), nil
+}
+
+// VerifyWebhook checks signature_key, which Midtrans computes as
+// SHA512(order_id + status_code + gross_amount + server_key).
+func (b *baseMidtransPaymentGateway) VerifyWebhook(ctx context.Context, header http.Header, body []byte) (*domain.PaymentGatewayNotification, error) {
+	var notification midtransResponse
+	err := json.Unmarshal(body, &notification)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha512.Sum512([]byte(notification.OrderID + notification.StatusCode + notification.GrossAmount + b.serverKey))
+	expected := hex.EncodeToString(hash[:])
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(notification.SignatureKey)) != 1 {
+		return nil, domain.ErrInvalidWebhookSignature
+	}
+
+	charge := b.toCharge(&notification)
+
+	return &domain.PaymentGatewayNotification{
+		TransactionID: charge.TransactionID,
+		ReferenceID:   charge.ReferenceID,
+		Status:        charge.Status,
+		Amount:        charge.Amount,
+	}, nil
+}
+
+func (b *baseMidtransPaymentGateway) Refund(ctx context.Context, payload *domain.PaymentGatewayPayloadRefund) error {
+	_, err := b.do(ctx, http.MethodPost, "/v2/"+payload.TransactionID+"/refund", map[string]interface{}{
+		"refund_key": payload.ReferenceID + "-refund",
+		"amount":     payload.Amount,
+		"reason":     payload.Reason,
+	})
+
+	return err
+}
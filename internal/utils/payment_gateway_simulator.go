@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lucsky/cuid"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+const simulatorSignatureHeader = "X-Simulator-Signature"
+
+// vaPrefixes mimics the bank company codes Indonesian gateways prepend to virtual account numbers.
+var vaPrefixes = map[string]string{
+	"bca":     "70012",
+	"bni":     "8808",
+	"bri":     "26215",
+	"permata": "8778",
+}
+
+type simulatorNotification struct {
+	TransactionID string `json:"transaction_id"`
+	ReferenceID   string `json:"reference_id"`
+	Status        string `json:"status"`
+	Amount        int    `json:"amount"`
+}
+
+type baseSimulatorPaymentGateway struct {
+	secret  []byte
+	mu      sync.Mutex
+	charges map[string]*domain.PaymentGatewayCharge
+}
+
+// NewSimulatorPaymentGateway returns an in-process gateway that keeps charges in memory and signs
+// its webhook notifications with PAYMENT_SIMULATOR_SECRET. It is meant for local development and tests.
+func NewSimulatorPaymentGateway(env *domain.Env) domain.PaymentGateway {
+	return &baseSimulatorPaymentGateway{
+		secret:  []byte(env.PaymentSimulatorSecret),
+		charges: map[string]*domain.PaymentGatewayCharge{},
+	}
+}
+
+func (b *baseSimulatorPaymentGateway) Name() string {
+	return domain.PaymentGatewaySimulator
+}
+
+func (b *baseSimulatorPaymentGateway) CreateCharge(ctx context.Context, payload *domain.PaymentGatewayPayloadCreateCharge) (*domain.PaymentGatewayCharge, error) {
+	expiresAt := time.Now().UTC().Add(24 * time.Hour)
+	charge := &domain.PaymentGatewayCharge{
+		TransactionID: "sim-" + cuid.New(),
+		ReferenceID:   payload.ReferenceID,
+		Status:        domain.PaymentStatusPending,
+		Amount:        payload.Amount,
+		ExpiresAt:     &expiresAt,
+	}
+
+	switch payload.Method {
+	case domain.PaymentMethodVirtualAccount:
+		prefix, ok := vaPrefixes[payload.Channel]
+		if !ok {
+			return nil, domain.ErrUnsupportedPaymentChannel
+		}
+		number, err := rand.Int(rand.Reader, big.NewInt(1_000_000_000))
+		if err != nil {
+			return nil, err
+		}
+		charge.VANumber = fmt.Sprintf("%s%09d", prefix, number.Int64())
+	case domain.PaymentMethodQRIS:
+		charge.QRString = fmt.Sprintf("SIMULATOR-QRIS-%s-%d", charge.TransactionID, payload.Amount)
+	case domain.PaymentMethodEWallet:
+		charge.ActionURL = fmt.Sprintf("simulator://%s/pay/%s", payload.Channel, charge.TransactionID)
+	default:
+		return nil, domain.ErrUnsupportedPaymentChannel
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.charges[charge.TransactionID] = charge
+	res := *charge
+
+	return &res, nil
+}
+
+func (b *baseSimulatorPaymentGateway) GetChargeStatus(ctx context.Context, transactionID string) (*domain.PaymentGatewayCharge, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	charge, ok := b.charges[transactionID]
+	if !ok {
+		return nil, domain.ErrPaymentNotFound
+	}
+	res := *charge
+
+	return &res, nil
+}
+
+func (b *baseSimulatorPaymentGateway) sign(body []byte) string {
+	mac := hmac.New(sha256.New, b.secret)
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (b *baseSimulatorPaymentGateway) VerifyWebhook(ctx context.Context, header http.Header, body []byte) (*domain.PaymentGatewayNotification, error) {
+	// An empty key would make every signature computable by anyone.
+	if len(b.secret) == 0 {
+		return nil, domain.ErrInvalidWebhookSignature
+	}
+	signature, err := hex.DecodeString(header.Get(simulatorSignatureHeader))
+	if err != nil {
+		return nil, domain.ErrInvalidWebhookSignature
+	}
+	expected, _ := hex.DecodeString(b.sign(body))
+	if !hmac.Equal(signature, expected) {
+		return nil, domain.ErrInvalidWebhookSignature
+	}
+
+	var notification simulatorNotification
+	err = json.Unmarshal(body, &notification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentGatewayNotification{
+		TransactionID: notification.TransactionID,
+		ReferenceID:   notification.ReferenceID,
+		Status:        notification.Status,
+		Amount:        notification.Amount,
+	}, nil
+}
+
+func (b *baseSimulatorPaymentGateway) Refund(ctx context.Context, payload *domain.PaymentGatewayPayloadRefund) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	charge, ok := b.charges[payload.TransactionID]
+	if !ok {
+		return domain.ErrPaymentNotFound
+	}
+	if charge.Status != domain.PaymentStatusPaid {
+		return domain.ErrPaymentNotRefundable
+	}
+	charge.Status = domain.PaymentStatusRefunded
+
+	return nil
+}
+
+func (b *baseSimulatorPaymentGateway) Simulate(ctx context.Context, transactionID, status string) (http.Header, []byte, error) {
+	b.mu.Lock()
+	charge, ok := b.charges[transactionID]
+	if !ok {
+		b.mu.Unlock()
+		return nil, nil, domain.ErrPaymentNotFound
+	}
+	charge.Status = status
+	notification := simulatorNotification{
+		TransactionID: charge.TransactionID,
+		ReferenceID:   charge.ReferenceID,
+		Status:        charge.Status,
+		Amount:        charge.Amount,
+	}
+	b.mu.Unlock()
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return nil, nil, err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(simulatorSignatureHeader, b.sign(body))
+
+	return header, body, nil
+}
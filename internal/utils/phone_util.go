@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+var indonesianPhoneRegex = regexp.MustCompile(`^\+628[1-9][0-9]{7,10}$`)
+
+// NormalizeIndonesianPhone accepts 08xx, 628xx and +628xx numbers with spaces or dashes and returns them as +628xx.
+func NormalizeIndonesianPhone(phone string) (string, error) {
+	phone = strings.NewReplacer(" ", "", "-", "").Replace(phone)
+	switch {
+	case strings.HasPrefix(phone, "0"):
+		phone = "+62" + phone[1:]
+	case strings.HasPrefix(phone, "62"):
+		phone = "+" + phone
+	}
+
+	if !indonesianPhoneRegex.MatchString(phone) {
+		return "", domain.ErrInvalidPhone
+	}
+
+	return phone, nil
+}
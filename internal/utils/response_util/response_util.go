@@ -1,11 +1,15 @@
 package response_util
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
 )
 
 type ValidationError struct {
@@ -21,6 +25,11 @@ type Response struct {
 	Error            string            `json:"error,omitempty"`
 	BindingError     string            `json:"binding_error,omitempty"`
 	ValidationErrors []ValidationError `json:"validation_errors,omitempty"`
+	// ErrorCode is a stable machine-readable code of Error, ErrorField is the payload field Error is about.
+	ErrorCode  string `json:"error_code,omitempty"`
+	ErrorField string `json:"error_field,omitempty"`
+
+	err error
 }
 
 func FromOK() *Response {
@@ -37,6 +46,30 @@ func FromCreated() *Response {
 	}
 }
 
+func FromCreatedData(data interface{}) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusCreated),
+		Code:   http.StatusCreated,
+		Data:   data,
+	}
+}
+
+func FromAcceptedData(data interface{}) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusAccepted),
+		Code:   http.StatusAccepted,
+		Data:   data,
+	}
+}
+
+func FromServiceUnavailableData(data interface{}) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusServiceUnavailable),
+		Code:   http.StatusServiceUnavailable,
+		Data:   data,
+	}
+}
+
 func FromData(data interface{}) *Response {
 	return &Response{
 		Status: http.StatusText(http.StatusOK),
@@ -45,19 +78,59 @@ func FromData(data interface{}) *Response {
 	}
 }
 
+// errorKinds maps the kinds of domain errors to a status code. ErrorCode is the code of the kind unless the
+// error is a *domain.Error with its own code.
+var errorKinds = []struct {
+	kind   error
+	status int
+	code   string
+}{
+	{domain.ErrNotFound, http.StatusNotFound, "NOT_FOUND"},
+	{domain.ErrConflict, http.StatusConflict, "CONFLICT"},
+	{domain.ErrValidation, http.StatusBadRequest, "VALIDATION_ERROR"},
+	{domain.ErrForbidden, http.StatusForbidden, "FORBIDDEN"},
+	{domain.ErrUnauthenticated, http.StatusUnauthorized, "UNAUTHENTICATED"},
+	{domain.ErrTooManyRequests, http.StatusTooManyRequests, "TOO_MANY_REQUESTS"},
+	{context.DeadlineExceeded, http.StatusServiceUnavailable, "TIMEOUT"},
+}
+
+// FromError maps a domain error to its status code. Any other error is unexpected, it is kept on the
+// response so WithEcho leaves it to the HTTP error handler.
 func FromError(err error) *Response {
-	if strings.Contains(strings.ToLower(err.Error()), "not found") {
-		return &Response{
-			Status: http.StatusText(http.StatusNotFound),
-			Code:   http.StatusNotFound,
-			Error:  err.Error(),
+	for _, errorKind := range errorKinds {
+		if !errors.Is(err, errorKind.kind) {
+			continue
+		}
+
+		res := &Response{
+			Status:    http.StatusText(errorKind.status),
+			Code:      errorKind.status,
+			Error:     err.Error(),
+			ErrorCode: errorKind.code,
+		}
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) {
+			res.ErrorCode = domainErr.Code
+			res.ErrorField = domainErr.Field
 		}
+
+		return res
 	}
 
 	return &Response{
-		Status: http.StatusText(http.StatusInternalServerError),
-		Code:   http.StatusInternalServerError,
-		Error:  err.Error(),
+		Status:    http.StatusText(http.StatusInternalServerError),
+		Code:      http.StatusInternalServerError,
+		Error:     err.Error(),
+		ErrorCode: "INTERNAL_ERROR",
+		err:       err,
+	}
+}
+
+func FromHTTPError(err *echo.HTTPError) *Response {
+	return &Response{
+		Status: http.StatusText(err.Code),
+		Code:   err.Code,
+		Error:  fmt.Sprint(err.Message),
 	}
 }
 
@@ -105,6 +178,22 @@ func FromBadRequestError(err error) *Response {
 	}
 }
 
+func FromUnauthorizedError(err error) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusUnauthorized),
+		Code:   http.StatusUnauthorized,
+		Error:  err.Error(),
+	}
+}
+
+func FromTooManyRequestsError(err error) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusTooManyRequests),
+		Code:   http.StatusTooManyRequests,
+		Error:  err.Error(),
+	}
+}
+
 func FromForbiddenError(err error) *Response {
 	return &Response{
 		Status: http.StatusText(http.StatusForbidden),
@@ -113,6 +202,22 @@ func FromForbiddenError(err error) *Response {
 	}
 }
 
+func FromConflictError(err error) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusConflict),
+		Code:   http.StatusConflict,
+		Error:  err.Error(),
+	}
+}
+
+func FromServiceUnavailableError(err error) *Response {
+	return &Response{
+		Status: http.StatusText(http.StatusServiceUnavailable),
+		Code:   http.StatusServiceUnavailable,
+		Error:  err.Error(),
+	}
+}
+
 func FromNotFoundError(err error) *Response {
 	if err != nil {
 		return &Response{
@@ -131,7 +236,7 @@ func FromNotFoundError(err error) *Response {
 func FromInternalServerError() *Response {
 	return &Response{
 		Status: http.StatusText(http.StatusInternalServerError),
-		Code:   http.StatusNotFound,
+		Code:   http.StatusInternalServerError,
 	}
 }
 
@@ -142,6 +247,12 @@ func (r *Response) WithCode(code int) *Response {
 	}
 }
 
+// WithEcho writes the response. An unexpected error is returned instead, the HTTP error handler logs it and
+// hides its message in production.
 func (r *Response) WithEcho(c echo.Context) error {
+	if r.err != nil {
+		return r.err
+	}
+
 	return c.JSON(r.Code, r)
 }
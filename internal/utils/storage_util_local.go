@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseLocalStorageUtil struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStorageUtil writes files below dir, they are expected to be served from baseURL.
+func NewLocalStorageUtil(dir, baseURL string) domain.StorageUtil {
+	return &baseLocalStorageUtil{dir: dir, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (b *baseLocalStorageUtil) Upload(ctx context.Context, key, contentType string, body io.Reader) (string, error) {
+	path := filepath.Join(b.dir, filepath.FromSlash(key))
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, body)
+	if err != nil {
+		return "", err
+	}
+
+	return b.baseURL + "/" + key, nil
+}
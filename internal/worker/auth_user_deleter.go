@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseAuthUserDeleter struct {
+	accountUsecase domain.AccountUsecase
+	loggerUtil     domain.LoggerUtil
+	interval       time.Duration
+}
+
+// NewAuthUserDeleter returns a worker that retries the auth provider deletions of deleted accounts every interval.
+func NewAuthUserDeleter(accountUsecase domain.AccountUsecase, loggerUtil domain.LoggerUtil, interval time.Duration) domain.Worker {
+	return &baseAuthUserDeleter{
+		accountUsecase: accountUsecase,
+		loggerUtil:     loggerUtil,
+		interval:       interval,
+	}
+}
+
+func (b *baseAuthUserDeleter) Name() string {
+	return "auth user deleter"
+}
+
+func (b *baseAuthUserDeleter) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := b.accountUsecase.DeleteQueuedAuthUsers(ctx)
+			if err != nil && ctx.Err() == nil {
+				b.loggerUtil.Errorf("failed to delete queued auth users: %s", err)
+			}
+		}
+	}
+}
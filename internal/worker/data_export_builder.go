@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseDataExportBuilder struct {
+	accountUsecase domain.AccountUsecase
+	loggerUtil     domain.LoggerUtil
+	interval       time.Duration
+}
+
+// NewDataExportBuilder returns a worker that builds the pending data exports every interval. An export being
+// built when the worker is stopped is marked as failed.
+func NewDataExportBuilder(accountUsecase domain.AccountUsecase, loggerUtil domain.LoggerUtil, interval time.Duration) domain.Worker {
+	return &baseDataExportBuilder{
+		accountUsecase: accountUsecase,
+		loggerUtil:     loggerUtil,
+		interval:       interval,
+	}
+}
+
+func (b *baseDataExportBuilder) Name() string {
+	return "data export builder"
+}
+
+func (b *baseDataExportBuilder) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := b.accountUsecase.BuildPendingDataExports(ctx)
+			if err != nil && ctx.Err() == nil {
+				b.loggerUtil.Errorf("failed to build pending data exports: %s", err)
+			}
+		}
+	}
+}
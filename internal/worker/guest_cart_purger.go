@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseGuestCartPurger struct {
+	cartRepository domain.CartRepository
+	loggerUtil     domain.LoggerUtil
+	maxAge         time.Duration
+	interval       time.Duration
+}
+
+// NewGuestCartPurger returns a worker that deletes guest carts not updated within maxAge every interval.
+func NewGuestCartPurger(cartRepository domain.CartRepository, loggerUtil domain.LoggerUtil, maxAge, interval time.Duration) domain.Worker {
+	return &baseGuestCartPurger{
+		cartRepository: cartRepository,
+		loggerUtil:     loggerUtil,
+		maxAge:         maxAge,
+		interval:       interval,
+	}
+}
+
+func (b *baseGuestCartPurger) Name() string {
+	return "guest cart purger"
+}
+
+func (b *baseGuestCartPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			deleted, err := b.cartRepository.DeleteGuestCartsUpdatedBefore(ctx, now.Add(-b.maxAge))
+			if err != nil {
+				b.loggerUtil.Errorf("failed to delete abandoned guest carts: %s", err)
+				continue
+			}
+			if deleted > 0 {
+				b.loggerUtil.Infof("deleted %d abandoned guest carts", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseReservationSweeper struct {
+	cartRepository domain.CartRepository
+	loggerUtil     domain.LoggerUtil
+	interval       time.Duration
+}
+
+// NewReservationSweeper returns a worker that releases expired cart stock reservations every interval.
+func NewReservationSweeper(cartRepository domain.CartRepository, loggerUtil domain.LoggerUtil, interval time.Duration) domain.Worker {
+	return &baseReservationSweeper{
+		cartRepository: cartRepository,
+		loggerUtil:     loggerUtil,
+		interval:       interval,
+	}
+}
+
+func (b *baseReservationSweeper) Name() string {
+	return "reservation sweeper"
+}
+
+func (b *baseReservationSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			deleted, err := b.cartRepository.DeleteExpiredReservations(ctx, now)
+			if err != nil {
+				b.loggerUtil.Errorf("failed to delete expired reservations: %s", err)
+				continue
+			}
+			if deleted > 0 {
+				b.loggerUtil.Infof("released %d expired reservations", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE order_items;
+DROP TABLE orders;
+DROP TYPE ORDER_STATUS;
\ No newline at end of file
@@ -0,0 +1,54 @@
+CREATE TYPE ORDER_STATUS
+AS ENUM ('PENDING_PAYMENT', 'PAID', 'PROCESSING', 'SHIPPED', 'DELIVERED', 'CANCELLED', 'REFUNDED');
+
+CREATE TABLE orders (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  status ORDER_STATUS NOT NULL,
+  quantity INT NOT NULL,
+  total_price TEXT NOT NULL,
+  total_price_value BIGINT NOT NULL,
+  total_weight TEXT NOT NULL,
+  total_weight_value NUMERIC(10, 2) NOT NULL,
+  user_id BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL,
+
+  FOREIGN KEY(user_id)
+    REFERENCES users(id)
+);
+
+CREATE INDEX orders_user_id_idx ON orders(user_id);
+
+CREATE TABLE order_items (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  quantity INT NOT NULL,
+  total_price TEXT NOT NULL,
+  total_price_value BIGINT NOT NULL,
+  total_weight TEXT NOT NULL,
+  total_weight_value NUMERIC(10, 2) NOT NULL,
+  product_name TEXT NOT NULL,
+  product_slug TEXT NOT NULL,
+  product_image TEXT NOT NULL,
+  product_weight TEXT NOT NULL,
+  product_weight_value NUMERIC(10, 2) NOT NULL,
+  base_price TEXT NOT NULL,
+  base_price_value INT NOT NULL,
+  offer_price TEXT NOT NULL,
+  offer_price_value INT NOT NULL,
+  discount SMALLINT NOT NULL,
+  order_id BIGINT NOT NULL,
+  product_id BIGINT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL,
+
+  FOREIGN KEY(order_id)
+    REFERENCES orders(id)
+    ON DELETE CASCADE,
+  FOREIGN KEY(product_id)
+    REFERENCES products(id)
+    ON DELETE SET NULL
+);
+
+CREATE INDEX order_items_order_id_idx ON order_items(order_id);
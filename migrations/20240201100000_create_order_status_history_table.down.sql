@@ -0,0 +1 @@
+DROP TABLE order_status_history;
\ No newline at end of file
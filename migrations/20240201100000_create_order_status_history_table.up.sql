@@ -0,0 +1,19 @@
+CREATE TABLE order_status_history (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  from_status ORDER_STATUS,
+  to_status ORDER_STATUS NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  order_id BIGINT NOT NULL,
+  changed_by_user_id BIGINT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY(order_id)
+    REFERENCES orders(id)
+    ON DELETE CASCADE,
+  FOREIGN KEY(changed_by_user_id)
+    REFERENCES users(id)
+    ON DELETE SET NULL
+);
+
+CREATE INDEX order_status_history_order_id_idx ON order_status_history(order_id);
@@ -0,0 +1,2 @@
+DROP TABLE payments;
+DROP TYPE PAYMENT_STATUS;
\ No newline at end of file
@@ -0,0 +1,28 @@
+CREATE TYPE PAYMENT_STATUS
+AS ENUM ('PENDING', 'PAID', 'FAILED', 'EXPIRED', 'REFUNDED');
+
+CREATE TABLE payments (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  gateway TEXT NOT NULL,
+  method TEXT NOT NULL,
+  channel TEXT NOT NULL DEFAULT '',
+  amount BIGINT NOT NULL,
+  currency TEXT NOT NULL DEFAULT 'IDR',
+  status PAYMENT_STATUS NOT NULL,
+  transaction_id TEXT UNIQUE NOT NULL,
+  va_number TEXT,
+  qr_string TEXT,
+  action_url TEXT,
+  expires_at TIMESTAMPTZ,
+  paid_at TIMESTAMPTZ,
+  order_id BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL,
+
+  FOREIGN KEY(order_id)
+    REFERENCES orders(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX payments_order_id_idx ON payments(order_id);
@@ -0,0 +1 @@
+DROP TABLE stock_reservations;
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE stock_reservations (
+  id BIGSERIAL PRIMARY KEY,
+  quantity INT NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  cart_id BIGINT NOT NULL,
+  product_id BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL,
+
+  UNIQUE(cart_id, product_id),
+
+  FOREIGN KEY(cart_id)
+    REFERENCES carts(id)
+    ON DELETE CASCADE,
+
+  FOREIGN KEY(product_id)
+    REFERENCES products(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX stock_reservations_product_id_expires_at_idx ON stock_reservations(product_id, expires_at);
@@ -0,0 +1,3 @@
+ALTER TABLE cart_items
+DROP COLUMN product_status,
+DROP COLUMN price_changed;
\ No newline at end of file
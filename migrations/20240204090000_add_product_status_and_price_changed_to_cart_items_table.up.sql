@@ -0,0 +1,3 @@
+ALTER TABLE cart_items
+ADD COLUMN product_status INVENTORY_STATUS NOT NULL DEFAULT 'ACTIVE',
+ADD COLUMN price_changed BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE cart_items
+DROP CONSTRAINT cart_items_cart_id_product_id_key;
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- Merge existing duplicate lines into the oldest one before adding the constraint.
+-- Formatted totals of merged lines are refreshed on the next change to the line.
+WITH merged AS (
+  SELECT MIN(id) AS id, SUM(quantity) AS quantity, SUM(total_price_value) AS total_price_value, SUM(total_weight_value) AS total_weight_value
+  FROM cart_items
+  GROUP BY cart_id, product_id
+  HAVING COUNT(*) > 1
+)
+UPDATE cart_items
+SET quantity = merged.quantity,
+  total_price_value = merged.total_price_value,
+  total_weight_value = merged.total_weight_value
+FROM merged
+WHERE cart_items.id = merged.id;
+
+DELETE FROM cart_items duplicate
+USING cart_items original
+WHERE duplicate.cart_id = original.cart_id AND duplicate.product_id = original.product_id AND duplicate.id > original.id;
+
+ALTER TABLE cart_items
+ADD CONSTRAINT cart_items_cart_id_product_id_key UNIQUE (cart_id, product_id);
\ No newline at end of file
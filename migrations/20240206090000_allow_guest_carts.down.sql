@@ -0,0 +1,6 @@
+DROP INDEX carts_guest_updated_at_idx;
+
+DELETE FROM carts WHERE user_id IS NULL;
+
+ALTER TABLE carts
+ALTER COLUMN user_id SET NOT NULL;
\ No newline at end of file
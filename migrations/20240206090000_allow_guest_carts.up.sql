@@ -0,0 +1,4 @@
+ALTER TABLE carts
+ALTER COLUMN user_id DROP NOT NULL;
+
+CREATE INDEX carts_guest_updated_at_idx ON carts(updated_at) WHERE user_id IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE credentials;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE credentials (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  email TEXT UNIQUE NOT NULL,
+  password_hash TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL
+);
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE refresh_tokens
+DROP COLUMN ip_address,
+DROP COLUMN user_agent;
+
+ALTER TABLE users
+DROP COLUMN tokens_revoked_at;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE users
+ADD COLUMN tokens_revoked_at TIMESTAMPTZ;
+
+ALTER TABLE refresh_tokens
+ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+ADD COLUMN ip_address TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE admins
+DROP CONSTRAINT admins_uid_key;
+
+DROP TABLE audit_logs;
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE audit_logs (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  action TEXT NOT NULL,
+  actor_user_id BIGINT,
+  target_user_id BIGINT,
+  ip_address TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY(actor_user_id)
+    REFERENCES users(id)
+    ON DELETE SET NULL,
+  FOREIGN KEY(target_user_id)
+    REFERENCES users(id)
+    ON DELETE SET NULL
+);
+
+CREATE INDEX audit_logs_target_user_id_idx ON audit_logs(target_user_id);
+
+ALTER TABLE admins
+ADD CONSTRAINT admins_uid_key UNIQUE (uid);
\ No newline at end of file
@@ -0,0 +1,71 @@
+CREATE TABLE roles (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  name TEXT UNIQUE NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE permissions (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT UNIQUE NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE role_permissions (
+  role_id BIGINT NOT NULL,
+  permission_id BIGINT NOT NULL,
+
+  PRIMARY KEY(role_id, permission_id),
+  FOREIGN KEY(role_id)
+    REFERENCES roles(id)
+    ON DELETE CASCADE,
+  FOREIGN KEY(permission_id)
+    REFERENCES permissions(id)
+    ON DELETE CASCADE
+);
+
+CREATE TABLE user_roles (
+  user_id BIGINT NOT NULL,
+  role_id BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY(user_id, role_id),
+  FOREIGN KEY(user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE,
+  FOREIGN KEY(role_id)
+    REFERENCES roles(id)
+    ON DELETE CASCADE
+);
+
+INSERT INTO permissions (name, description) VALUES
+('product:write', 'Create, update and delete products'),
+('order:read', 'List and view every order'),
+('order:write', 'Update the status of orders'),
+('payment:refund', 'Refund payments of cancelled orders'),
+('admin:manage', 'Grant and revoke the admin role'),
+('role:manage', 'Assign and remove roles of users');
+
+INSERT INTO roles (uid, name, description) VALUES
+('role_super_admin', 'super_admin', 'Every permission'),
+('role_catalog_manager', 'catalog_manager', 'Manages the product catalog'),
+('role_warehouse_staff', 'warehouse_staff', 'Processes and ships orders'),
+('role_customer_support', 'customer_support', 'Looks up orders and refunds payments');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'super_admin';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r JOIN permissions p ON (r.name, p.name) IN (
+  ('catalog_manager', 'product:write'),
+  ('warehouse_staff', 'order:read'),
+  ('warehouse_staff', 'order:write'),
+  ('customer_support', 'order:read'),
+  ('customer_support', 'payment:refund')
+);
+
+INSERT INTO user_roles (user_id, role_id)
+SELECT a.user_id, r.id FROM admins a CROSS JOIN roles r WHERE r.name = 'super_admin';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE addresses;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE addresses (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  user_id BIGINT NOT NULL,
+  label TEXT NOT NULL DEFAULT '',
+  recipient_name TEXT NOT NULL,
+  phone TEXT NOT NULL,
+  street TEXT NOT NULL,
+  province TEXT NOT NULL,
+  city TEXT NOT NULL,
+  district TEXT NOT NULL,
+  sub_district TEXT NOT NULL,
+  postal_code TEXT NOT NULL,
+  is_default BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY(user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX addresses_user_id_idx ON addresses(user_id);
+-- A user has at most one default address
+CREATE UNIQUE INDEX addresses_user_id_default_idx ON addresses(user_id) WHERE is_default;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE action_tokens;
+
+ALTER TABLE users
+DROP COLUMN email_verified_at;
\ No newline at end of file
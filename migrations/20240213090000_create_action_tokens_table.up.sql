@@ -0,0 +1,16 @@
+ALTER TABLE users
+ADD COLUMN email_verified_at TIMESTAMPTZ;
+
+CREATE TABLE action_tokens (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  credential_uid TEXT NOT NULL,
+  mode TEXT NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  used_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY(credential_uid)
+    REFERENCES credentials(uid)
+    ON DELETE CASCADE
+);
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE TABLE login_attempts (
+  key TEXT PRIMARY KEY,
+  failures INT NOT NULL DEFAULT 0,
+  last_failed_at TIMESTAMPTZ NOT NULL,
+  locked_until TIMESTAMPTZ
+);
\ No newline at end of file
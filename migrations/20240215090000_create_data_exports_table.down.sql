@@ -0,0 +1,4 @@
+DROP TABLE data_exports;
+
+ALTER TABLE users
+DROP COLUMN deleted_at;
\ No newline at end of file
@@ -0,0 +1,19 @@
+ALTER TABLE users
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE TABLE data_exports (
+  id BIGSERIAL PRIMARY KEY,
+  uid TEXT UNIQUE NOT NULL,
+  user_id BIGINT NOT NULL,
+  status TEXT NOT NULL,
+  archive JSONB,
+  error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  completed_at TIMESTAMPTZ,
+
+  FOREIGN KEY(user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX data_exports_user_id_idx ON data_exports(user_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE auth_user_deletions;
\ No newline at end of file
@@ -0,0 +1,4 @@
+CREATE TABLE auth_user_deletions (
+  auth_uid TEXT PRIMARY KEY,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
\ No newline at end of file
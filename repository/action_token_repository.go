@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseActionTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewActionTokenRepository(db *sqlx.DB) domain.ActionTokenRepository {
+	return &baseActionTokenRepository{db: db}
+}
+
+func (b *baseActionTokenRepository) CreateActionToken(ctx context.Context, actionTokenPayload *domain.ActionTokenRepositoryPayloadCreateActionToken) error {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO action_tokens (uid, credential_uid, mode, expires_at, created_at)
+	VALUES (:uid, :credential_uid, :mode, :expires_at, :created_at);
+	`, actionTokenPayload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseActionTokenRepository) UseActionToken(ctx context.Context, UID, mode string, usedAt time.Time) error {
+	res, err := conn(ctx, b.db).ExecContext(ctx, `
+	UPDATE action_tokens SET used_at = $1
+	WHERE uid = $2 AND mode = $3 AND used_at IS NULL AND expires_at > $1;
+	`, usedAt, UID, mode)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrInvalidActionCode
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseAddressRepository struct {
+	db *sqlx.DB
+}
+
+func NewAddressRepository(db *sqlx.DB) domain.AddressRepository {
+	return &baseAddressRepository{db: db}
+}
+
+func (b *baseAddressRepository) CreateAddress(ctx context.Context, addressPayload *domain.AddressRepositoryPayloadCreateAddress) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	if addressPayload.IsDefault {
+		_, err = tx.ExecContext(ctx, "UPDATE addresses SET is_default = FALSE, updated_at = $1 WHERE user_id = $2 AND is_default;", addressPayload.UpdatedAt, addressPayload.UserID)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.NamedExecContext(ctx, `
+	INSERT INTO addresses (uid, user_id, label, recipient_name, phone, street, province, city, district, sub_district, postal_code, is_default, created_at, updated_at)
+	VALUES (:uid, :user_id, :label, :recipient_name, :phone, :street, :province, :city, :district, :sub_district, :postal_code, :is_default, :created_at, :updated_at);
+	`, addressPayload)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseAddressRepository) ListAddressesByUserID(ctx context.Context, userID int) ([]*domain.AddressModel, error) {
+	addresses := []*domain.AddressModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &addresses, "SELECT * FROM addresses WHERE user_id = $1 ORDER BY is_default DESC, id DESC;", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+func (b *baseAddressRepository) GetAddressByUID(ctx context.Context, userID int, UID string) (*domain.AddressModel, error) {
+	var address domain.AddressModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &address, "SELECT * FROM addresses WHERE uid = $1 AND user_id = $2;", UID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &address, nil
+}
+
+func (b *baseAddressRepository) UpdateAddress(ctx context.Context, addressPayload *domain.AddressRepositoryPayloadUpdateAddress) error {
+	res, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	UPDATE addresses
+	SET label = :label, recipient_name = :recipient_name, phone = :phone, street = :street, province = :province,
+	city = :city, district = :district, sub_district = :sub_district, postal_code = :postal_code, updated_at = :updated_at
+	WHERE uid = :uid AND user_id = :user_id;
+	`, addressPayload)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrAddressNotFound
+	}
+
+	return nil
+}
+
+func (b *baseAddressRepository) SetDefaultAddress(ctx context.Context, userID int, UID string, updatedAt time.Time) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	var addressID int
+	err = tx.GetContext(ctx, &addressID, "SELECT id FROM addresses WHERE uid = $1 AND user_id = $2 FOR UPDATE;", UID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAddressNotFound
+		}
+
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE addresses SET is_default = FALSE, updated_at = $1 WHERE user_id = $2 AND is_default AND id <> $3;", updatedAt, userID, addressID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE addresses SET is_default = TRUE, updated_at = $1 WHERE id = $2;", updatedAt, addressID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAddress promotes the most recently created address to default when the default address is deleted.
+func (b *baseAddressRepository) DeleteAddress(ctx context.Context, userID int, UID string, updatedAt time.Time) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	var isDefault bool
+	err = tx.GetContext(ctx, &isDefault, "DELETE FROM addresses WHERE uid = $1 AND user_id = $2 RETURNING is_default;", UID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAddressNotFound
+		}
+
+		return err
+	}
+	if isDefault {
+		_, err = tx.ExecContext(ctx, `
+		UPDATE addresses SET is_default = TRUE, updated_at = $1
+		WHERE id = (SELECT id FROM addresses WHERE user_id = $2 ORDER BY id DESC LIMIT 1);
+		`, updatedAt, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
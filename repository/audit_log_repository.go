@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseAuditLogRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditLogRepository(db *sqlx.DB) domain.AuditLogRepository {
+	return &baseAuditLogRepository{db: db}
+}
+
+func (b *baseAuditLogRepository) CreateAuditLog(ctx context.Context, auditLogPayload *domain.AuditLogRepositoryPayloadCreateAuditLog) error {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO audit_logs (uid, action, actor_user_id, target_user_id, ip_address, created_at)
+	VALUES (:uid, :action, :actor_user_id, :target_user_id, :ip_address, :created_at);
+	`, auditLogPayload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseAuditLogRepository) ListAuditLogsByTargetUserID(ctx context.Context, targetUserID int) ([]*domain.AuditLogModel, error) {
+	auditLogs := []*domain.AuditLogModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &auditLogs, "SELECT * FROM audit_logs WHERE target_user_id = $1 ORDER BY id;", targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLogs, nil
+}
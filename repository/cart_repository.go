@@ -1,8 +1,12 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"math"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
@@ -10,16 +14,17 @@ import (
 )
 
 type baseCartRepository struct {
-	db *sqlx.DB
+	db          *sqlx.DB
+	productUtil domain.ProductUtil
 }
 
-func NewCartRepository(db *sqlx.DB) domain.CartRepository {
-	return &baseCartRepository{db: db}
+func NewCartRepository(db *sqlx.DB, productUtil domain.ProductUtil) domain.CartRepository {
+	return &baseCartRepository{db: db, productUtil: productUtil}
 }
 
-func (b *baseCartRepository) GetProductByUID(UID string) (*domain.ProductModel, error) {
+func (b *baseCartRepository) GetProductByID(ctx context.Context, ID int) (*domain.ProductModel, error) {
 	var product domain.ProductModel
-	err := b.db.Get(&product, "SELECT * FROM products WHERE UID = $1;", UID)
+	err := conn(ctx, b.db).GetContext(ctx, &product, "SELECT * FROM products WHERE id = $1;", ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -31,7 +36,21 @@ func (b *baseCartRepository) GetProductByUID(UID string) (*domain.ProductModel,
 	return &product, nil
 }
 
-func (b *baseCartRepository) CreateCart() error {
+func (b *baseCartRepository) GetProductByUID(ctx context.Context, UID string) (*domain.ProductModel, error) {
+	var product domain.ProductModel
+	err := conn(ctx, b.db).GetContext(ctx, &product, "SELECT * FROM products WHERE UID = $1;", UID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (b *baseCartRepository) CreateCart(ctx context.Context, userID int) error {
 	metadata := utils.GenerateMetadata()
 
 	cart := domain.CartModel{
@@ -41,12 +60,12 @@ func (b *baseCartRepository) CreateCart() error {
 		TotalPriceValue:  0,
 		TotalWeight:      "Rp 0",
 		TotalWeightValue: 0,
-		UserID:           1,
+		UserID:           sql.NullInt64{Int64: int64(userID), Valid: true},
 		CreatedAt:        metadata.CreatedAt,
 		UpdatedAt:        metadata.UpdatedAt,
 	}
 
-	_, err := b.db.NamedExec(`
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
 	INSERT INTO carts (uid, quantity, total_price, total_price_value, total_weight, total_weight_value, user_id, created_at, updated_at)
 	VALUES (:uid, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :user_id, :created_at, :updated_at)
 	`, cart)
@@ -57,8 +76,37 @@ func (b *baseCartRepository) CreateCart() error {
 	return nil
 }
 
-func (b *baseCartRepository) GetCartByUID(UID string) (*domain.CartModel, error) {
-	tx, err := b.db.Beginx()
+func (b *baseCartRepository) CreateGuestCart(ctx context.Context) (string, error) {
+	metadata := utils.GenerateMetadata()
+	totalPrice, err := b.productUtil.FormatRupiah(0)
+	if err != nil {
+		return "", err
+	}
+
+	cart := domain.CartModel{
+		UID:              metadata.UID(),
+		Quantity:         0,
+		TotalPrice:       totalPrice,
+		TotalPriceValue:  0,
+		TotalWeight:      b.productUtil.FormatWeight(0),
+		TotalWeightValue: 0,
+		CreatedAt:        metadata.CreatedAt,
+		UpdatedAt:        metadata.UpdatedAt,
+	}
+
+	_, err = conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO carts (uid, quantity, total_price, total_price_value, total_weight, total_weight_value, user_id, created_at, updated_at)
+	VALUES (:uid, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :user_id, :created_at, :updated_at)
+	`, cart)
+	if err != nil {
+		return "", err
+	}
+
+	return cart.UID, nil
+}
+
+func (b *baseCartRepository) GetCartByUID(ctx context.Context, UID string) (*domain.CartModel, error) {
+	tx, err := beginTx(ctx, b.db)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +117,7 @@ func (b *baseCartRepository) GetCartByUID(UID string) (*domain.CartModel, error)
 	var cart domain.CartModel
 	var cartItems []domain.CartItemModel
 
-	err = tx.Get(&cart, "SELECT * FROM carts WHERE uid = $1", UID)
+	err = tx.GetContext(ctx, &cart, "SELECT * FROM carts WHERE uid = $1", UID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -78,7 +126,7 @@ func (b *baseCartRepository) GetCartByUID(UID string) (*domain.CartModel, error)
 		return nil, err
 	}
 
-	err = tx.Select(&cartItems, "SELECT * FROM cart_items WHERE cart_id = $1", cart.ID)
+	err = tx.SelectContext(ctx, &cartItems, "SELECT * FROM cart_items WHERE cart_id = $1", cart.ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -96,8 +144,8 @@ func (b *baseCartRepository) GetCartByUID(UID string) (*domain.CartModel, error)
 	return &cart, nil
 }
 
-func (b *baseCartRepository) GetCartByUserID(userID int) (*domain.CartModel, error) {
-	tx, err := b.db.Beginx()
+func (b *baseCartRepository) GetCartByUserID(ctx context.Context, userID int) (*domain.CartModel, error) {
+	tx, err := beginTx(ctx, b.db)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +156,7 @@ func (b *baseCartRepository) GetCartByUserID(userID int) (*domain.CartModel, err
 	var cart domain.CartModel
 	var cartItems []domain.CartItemModel
 
-	err = tx.Get(&cart, "SELECT * FROM carts WHERE user_id = $1", userID)
+	err = tx.GetContext(ctx, &cart, "SELECT * FROM carts WHERE user_id = $1", userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -117,7 +165,7 @@ func (b *baseCartRepository) GetCartByUserID(userID int) (*domain.CartModel, err
 		return nil, err
 	}
 
-	err = tx.Select(&cartItems, "SELECT * FROM cart_items WHERE cart_id = $1", cart.ID)
+	err = tx.SelectContext(ctx, &cartItems, "SELECT * FROM cart_items WHERE cart_id = $1", cart.ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -135,8 +183,8 @@ func (b *baseCartRepository) GetCartByUserID(userID int) (*domain.CartModel, err
 	return &cart, nil
 }
 
-func (b *baseCartRepository) CreateCartItem(cartItemPayload domain.CartRepositoryPayloadCreateCartItem, cartPayload domain.CartRepositoryPayloadUpdateCart) (string, error) {
-	tx, err := b.db.Beginx()
+func (b *baseCartRepository) CreateCartItem(ctx context.Context, cartItemPayload domain.CartRepositoryPayloadCreateCartItem) (string, error) {
+	tx, err := beginTx(ctx, b.db)
 	if err != nil {
 		return "", err
 	}
@@ -144,39 +192,188 @@ func (b *baseCartRepository) CreateCartItem(cartItemPayload domain.CartRepositor
 		tx.Rollback()
 	}()
 
-	_, err = tx.NamedExec(`
+	product, err := lockProduct(ctx, tx, cartItemPayload.ProductID)
+	if err != nil {
+		return "", err
+	}
+	// The payload was calculated from a read outside of the transaction, the product may have been updated since.
+	if product.Status != "ACTIVE" {
+		return "", fmt.Errorf("%w: %s", domain.ErrProductUnavailable, product.Name)
+	}
+	if product.OfferPriceValue != cartItemPayload.OfferPriceValue {
+		return "", domain.ErrPriceChanged
+	}
+
+	err = lockCart(ctx, tx, cartItemPayload.CartID)
+	if err != nil {
+		return "", err
+	}
+
+	// Adding a product that is already in the cart increases the quantity of the existing line.
+	var cartItem domain.CartItemModel
+	err = tx.GetContext(ctx, &cartItem, "SELECT * FROM cart_items WHERE cart_id = $1 AND product_id = $2;", cartItemPayload.CartID, cartItemPayload.ProductID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = b.insertCartItem(ctx, tx, cartItemPayload)
+	case err == nil:
+		err = b.mergeCartItem(ctx, tx, cartItem, cartItemPayload)
+		cartItemPayload.UID = cartItem.UID
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if cartItemPayload.ReservedUntil.Valid {
+		err = b.reserveStock(ctx, tx, cartItemPayload.CartID, cartItemPayload.ProductID, cartItemPayload.ReservedUntil.Time, cartItemPayload.UpdatedAt)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = recalculateCart(ctx, tx, b.productUtil, cartItemPayload.CartID, cartItemPayload.UpdatedAt)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return "", err
+	}
+
+	return cartItemPayload.UID, nil
+}
+
+func (b *baseCartRepository) insertCartItem(ctx context.Context, tx *txScope, cartItemPayload domain.CartRepositoryPayloadCreateCartItem) error {
+	_, err := tx.NamedExecContext(ctx, `
 	INSERT INTO cart_items
-	(uid, quantity, total_price, total_price_value, total_weight, total_weight_value, product_name, product_slug, product_image, product_weight, product_weight_value, base_price, base_price_value, offer_price, offer_price_value, discount, cart_id, product_id, created_at, updated_at)
-	VALUES (:uid, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :product_name, :product_slug, :product_image, :product_weight, :product_weight_value, :base_price, :base_price_value, :offer_price, :offer_price_value, :discount, :cart_id, :product_id, :created_at, :updated_at);
+	(uid, quantity, total_price, total_price_value, total_weight, total_weight_value, product_name, product_slug, product_image, product_weight, product_weight_value, base_price, base_price_value, offer_price, offer_price_value, discount, product_status, cart_id, product_id, created_at, updated_at)
+	VALUES (:uid, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :product_name, :product_slug, :product_image, :product_weight, :product_weight_value, :base_price, :base_price_value, :offer_price, :offer_price_value, :discount, :product_status, :cart_id, :product_id, :created_at, :updated_at);
 	`, cartItemPayload)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, err = tx.NamedExec(`
-	UPDATE carts
+	return nil
+}
+
+// calculateCartItemTotals sets the price and weight totals of the payload from its quantity and product snapshot.
+func (b *baseCartRepository) calculateCartItemTotals(cartItemPayload *domain.CartRepositoryPayloadCreateCartItem) error {
+	cartItemPayload.TotalPriceValue = cartItemPayload.Quantity * cartItemPayload.OfferPriceValue
+	totalPrice, err := b.productUtil.FormatRupiah(cartItemPayload.TotalPriceValue)
+	if err != nil {
+		return err
+	}
+	cartItemPayload.TotalPrice = totalPrice
+	cartItemPayload.TotalWeightValue = math.Round(float64(cartItemPayload.Quantity)*cartItemPayload.ProductWeightValue*100) / 100
+	cartItemPayload.TotalWeight = b.productUtil.FormatWeight(cartItemPayload.TotalWeightValue)
+
+	return nil
+}
+
+// mergeCartItem adds the payload quantity to an existing line, refreshing its product snapshot
+// so the whole line is priced at the current price.
+func (b *baseCartRepository) mergeCartItem(ctx context.Context, tx *txScope, cartItem domain.CartItemModel, cartItemPayload domain.CartRepositoryPayloadCreateCartItem) error {
+	cartItemPayload.UID = cartItem.UID
+	cartItemPayload.Quantity += cartItem.Quantity
+	err := b.calculateCartItemTotals(&cartItemPayload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.NamedExecContext(ctx, `
+	UPDATE cart_items
 	SET quantity = :quantity,
 			total_price = :total_price,
 			total_price_value = :total_price_value,
 			total_weight = :total_weight,
 			total_weight_value = :total_weight_value,
+			product_name = :product_name,
+			product_slug = :product_slug,
+			product_image = :product_image,
+			product_weight = :product_weight,
+			product_weight_value = :product_weight_value,
+			base_price = :base_price,
+			base_price_value = :base_price_value,
+			offer_price = :offer_price,
+			offer_price_value = :offer_price_value,
+			discount = :discount,
+			product_status = :product_status,
+			price_changed = FALSE,
 			updated_at = :updated_at
 	WHERE uid = :uid;
-	`, cartPayload)
+	`, cartItemPayload)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseCartRepository) UpdateCartItem(ctx context.Context, cartItemPayload domain.CartRepositoryPayloadUpdateCartItem) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	product, err := lockProduct(ctx, tx, cartItemPayload.ProductID)
+	if err != nil {
+		return err
+	}
+
+	err = lockCart(ctx, tx, cartItemPayload.CartID)
+	if err != nil {
+		return err
+	}
+
+	// The line is priced from the locked product, a price change committed after the caller read the cart item
+	// is kept along with its price_changed flag.
+	totalPriceValue := cartItemPayload.Quantity * product.OfferPriceValue
+	totalPrice, err := b.productUtil.FormatRupiah(totalPriceValue)
+	if err != nil {
+		return err
+	}
+	totalWeightValue := math.Round(float64(cartItemPayload.Quantity)*product.WeightValue*100) / 100
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE cart_items
+	SET quantity = $1,
+			total_price = $2,
+			total_price_value = $3,
+			total_weight = $4,
+			total_weight_value = $5,
+			updated_at = $6
+	WHERE uid = $7 AND cart_id = $8;
+	`, cartItemPayload.Quantity, totalPrice, totalPriceValue, b.productUtil.FormatWeight(totalWeightValue), totalWeightValue,
+		cartItemPayload.UpdatedAt, cartItemPayload.UID, cartItemPayload.CartID)
+	if err != nil {
+		return err
+	}
+
+	if cartItemPayload.ReservedUntil.Valid {
+		err = b.reserveStock(ctx, tx, cartItemPayload.CartID, cartItemPayload.ProductID, cartItemPayload.ReservedUntil.Time, cartItemPayload.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = recalculateCart(ctx, tx, b.productUtil, cartItemPayload.CartID, cartItemPayload.UpdatedAt)
+	if err != nil {
+		return err
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	return cartItemPayload.UID, nil
+	return nil
 }
 
-func (b *baseCartRepository) UpdateCartItem(cartItemPayload domain.CartRepositoryPayloadUpdateCartItem, cartPayload domain.CartRepositoryPayloadUpdateCart) error {
-	tx, err := b.db.Beginx()
+func (b *baseCartRepository) DeleteCartItemByUID(ctx context.Context, UID string, cartID int) error {
+	tx, err := beginTx(ctx, b.db)
 	if err != nil {
 		return err
 	}
@@ -184,30 +381,38 @@ func (b *baseCartRepository) UpdateCartItem(cartItemPayload domain.CartRepositor
 		tx.Rollback()
 	}()
 
-	_, err = tx.NamedExec(`
-	UPDATE cart_items 
-	SET quantity = :quantity, 
-			total_price = :total_price,
-			total_price_value = :total_price_value,
-			total_weight = :total_weight,
-			total_weight_value = :total_weight_value,
-			updated_at = :updated_at
-	WHERE uid = :uid;
-	`, cartItemPayload)
+	err = lockCart(ctx, tx, cartID)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.NamedExec(`
-	UPDATE carts 
-	SET quantity = :quantity, 
-			total_price = :total_price,
-			total_price_value = :total_price_value,
-			total_weight = :total_weight,
-			total_weight_value = :total_weight_value,
-			updated_at = :updated_at
-	WHERE uid = :uid;
-	`, cartPayload)
+	var cartItem domain.CartItemModel
+	err = tx.GetContext(ctx, &cartItem, "DELETE FROM cart_items WHERE uid = $1 AND cart_id = $2 RETURNING *;", UID, cartID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+	updatedAt := time.Now().UTC()
+
+	// Shrink the reservation to what is left in the cart, dropping it once the product is gone.
+	_, err = tx.ExecContext(ctx, `
+	UPDATE stock_reservations
+	SET quantity = quantity - $1,
+			updated_at = $2
+	WHERE cart_id = $3 AND product_id = $4;
+	`, cartItem.Quantity, updatedAt, cartItem.CartID, cartItem.ProductID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM stock_reservations WHERE cart_id = $1 AND product_id = $2 AND quantity <= 0;", cartItem.CartID, cartItem.ProductID)
+	if err != nil {
+		return err
+	}
+
+	err = recalculateCart(ctx, tx, b.productUtil, cartID, updatedAt)
 	if err != nil {
 		return err
 	}
@@ -220,8 +425,8 @@ func (b *baseCartRepository) UpdateCartItem(cartItemPayload domain.CartRepositor
 	return nil
 }
 
-func (b *baseCartRepository) DeleteCartItemByUID(UID string, cartPayload domain.CartRepositoryPayloadUpdateCart) error {
-	tx, err := b.db.Beginx()
+func (b *baseCartRepository) RecalculateCart(ctx context.Context, cartID int) error {
+	tx, err := beginTx(ctx, b.db)
 	if err != nil {
 		return err
 	}
@@ -229,21 +434,12 @@ func (b *baseCartRepository) DeleteCartItemByUID(UID string, cartPayload domain.
 		tx.Rollback()
 	}()
 
-	_, err = tx.Exec("DELETE FROM cart_items WHERE uid = $1;", UID)
+	err = lockCart(ctx, tx, cartID)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.NamedExec(`
-	UPDATE carts 
-	SET quantity = :quantity, 
-			total_price = :total_price,
-			total_price_value = :total_price_value,
-			total_weight = :total_weight,
-			total_weight_value = :total_weight_value,
-			updated_at = :updated_at
-	WHERE uid = :uid;
-	`, cartPayload)
+	err = recalculateCart(ctx, tx, b.productUtil, cartID, time.Now().UTC())
 	if err != nil {
 		return err
 	}
@@ -256,10 +452,83 @@ func (b *baseCartRepository) DeleteCartItemByUID(UID string, cartPayload domain.
 	return nil
 }
 
-func (b *baseCartRepository) GetCartItemByUID(UID string) (*domain.CartItemModel, error) {
+// lockProduct locks the product row until the transaction ends. A transaction that locks both products and
+// carts locks the products first, checkout and product updates included, so they never wait on each other in a cycle.
+func lockProduct(ctx context.Context, tx *txScope, productID int) (*domain.ProductModel, error) {
+	var product domain.ProductModel
+	err := tx.GetContext(ctx, &product, "SELECT * FROM products WHERE id = $1 FOR UPDATE;", productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProductNotFound
+		}
+
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// lockCart locks the cart row until the transaction ends, so mutations of the same cart run one at a time.
+func lockCart(ctx context.Context, tx *txScope, cartID int) error {
+	var ID int
+	err := tx.GetContext(ctx, &ID, "SELECT id FROM carts WHERE id = $1 FOR UPDATE;", cartID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCartNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// recalculateCart recomputes the cart totals from its items, so they never drift from the sum of cart_items.
+// The cart row must be locked by the caller.
+func recalculateCart(ctx context.Context, tx *txScope, productUtil domain.ProductUtil, cartID int, updatedAt time.Time) error {
+	var totals struct {
+		Quantity         int     `db:"quantity"`
+		TotalPriceValue  int     `db:"total_price_value"`
+		TotalWeightValue float64 `db:"total_weight_value"`
+	}
+	err := tx.GetContext(ctx, &totals, `
+	SELECT COALESCE(SUM(quantity), 0) AS quantity,
+			COALESCE(SUM(total_price_value), 0) AS total_price_value,
+			COALESCE(SUM(total_weight_value), 0) AS total_weight_value
+	FROM cart_items
+	WHERE cart_id = $1;
+	`, cartID)
+	if err != nil {
+		return err
+	}
+
+	totalPrice, err := productUtil.FormatRupiah(totals.TotalPriceValue)
+	if err != nil {
+		return err
+	}
+	totalWeightValue := math.Round(totals.TotalWeightValue*100) / 100
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE carts
+	SET quantity = $1,
+			total_price = $2,
+			total_price_value = $3,
+			total_weight = $4,
+			total_weight_value = $5,
+			updated_at = $6
+	WHERE id = $7;
+	`, totals.Quantity, totalPrice, totals.TotalPriceValue, productUtil.FormatWeight(totalWeightValue), totalWeightValue, updatedAt, cartID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseCartRepository) GetCartItemByUID(ctx context.Context, UID string) (*domain.CartItemModel, error) {
 	var cartItem domain.CartItemModel
 
-	err := b.db.Get(&cartItem, "SELECT * FROM cart_items WHERE uid = $1", UID)
+	err := conn(ctx, b.db).GetContext(ctx, &cartItem, "SELECT * FROM cart_items WHERE uid = $1", UID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -271,10 +540,10 @@ func (b *baseCartRepository) GetCartItemByUID(UID string) (*domain.CartItemModel
 	return &cartItem, nil
 }
 
-func (b *baseCartRepository) GetCartItemByProductID(productID int) (*domain.CartItemModel, error) {
+func (b *baseCartRepository) GetCartItemByProductID(ctx context.Context, cartID, productID int) (*domain.CartItemModel, error) {
 	var cartItem domain.CartItemModel
 
-	err := b.db.Get(&cartItem, "SELECT * FROM cart_items WHERE product_id = $1", productID)
+	err := conn(ctx, b.db).GetContext(ctx, &cartItem, "SELECT * FROM cart_items WHERE cart_id = $1 AND product_id = $2", cartID, productID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -285,3 +554,196 @@ func (b *baseCartRepository) GetCartItemByProductID(productID int) (*domain.Cart
 
 	return &cartItem, nil
 }
+
+// reserveStock holds the cart's total quantity of a product until reservedUntil. The product row is locked
+// so concurrent reservations see each other, and stock held by other carts' unexpired reservations is not
+// available. Must be called after the cart item change so the cart total includes it.
+func (b *baseCartRepository) reserveStock(ctx context.Context, tx *txScope, cartID, productID int, reservedUntil, updatedAt time.Time) error {
+	var stock int
+	err := tx.GetContext(ctx, &stock, "SELECT stock FROM products WHERE id = $1 FOR UPDATE;", productID)
+	if err != nil {
+		return err
+	}
+
+	var reservedByOthers int
+	err = tx.GetContext(ctx, &reservedByOthers, `
+	SELECT COALESCE(SUM(quantity), 0)
+	FROM stock_reservations
+	WHERE product_id = $1 AND cart_id <> $2 AND expires_at > $3;
+	`, productID, cartID, updatedAt)
+	if err != nil {
+		return err
+	}
+
+	var quantity int
+	err = tx.GetContext(ctx, &quantity, "SELECT COALESCE(SUM(quantity), 0) FROM cart_items WHERE cart_id = $1 AND product_id = $2;", cartID, productID)
+	if err != nil {
+		return err
+	}
+	if quantity > stock-reservedByOthers {
+		return domain.ErrInsufficientStock
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO stock_reservations (quantity, expires_at, cart_id, product_id, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $5)
+	ON CONFLICT (cart_id, product_id)
+	DO UPDATE SET quantity = EXCLUDED.quantity, expires_at = EXCLUDED.expires_at, updated_at = EXCLUDED.updated_at;
+	`, quantity, reservedUntil, cartID, productID, updatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseCartRepository) DeleteExpiredReservations(ctx context.Context, now time.Time) (int64, error) {
+	res, err := conn(ctx, b.db).ExecContext(ctx, "DELETE FROM stock_reservations WHERE expires_at <= $1;", now)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// MergeCart moves the items of a guest cart into the cart of a user and deletes the guest cart.
+// Quantities are capped to the stock left after other carts' reservations, and products that
+// are no longer active are dropped.
+func (b *baseCartRepository) MergeCart(ctx context.Context, guestCartID, userCartID int) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// Products before carts, see lockProduct.
+	_, err = tx.ExecContext(ctx, `
+	SELECT id
+	FROM products
+	WHERE id IN (SELECT product_id FROM cart_items WHERE cart_id = $1)
+	ORDER BY id
+	FOR UPDATE;
+	`, guestCartID)
+	if err != nil {
+		return err
+	}
+
+	var cartIDs []int
+	err = tx.SelectContext(ctx, &cartIDs, "SELECT id FROM carts WHERE id IN ($1, $2) ORDER BY id FOR UPDATE;", guestCartID, userCartID)
+	if err != nil {
+		return err
+	}
+	if len(cartIDs) != 2 {
+		return domain.ErrCartNotFound
+	}
+
+	var guestCartItems []domain.CartItemModel
+	err = tx.SelectContext(ctx, &guestCartItems, "SELECT * FROM cart_items WHERE cart_id = $1 ORDER BY product_id;", guestCartID)
+	if err != nil {
+		return err
+	}
+
+	metadata := utils.GenerateMetadata()
+	for _, guestCartItem := range guestCartItems {
+		var product domain.ProductModel
+		err = tx.GetContext(ctx, &product, "SELECT * FROM products WHERE id = $1 FOR UPDATE;", guestCartItem.ProductID)
+		if err != nil {
+			return err
+		}
+		if product.Status != "ACTIVE" {
+			continue
+		}
+
+		var reservedByOthers int
+		err = tx.GetContext(ctx, &reservedByOthers, `
+		SELECT COALESCE(SUM(quantity), 0)
+		FROM stock_reservations
+		WHERE product_id = $1 AND cart_id NOT IN ($2, $3) AND expires_at > $4;
+		`, product.ID, guestCartID, userCartID, metadata.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		existingQuantity := 0
+		var cartItem domain.CartItemModel
+		err = tx.GetContext(ctx, &cartItem, "SELECT * FROM cart_items WHERE cart_id = $1 AND product_id = $2;", userCartID, product.ID)
+		if err == nil {
+			existingQuantity = cartItem.Quantity
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		quantity := guestCartItem.Quantity
+		available := product.Stock - reservedByOthers - existingQuantity
+		if quantity > available {
+			quantity = available
+		}
+		if quantity <= 0 {
+			continue
+		}
+
+		productImage := ""
+		if len(product.Images) > 0 {
+			productImage = product.Images[0]
+		}
+		cartItemPayload := domain.CartRepositoryPayloadCreateCartItem{
+			UID:                metadata.UID(),
+			Quantity:           quantity,
+			ProductName:        product.Name,
+			ProductSlug:        product.Slug,
+			ProductImage:       productImage,
+			ProductWeight:      product.Weight,
+			ProductWeightValue: product.WeightValue,
+			BasePrice:          product.BasePrice,
+			BasePriceValue:     product.BasePriceValue,
+			OfferPrice:         product.OfferPrice,
+			OfferPriceValue:    product.OfferPriceValue,
+			Discount:           product.Discount,
+			ProductStatus:      product.Status,
+			CartID:             userCartID,
+			ProductID:          product.ID,
+			CreatedAt:          metadata.CreatedAt,
+			UpdatedAt:          metadata.UpdatedAt,
+		}
+		if existingQuantity > 0 {
+			err = b.mergeCartItem(ctx, tx, cartItem, cartItemPayload)
+		} else {
+			err = b.calculateCartItemTotals(&cartItemPayload)
+			if err != nil {
+				return err
+			}
+			err = b.insertCartItem(ctx, tx, cartItemPayload)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM carts WHERE id = $1;", guestCartID)
+	if err != nil {
+		return err
+	}
+
+	err = recalculateCart(ctx, tx, b.productUtil, userCartID, metadata.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseCartRepository) DeleteGuestCartsUpdatedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := conn(ctx, b.db).ExecContext(ctx, "DELETE FROM carts WHERE user_id IS NULL AND updated_at < $1;", before)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
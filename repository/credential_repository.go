@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseCredentialRepository struct {
+	db *sqlx.DB
+}
+
+func NewCredentialRepository(db *sqlx.DB) domain.CredentialRepository {
+	return &baseCredentialRepository{db: db}
+}
+
+func (b *baseCredentialRepository) CreateCredential(ctx context.Context, credentialPayload *domain.CredentialRepositoryPayloadCreateCredential) error {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO credentials (uid, email, password_hash, created_at, updated_at)
+	VALUES (:uid, :email, :password_hash, :created_at, :updated_at);
+	`, credentialPayload)
+	if err != nil {
+		return fromUniqueViolation(err)
+	}
+
+	return nil
+}
+
+func (b *baseCredentialRepository) GetCredentialByEmail(ctx context.Context, email string) (*domain.CredentialModel, error) {
+	var credential domain.CredentialModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &credential, "SELECT * FROM credentials WHERE email = $1;", email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+func (b *baseCredentialRepository) GetCredentialByUID(ctx context.Context, UID string) (*domain.CredentialModel, error) {
+	var credential domain.CredentialModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &credential, "SELECT * FROM credentials WHERE uid = $1;", UID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+func (b *baseCredentialRepository) UpdateEmailByUID(ctx context.Context, UID, email string, updatedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE credentials SET email = $1, updated_at = $2 WHERE uid = $3;", email, updatedAt, UID)
+	if err != nil {
+		return fromUniqueViolation(err)
+	}
+
+	return nil
+}
+
+func (b *baseCredentialRepository) UpdatePasswordByUID(ctx context.Context, UID, passwordHash string, updatedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE credentials SET password_hash = $1, updated_at = $2 WHERE uid = $3;", passwordHash, updatedAt, UID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseCredentialRepository) DeleteCredentialByUID(ctx context.Context, UID string) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "DELETE FROM credentials WHERE uid = $1;", UID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseDataExportRepository struct {
+	db *sqlx.DB
+}
+
+func NewDataExportRepository(db *sqlx.DB) domain.DataExportRepository {
+	return &baseDataExportRepository{db: db}
+}
+
+func (b *baseDataExportRepository) CreateDataExport(ctx context.Context, dataExportPayload *domain.DataExportRepositoryPayloadCreateDataExport) error {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO data_exports (uid, user_id, status, created_at)
+	VALUES (:uid, :user_id, :status, :created_at);
+	`, dataExportPayload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseDataExportRepository) GetLatestDataExportByUserID(ctx context.Context, userID int) (*domain.DataExportModel, error) {
+	var dataExport domain.DataExportModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &dataExport, "SELECT * FROM data_exports WHERE user_id = $1 ORDER BY id DESC LIMIT 1;", userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &dataExport, nil
+}
+
+func (b *baseDataExportRepository) ListPendingDataExports(ctx context.Context, createdAfter time.Time) ([]*domain.DataExportModel, error) {
+	dataExports := []*domain.DataExportModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &dataExports, "SELECT * FROM data_exports WHERE status = $1 AND created_at > $2 ORDER BY id;", domain.DataExportStatusPending, createdAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataExports, nil
+}
+
+func (b *baseDataExportRepository) FailPendingDataExports(ctx context.Context, createdBefore time.Time, errMessage string, completedAt time.Time) (int64, error) {
+	res, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE data_exports SET status = $1, error = $2, completed_at = $3 WHERE status = $4 AND created_at <= $5;", domain.DataExportStatusFailed, errMessage, completedAt, domain.DataExportStatusPending, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (b *baseDataExportRepository) CompleteDataExport(ctx context.Context, UID string, archive []byte, completedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE data_exports SET status = $1, archive = $2, completed_at = $3 WHERE uid = $4;", domain.DataExportStatusReady, archive, completedAt, UID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseDataExportRepository) FailDataExport(ctx context.Context, UID, errMessage string, completedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE data_exports SET status = $1, error = $2, completed_at = $3 WHERE uid = $4;", domain.DataExportStatusFailed, errMessage, completedAt, UID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+const (
+	uniqueViolationCode = "23505"
+	undefinedTableCode  = "42P01"
+)
+
+// uniqueViolationKey matches the detail of a unique violation, e.g. Key (slug)=(t-shirt) already exists.
+var uniqueViolationKey = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// fromUniqueViolation turns a unique constraint violation into a Conflict error naming the violated
+// field, any other error is returned as is.
+func fromUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return err
+	}
+
+	field := pgErr.ColumnName
+	if match := uniqueViolationKey.FindStringSubmatch(pgErr.Detail); match != nil {
+		field = match[1]
+	}
+	if field == "" {
+		field = pgErr.ConstraintName
+	}
+
+	return domain.NewAlreadyExistError(field)
+}
+
+func isUndefinedTable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == undefinedTableCode
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4/source"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseHealthRepository struct {
+	db            *sqlx.DB
+	migrationsDir string
+}
+
+func NewHealthRepository(db *sqlx.DB, migrationsDir string) domain.HealthRepository {
+	return &baseHealthRepository{db: db, migrationsDir: migrationsDir}
+}
+
+func (b *baseHealthRepository) Ping(ctx context.Context) error {
+	return b.db.PingContext(ctx)
+}
+
+// MigrationVersion reads the table golang-migrate keeps, a database that was never migrated is at version 0.
+func (b *baseHealthRepository) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := b.db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1;").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) || isUndefinedTable(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return uint(version), dirty, nil
+}
+
+func (b *baseHealthRepository) LatestMigrationVersion() (uint, error) {
+	driver, err := source.Open("file://" + b.migrationsDir)
+	if err != nil {
+		return 0, err
+	}
+	defer driver.Close()
+
+	version, err := driver.First()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		next, err := driver.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		version = next
+	}
+}
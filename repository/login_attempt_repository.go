@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+// NewLoginAttemptRepository returns the store selected by LOGIN_ATTEMPT_STORE, defaulting to memory.
+// The memory store is per process, use postgres when running more than one instance.
+func NewLoginAttemptRepository(env *domain.Env, db *sqlx.DB) domain.LoginAttemptRepository {
+	if env.LoginAttemptStore == domain.LoginAttemptStorePostgres {
+		return NewPostgresLoginAttemptRepository(db)
+	}
+
+	return NewMemoryLoginAttemptRepository()
+}
+
+type basePostgresLoginAttemptRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresLoginAttemptRepository(db *sqlx.DB) domain.LoginAttemptRepository {
+	return &basePostgresLoginAttemptRepository{db: db}
+}
+
+func (b *basePostgresLoginAttemptRepository) GetLoginAttempt(ctx context.Context, key string) (*domain.LoginAttemptModel, error) {
+	var loginAttempt domain.LoginAttemptModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &loginAttempt, "SELECT * FROM login_attempts WHERE key = $1;", key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &loginAttempt, nil
+}
+
+func (b *basePostgresLoginAttemptRepository) RecordFailedLoginAttempt(ctx context.Context, key string, failedAt time.Time) (*domain.LoginAttemptModel, error) {
+	var loginAttempt domain.LoginAttemptModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &loginAttempt, `
+	INSERT INTO login_attempts (key, failures, last_failed_at)
+	VALUES ($1, 1, $2)
+	ON CONFLICT (key) DO UPDATE SET
+	failures = CASE WHEN GREATEST(login_attempts.last_failed_at, login_attempts.locked_until) < $3 THEN 1 ELSE login_attempts.failures + 1 END,
+	locked_until = CASE WHEN GREATEST(login_attempts.last_failed_at, login_attempts.locked_until) < $3 THEN NULL ELSE login_attempts.locked_until END,
+	last_failed_at = EXCLUDED.last_failed_at
+	RETURNING *;
+	`, key, failedAt, failedAt.Add(-domain.LoginFailureWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	return &loginAttempt, nil
+}
+
+func (b *basePostgresLoginAttemptRepository) LockLoginAttempt(ctx context.Context, key string, lockedUntil time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE login_attempts SET locked_until = $1 WHERE key = $2;", lockedUntil, key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *basePostgresLoginAttemptRepository) DeleteLoginAttempt(ctx context.Context, key string) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "DELETE FROM login_attempts WHERE key = $1;", key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseMemoryLoginAttemptRepository struct {
+	mu            sync.Mutex
+	loginAttempts map[string]*domain.LoginAttemptModel
+}
+
+func NewMemoryLoginAttemptRepository() domain.LoginAttemptRepository {
+	return &baseMemoryLoginAttemptRepository{loginAttempts: map[string]*domain.LoginAttemptModel{}}
+}
+
+func (b *baseMemoryLoginAttemptRepository) GetLoginAttempt(ctx context.Context, key string) (*domain.LoginAttemptModel, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	loginAttempt, ok := b.loginAttempts[key]
+	if !ok {
+		return nil, nil
+	}
+	res := *loginAttempt
+
+	return &res, nil
+}
+
+func (b *baseMemoryLoginAttemptRepository) RecordFailedLoginAttempt(ctx context.Context, key string, failedAt time.Time) (*domain.LoginAttemptModel, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Expired entries are dropped on write so the map does not grow with every key ever seen
+	windowStart := failedAt.Add(-domain.LoginFailureWindow)
+	for k, loginAttempt := range b.loginAttempts {
+		if isLoginAttemptExpired(loginAttempt, windowStart) {
+			delete(b.loginAttempts, k)
+		}
+	}
+
+	loginAttempt, ok := b.loginAttempts[key]
+	if !ok {
+		loginAttempt = &domain.LoginAttemptModel{Key: key}
+		b.loginAttempts[key] = loginAttempt
+	}
+	loginAttempt.Failures++
+	loginAttempt.LastFailedAt = failedAt
+	res := *loginAttempt
+
+	return &res, nil
+}
+
+func (b *baseMemoryLoginAttemptRepository) LockLoginAttempt(ctx context.Context, key string, lockedUntil time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	loginAttempt, ok := b.loginAttempts[key]
+	if ok {
+		loginAttempt.LockedUntil.Time = lockedUntil
+		loginAttempt.LockedUntil.Valid = true
+	}
+
+	return nil
+}
+
+func (b *baseMemoryLoginAttemptRepository) DeleteLoginAttempt(ctx context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.loginAttempts, key)
+
+	return nil
+}
+
+func isLoginAttemptExpired(loginAttempt *domain.LoginAttemptModel, windowStart time.Time) bool {
+	if loginAttempt.LockedUntil.Valid && !loginAttempt.LockedUntil.Time.Before(windowStart) {
+		return false
+	}
+
+	return loginAttempt.LastFailedAt.Before(windowStart)
+}
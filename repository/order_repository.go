@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+type baseOrderRepository struct {
+	db          *sqlx.DB
+	productUtil domain.ProductUtil
+}
+
+func NewOrderRepository(db *sqlx.DB, productUtil domain.ProductUtil) domain.OrderRepository {
+	return &baseOrderRepository{db: db, productUtil: productUtil}
+}
+
+// CreateOrder turns the cart into an order, taking the stock of its items and emptying it. The order items and
+// totals are built from the cart items read under the cart lock, the cart the caller read may be stale.
+func (b *baseOrderRepository) CreateOrder(ctx context.Context, orderPayload *domain.OrderRepositoryPayloadCreateOrder) (string, error) {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// Products before carts, see lockProduct. The product of an item added before the cart is locked is
+	// locked when its stock is taken below.
+	_, err = tx.ExecContext(ctx, `
+	SELECT id
+	FROM products
+	WHERE id IN (SELECT product_id FROM cart_items WHERE cart_id = $1)
+	ORDER BY id
+	FOR UPDATE;
+	`, orderPayload.CartID)
+	if err != nil {
+		return "", err
+	}
+
+	err = lockCart(ctx, tx, orderPayload.CartID)
+	if err != nil {
+		return "", err
+	}
+
+	var cartItems []domain.CartItemModel
+	err = tx.SelectContext(ctx, &cartItems, "SELECT * FROM cart_items WHERE cart_id = $1 ORDER BY product_id;", orderPayload.CartID)
+	if err != nil {
+		return "", err
+	}
+	if len(cartItems) == 0 {
+		return "", domain.ErrCartEmpty
+	}
+
+	orderPayload.OrderItems = make([]domain.OrderRepositoryPayloadCreateOrderItem, 0, len(cartItems))
+	orderPayload.Quantity = 0
+	orderPayload.TotalPriceValue = 0
+	orderPayload.TotalWeightValue = 0
+	for _, cartItem := range cartItems {
+		orderItem, err := b.takeStock(ctx, tx, orderPayload, cartItem)
+		if err != nil {
+			return "", err
+		}
+
+		orderPayload.OrderItems = append(orderPayload.OrderItems, *orderItem)
+		orderPayload.Quantity += orderItem.Quantity
+		orderPayload.TotalPriceValue += orderItem.TotalPriceValue
+		orderPayload.TotalWeightValue += orderItem.TotalWeightValue
+	}
+	orderPayload.TotalWeightValue = math.Round(orderPayload.TotalWeightValue*100) / 100
+	orderPayload.TotalWeight = b.productUtil.FormatWeight(orderPayload.TotalWeightValue)
+	orderPayload.TotalPrice, err = b.productUtil.FormatRupiah(orderPayload.TotalPriceValue)
+	if err != nil {
+		return "", err
+	}
+
+	query, args, err := tx.BindNamed(`
+	INSERT INTO orders (uid, status, quantity, total_price, total_price_value, total_weight, total_weight_value, user_id, created_at, updated_at)
+	VALUES (:uid, :status, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :user_id, :created_at, :updated_at)
+	RETURNING id;
+	`, orderPayload)
+	if err != nil {
+		return "", err
+	}
+	var orderID int
+	err = tx.GetContext(ctx, &orderID, query, args...)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO order_status_history (uid, to_status, order_id, changed_by_user_id, created_at)
+	VALUES ($1, $2, $3, $4, $5);
+	`, utils.GenerateMetadata().UID(), orderPayload.Status, orderID, orderPayload.UserID, orderPayload.CreatedAt)
+	if err != nil {
+		return "", err
+	}
+
+	for _, orderItem := range orderPayload.OrderItems {
+		orderItem.OrderID = orderID
+		_, err = tx.NamedExecContext(ctx, `
+		INSERT INTO order_items
+		(uid, quantity, total_price, total_price_value, total_weight, total_weight_value, product_name, product_slug, product_image, product_weight, product_weight_value, base_price, base_price_value, offer_price, offer_price_value, discount, order_id, product_id, created_at, updated_at)
+		VALUES (:uid, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :product_name, :product_slug, :product_image, :product_weight, :product_weight_value, :base_price, :base_price_value, :offer_price, :offer_price_value, :discount, :order_id, :product_id, :created_at, :updated_at);
+		`, orderItem)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM cart_items WHERE cart_id = $1;", orderPayload.CartID)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM stock_reservations WHERE cart_id = $1;", orderPayload.CartID)
+	if err != nil {
+		return "", err
+	}
+
+	err = recalculateCart(ctx, tx, b.productUtil, orderPayload.CartID, orderPayload.UpdatedAt)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return "", err
+	}
+
+	return orderPayload.UID, nil
+}
+
+// takeStock decrements the stock of the cart item's product and returns the order item for it. The checks
+// run on the locked product row, stock held by other carts' unexpired reservations is not available.
+func (b *baseOrderRepository) takeStock(ctx context.Context, tx *txScope, orderPayload *domain.OrderRepositoryPayloadCreateOrder, cartItem domain.CartItemModel) (*domain.OrderRepositoryPayloadCreateOrderItem, error) {
+	product, err := lockProduct(ctx, tx, cartItem.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status != "ACTIVE" {
+		return nil, fmt.Errorf("%w: %s", domain.ErrProductUnavailable, product.Name)
+	}
+	if product.OfferPriceValue != cartItem.OfferPriceValue {
+		return nil, fmt.Errorf("%w: %s", domain.ErrPriceChanged, product.Name)
+	}
+
+	var reservedByOthers int
+	err = tx.GetContext(ctx, &reservedByOthers, `
+	SELECT COALESCE(SUM(quantity), 0)
+	FROM stock_reservations
+	WHERE product_id = $1 AND cart_id <> $2 AND expires_at > $3;
+	`, product.ID, orderPayload.CartID, orderPayload.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if product.Stock-reservedByOthers < cartItem.Quantity {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInsufficientStock, product.Name)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE products SET stock = stock - $1, updated_at = $2 WHERE id = $3;", cartItem.Quantity, orderPayload.UpdatedAt, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPriceValue := cartItem.Quantity * product.OfferPriceValue
+	totalPrice, err := b.productUtil.FormatRupiah(totalPriceValue)
+	if err != nil {
+		return nil, err
+	}
+	totalWeightValue := math.Round(float64(cartItem.Quantity)*product.WeightValue*100) / 100
+	var productImage string
+	if len(product.Images) > 0 {
+		productImage = product.Images[0]
+	}
+
+	return &domain.OrderRepositoryPayloadCreateOrderItem{
+		UID:                utils.GenerateMetadata().UID(),
+		Quantity:           cartItem.Quantity,
+		TotalPrice:         totalPrice,
+		TotalPriceValue:    totalPriceValue,
+		TotalWeight:        b.productUtil.FormatWeight(totalWeightValue),
+		TotalWeightValue:   totalWeightValue,
+		ProductName:        product.Name,
+		ProductSlug:        product.Slug,
+		ProductImage:       productImage,
+		ProductWeight:      product.Weight,
+		ProductWeightValue: product.WeightValue,
+		BasePrice:          product.BasePrice,
+		BasePriceValue:     product.BasePriceValue,
+		OfferPrice:         product.OfferPrice,
+		OfferPriceValue:    product.OfferPriceValue,
+		Discount:           product.Discount,
+		ProductID:          product.ID,
+		CreatedAt:          orderPayload.CreatedAt,
+		UpdatedAt:          orderPayload.UpdatedAt,
+	}, nil
+}
+
+func (b *baseOrderRepository) getOrder(ctx context.Context, query string, args ...interface{}) (*domain.OrderModel, error) {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	var order domain.OrderModel
+	var orderItems []domain.OrderItemModel
+	var statusHistory []domain.OrderStatusHistoryModel
+
+	err = tx.GetContext(ctx, &order, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	err = tx.SelectContext(ctx, &orderItems, "SELECT * FROM order_items WHERE order_id = $1 ORDER BY id ASC;", order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.SelectContext(ctx, &statusHistory, "SELECT * FROM order_status_history WHERE order_id = $1 ORDER BY id ASC;", order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	order.OrderItems = orderItems
+	order.StatusHistory = statusHistory
+
+	return &order, nil
+}
+
+func (b *baseOrderRepository) GetOrderByUID(ctx context.Context, UID string) (*domain.OrderModel, error) {
+	return b.getOrder(ctx, "SELECT * FROM orders WHERE uid = $1;", UID)
+}
+
+func (b *baseOrderRepository) GetOrderByUIDAndUserID(ctx context.Context, UID string, userID int) (*domain.OrderModel, error) {
+	return b.getOrder(ctx, "SELECT * FROM orders WHERE uid = $1 AND user_id = $2;", UID, userID)
+}
+
+func (b *baseOrderRepository) LockOrder(ctx context.Context, orderID int) error {
+	var ID int
+	err := conn(ctx, b.db).GetContext(ctx, &ID, "SELECT id FROM orders WHERE id = $1 FOR UPDATE;", orderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrOrderNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseOrderRepository) ListOrdersByUserID(ctx context.Context, userID, limit, offset int) ([]*domain.OrderModel, error) {
+	var orders []*domain.OrderModel
+
+	err := conn(ctx, b.db).SelectContext(ctx, &orders, `
+		SELECT *
+		FROM orders
+		WHERE user_id = $1
+		ORDER BY id DESC
+		LIMIT $2
+		OFFSET $3;
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (b *baseOrderRepository) ListOrders(ctx context.Context, limit, offset int, status string) ([]*domain.OrderModel, error) {
+	var orders []*domain.OrderModel
+
+	err := conn(ctx, b.db).SelectContext(ctx, &orders, `
+		SELECT *
+		FROM orders
+		WHERE $1 = '' OR status::TEXT = $1
+		ORDER BY id DESC
+		LIMIT $2
+		OFFSET $3;
+	`, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (b *baseOrderRepository) UpdateOrderStatus(ctx context.Context, payload *domain.OrderRepositoryPayloadUpdateOrderStatus) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// Only update if the status is still the one the transition was validated against.
+	res, err := tx.NamedExecContext(ctx, `
+	UPDATE orders
+	SET status = :to_status,
+			updated_at = :updated_at
+	WHERE id = :order_id AND status = :from_status;
+	`, payload)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrOrderStatusChanged
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO order_status_history (uid, from_status, to_status, note, order_id, changed_by_user_id, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7);
+	`, utils.GenerateMetadata().UID(), payload.FromStatus, payload.ToStatus, payload.Note, payload.OrderID, payload.ChangedByUserID, payload.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if payload.Restock {
+		_, err = tx.ExecContext(ctx, `
+		UPDATE products
+		SET stock = products.stock + order_items.quantity,
+				updated_at = $1
+		FROM order_items
+		WHERE order_items.order_id = $2 AND order_items.product_id = products.id;
+		`, payload.UpdatedAt, payload.OrderID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+type basePaymentRepository struct {
+	db *sqlx.DB
+}
+
+func NewPaymentRepository(db *sqlx.DB) domain.PaymentRepository {
+	return &basePaymentRepository{db: db}
+}
+
+func (b *basePaymentRepository) CreatePayment(ctx context.Context, paymentPayload *domain.PaymentRepositoryPayloadCreatePayment) (string, error) {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO payments
+	(uid, gateway, method, channel, amount, currency, status, transaction_id, va_number, qr_string, action_url, expires_at, order_id, created_at, updated_at)
+	VALUES (:uid, :gateway, :method, :channel, :amount, :currency, :status, :transaction_id, :va_number, :qr_string, :action_url, :expires_at, :order_id, :created_at, :updated_at);
+	`, paymentPayload)
+	if err != nil {
+		return "", err
+	}
+
+	return paymentPayload.UID, nil
+}
+
+func (b *basePaymentRepository) GetActivePaymentByOrderID(ctx context.Context, orderID int) (*domain.PaymentModel, error) {
+	var payment domain.PaymentModel
+	err := conn(ctx, b.db).GetContext(ctx, &payment, `
+	SELECT *
+	FROM payments
+	WHERE order_id = $1 AND status IN ('PENDING', 'PAID')
+	ORDER BY id DESC
+	LIMIT 1;
+	`, orderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+func (b *basePaymentRepository) ExpirePayment(ctx context.Context, paymentID int, updatedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, `
+	UPDATE payments
+	SET status = 'EXPIRED',
+			updated_at = $1
+	WHERE id = $2 AND status = 'PENDING';
+	`, updatedAt, paymentID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *basePaymentRepository) ApplyNotification(ctx context.Context, payload *domain.PaymentRepositoryPayloadApplyNotification) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// Orders before payments, the order a new payment is created in. Locking the payment as well makes
+	// concurrent deliveries of the same notification apply once.
+	var orderID int
+	err = tx.GetContext(ctx, &orderID, "SELECT order_id FROM payments WHERE transaction_id = $1;", payload.TransactionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrPaymentNotFound
+		}
+
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "SELECT id FROM orders WHERE id = $1 FOR UPDATE;", orderID)
+	if err != nil {
+		return err
+	}
+	var payment domain.PaymentModel
+	err = tx.GetContext(ctx, &payment, "SELECT * FROM payments WHERE transaction_id = $1 FOR UPDATE;", payload.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	// A charge that was replaced by a newer one must not pay the order, the newer charge is still payable.
+	if payload.Status == domain.PaymentStatusPaid && payment.Status != domain.PaymentStatusPaid {
+		var superseded bool
+		err = tx.GetContext(ctx, &superseded, "SELECT EXISTS (SELECT 1 FROM payments WHERE order_id = $1 AND id > $2);", payment.OrderID, payment.ID)
+		if err != nil {
+			return err
+		}
+		if superseded || payment.Status == domain.PaymentStatusExpired {
+			return domain.ErrPaymentSuperseded
+		}
+	}
+
+	// Gateways retry webhooks, so anything that is no longer pending has already been applied.
+	if payment.Status != domain.PaymentStatusPending || payload.Status == domain.PaymentStatusPending {
+		return nil
+	}
+	if payload.Status == domain.PaymentStatusPaid && payload.Amount != payment.Amount {
+		return domain.ErrPaymentAmountMismatch
+	}
+
+	var paidAt sql.NullTime
+	if payload.Status == domain.PaymentStatusPaid {
+		paidAt = sql.NullTime{Time: payload.UpdatedAt, Valid: true}
+	}
+	_, err = tx.ExecContext(ctx, `
+	UPDATE payments
+	SET status = $1,
+			paid_at = COALESCE($2, paid_at),
+			updated_at = $3
+	WHERE id = $4;
+	`, payload.Status, paidAt, payload.UpdatedAt, payment.ID)
+	if err != nil {
+		return err
+	}
+
+	if payload.Status == domain.PaymentStatusPaid {
+		res, err := tx.ExecContext(ctx, `
+		UPDATE orders
+		SET status = 'PAID',
+				updated_at = $1
+		WHERE id = $2 AND status = 'PENDING_PAYMENT';
+		`, payload.UpdatedAt, payment.OrderID)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		// An order cancelled before the payment settled keeps its status and needs a refund.
+		if rowsAffected > 0 {
+			_, err = tx.ExecContext(ctx, `
+			INSERT INTO order_status_history (uid, from_status, to_status, note, order_id, created_at)
+			VALUES ($1, 'PENDING_PAYMENT', 'PAID', $2, $3, $4);
+			`, utils.GenerateMetadata().UID(), fmt.Sprintf("paid via %s transaction %s", payment.Gateway, payment.TransactionID), payment.OrderID, payload.UpdatedAt)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *basePaymentRepository) RefundPayment(ctx context.Context, payload *domain.PaymentRepositoryPayloadRefundPayment) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// Orders before payments, see ApplyNotification.
+	res, err := tx.ExecContext(ctx, `
+	UPDATE orders
+	SET status = 'REFUNDED',
+			updated_at = $1
+	WHERE id = $2 AND status = $3;
+	`, payload.UpdatedAt, payload.OrderID, payload.OrderFromStatus)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrOrderStatusChanged
+	}
+
+	res, err = tx.ExecContext(ctx, `
+	UPDATE payments
+	SET status = 'REFUNDED',
+			updated_at = $1
+	WHERE id = $2 AND status = 'PAID';
+	`, payload.UpdatedAt, payload.PaymentID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err = res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrPaymentNotRefundable
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO order_status_history (uid, from_status, to_status, note, order_id, changed_by_user_id, created_at)
+	VALUES ($1, $2, 'REFUNDED', $3, $4, $5, $6);
+	`, utils.GenerateMetadata().UID(), payload.OrderFromStatus, payload.Note, payload.OrderID, payload.ChangedByUserID, payload.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,61 +1,68 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"math"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
 )
 
 type baseProductRepository struct {
-	db *sqlx.DB
+	db          *sqlx.DB
+	productUtil domain.ProductUtil
 }
 
-func NewProductRepository(db *sqlx.DB) domain.ProductRepository {
-	return &baseProductRepository{db: db}
+func NewProductRepository(db *sqlx.DB, productUtil domain.ProductUtil) domain.ProductRepository {
+	return &baseProductRepository{db: db, productUtil: productUtil}
 }
 
-func (b *baseProductRepository) Create(productPayload *domain.ProductRepositoryPayloadCreateProduct) (string, error) {
-	_, err := b.db.NamedExec(`
+// Create stores the product, a product without a SKU stores NULL so the unique constraint allows any number of them.
+func (b *baseProductRepository) Create(ctx context.Context, productPayload *domain.ProductRepositoryPayloadCreateProduct) (string, error) {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
 	INSERT INTO products (
     uid, name, slug, sku, description, images, weight, weight_value, base_price_value, base_price, offer_price_value, offer_price, discount, stock, status, created_at, updated_at
   )
 	VALUES (
-    :uid, :name, :slug, :sku, :description, :images, :weight, :weight_value,:base_price_value, :base_price, :offer_price_value, :offer_price, :discount, :stock, :status, :created_at, :updated_at
+    :uid, :name, :slug, NULLIF(:sku, ''), :description, :images, :weight, :weight_value,:base_price_value, :base_price, :offer_price_value, :offer_price, :discount, :stock, :status, :created_at, :updated_at
   );
 	`, productPayload)
 	if err != nil {
-		return "", err
+		return "", fromUniqueViolation(err)
 	}
 
 	return productPayload.UID, nil
 }
 
-func (b *baseProductRepository) List(limit, cursor int, direction string) ([]*domain.ProductModel, error) {
+func (b *baseProductRepository) List(ctx context.Context, limit, cursor int, direction string) ([]*domain.ProductModel, error) {
 	var products []*domain.ProductModel
 
 	if direction == "" {
-		err := b.db.Select(&products, `
+		err := conn(ctx, b.db).SelectContext(ctx, &products, `
 			SELECT *
 			FROM products
+			ORDER BY id ASC
 			LIMIT $1;
 		`, limit)
 		if err != nil {
 			return nil, err
 		}
 	} else if direction == "next" {
-		err := b.db.Select(&products, `
+		err := conn(ctx, b.db).SelectContext(ctx, &products, `
 			SELECT *
 			FROM products
 			WHERE id > $1
+			ORDER BY id ASC
 			LIMIT $2;
 		`, cursor, limit)
 		if err != nil {
-			return nil, nil
+			return nil, err
 		}
 	} else {
-		err := b.db.Select(&products, `
+		err := conn(ctx, b.db).SelectContext(ctx, &products, `
 			SELECT * 
 			FROM (
 				SELECT *
@@ -67,16 +74,16 @@ func (b *baseProductRepository) List(limit, cursor int, direction string) ([]*do
 			ORDER by id ASC;
 		`, cursor, limit)
 		if err != nil {
-			return nil, nil
+			return nil, err
 		}
 	}
 
 	return products, nil
 }
 
-func (b *baseProductRepository) GetByUID(UID string) (*domain.ProductModel, error) {
+func (b *baseProductRepository) GetByID(ctx context.Context, ID int) (*domain.ProductModel, error) {
 	var product domain.ProductModel
-	err := b.db.Get(&product, "SELECT * FROM products WHERE UID = $1;", UID)
+	err := conn(ctx, b.db).GetContext(ctx, &product, "SELECT * FROM products WHERE id = $1;", ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -88,12 +95,38 @@ func (b *baseProductRepository) GetByUID(UID string) (*domain.ProductModel, erro
 	return &product, nil
 }
 
-func (b *baseProductRepository) UpdateByUID(productPayload *domain.ProductRepositoryPayloadUpdateProduct) error {
-	_, err := b.db.NamedExec(`
+func (b *baseProductRepository) GetByUID(ctx context.Context, UID string) (*domain.ProductModel, error) {
+	var product domain.ProductModel
+	err := conn(ctx, b.db).GetContext(ctx, &product, "SELECT * FROM products WHERE UID = $1;", UID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// UpdateByUID updates the product and refreshes the snapshot of it in every cart item in the same
+// transaction, recomputing the totals of the affected carts.
+func (b *baseProductRepository) UpdateByUID(ctx context.Context, productPayload *domain.ProductRepositoryPayloadUpdateProduct) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// The update locks the product before the carts, the order every transaction that locks both uses. Carts
+	// adding the product wait for it, so the carts selected below are all the carts holding the product.
+	_, err = tx.NamedExecContext(ctx, `
   UPDATE products 
 	SET name = :name,
 			slug = :slug,
-			sku = :sku,
+			sku = NULLIF(:sku, ''),
 			description = :description,
 			images = :images,
 			weight = :weight,
@@ -108,6 +141,87 @@ func (b *baseProductRepository) UpdateByUID(productPayload *domain.ProductReposi
 			updated_at = :updated_at
 	WHERE uid = :uid;
 	`, productPayload)
+	if err != nil {
+		return fromUniqueViolation(err)
+	}
+
+	var cartIDs []int
+	err = tx.SelectContext(ctx, &cartIDs, `
+	SELECT id
+	FROM carts
+	WHERE id IN (
+		SELECT cart_items.cart_id
+		FROM cart_items
+		JOIN products ON products.id = cart_items.product_id
+		WHERE products.uid = $1
+	)
+	ORDER BY id
+	FOR UPDATE;
+	`, productPayload.UID)
+	if err != nil {
+		return err
+	}
+
+	var cartItems []domain.CartItemModel
+	err = tx.SelectContext(ctx, &cartItems, `
+	SELECT cart_items.*
+	FROM cart_items
+	JOIN products ON products.id = cart_items.product_id
+	WHERE products.uid = $1;
+	`, productPayload.UID)
+	if err != nil {
+		return err
+	}
+
+	productImage := ""
+	if len(productPayload.Images) > 0 {
+		productImage = productPayload.Images[0]
+	}
+	for _, cartItem := range cartItems {
+		totalPriceValue := cartItem.Quantity * productPayload.OfferPriceValue
+		totalPrice, err := b.productUtil.FormatRupiah(totalPriceValue)
+		if err != nil {
+			return err
+		}
+		totalWeightValue := math.Round(float64(cartItem.Quantity)*productPayload.WeightValue*100) / 100
+
+		_, err = tx.ExecContext(ctx, `
+		UPDATE cart_items
+		SET total_price = $1,
+				total_price_value = $2,
+				total_weight = $3,
+				total_weight_value = $4,
+				product_name = $5,
+				product_slug = $6,
+				product_image = $7,
+				product_weight = $8,
+				product_weight_value = $9,
+				base_price = $10,
+				base_price_value = $11,
+				offer_price = $12,
+				offer_price_value = $13,
+				discount = $14,
+				product_status = $15,
+				price_changed = price_changed OR offer_price_value <> $13,
+				updated_at = $16
+		WHERE id = $17;
+		`, totalPrice, totalPriceValue, b.productUtil.FormatWeight(totalWeightValue), totalWeightValue,
+			productPayload.Name, productPayload.Slug, productImage, productPayload.Weight, productPayload.WeightValue,
+			productPayload.BasePrice, productPayload.BasePriceValue, productPayload.OfferPrice, productPayload.OfferPriceValue,
+			productPayload.Discount, productPayload.Status, productPayload.UpdatedAt, cartItem.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, cartID := range cartIDs {
+		err = recalculateCart(ctx, tx, b.productUtil, cartID, productPayload.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
@@ -115,8 +229,54 @@ func (b *baseProductRepository) UpdateByUID(productPayload *domain.ProductReposi
 	return nil
 }
 
-func (b *baseProductRepository) DeleteByUID(UID string) error {
-	_, err := b.db.Exec("DELETE FROM products WHERE uid = $1;", UID)
+// DeleteByUID deletes the product, its cart items go with it and the totals of the carts that held it are
+// recalculated.
+func (b *baseProductRepository) DeleteByUID(ctx context.Context, UID string) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// Products before carts, see lockProduct.
+	var productID int
+	err = tx.GetContext(ctx, &productID, "SELECT id FROM products WHERE uid = $1 FOR UPDATE;", UID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	var cartIDs []int
+	err = tx.SelectContext(ctx, &cartIDs, `
+	SELECT id
+	FROM carts
+	WHERE id IN (SELECT cart_id FROM cart_items WHERE product_id = $1)
+	ORDER BY id
+	FOR UPDATE;
+	`, productID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM products WHERE id = $1;", productID)
+	if err != nil {
+		return err
+	}
+
+	updatedAt := time.Now().UTC()
+	for _, cartID := range cartIDs {
+		err = recalculateCart(ctx, tx, b.productUtil, cartID, updatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
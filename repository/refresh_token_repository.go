@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseRefreshTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewRefreshTokenRepository(db *sqlx.DB) domain.RefreshTokenRepository {
+	return &baseRefreshTokenRepository{db: db}
+}
+
+func (b *baseRefreshTokenRepository) CreateRefreshToken(ctx context.Context, refreshTokenPayload *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error {
+	_, err := conn(ctx, b.db).NamedExecContext(ctx, `
+	INSERT INTO refresh_tokens (uid, family_uid, token_hash, expires_at, user_agent, ip_address, user_id, created_at, updated_at)
+	VALUES (:uid, :family_uid, :token_hash, :expires_at, :user_agent, :ip_address, :user_id, :created_at, :updated_at);
+	`, refreshTokenPayload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseRefreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshTokenModel, error) {
+	var refreshToken domain.RefreshTokenModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &refreshToken, "SELECT * FROM refresh_tokens WHERE token_hash = $1;", tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+// RotateRefreshToken marks the used token and stores its replacement in one transaction, it returns
+// domain.ErrRefreshTokenReused if another request has used or revoked the token in the meantime.
+func (b *baseRefreshTokenRepository) RotateRefreshToken(ctx context.Context, usedID int, usedAt time.Time, refreshTokenPayload *domain.RefreshTokenRepositoryPayloadCreateRefreshToken) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	res, err := tx.ExecContext(ctx, `
+	UPDATE refresh_tokens SET used_at = $1, updated_at = $1
+	WHERE id = $2 AND used_at IS NULL AND revoked_at IS NULL;
+	`, usedAt, usedID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrRefreshTokenReused
+	}
+
+	_, err = tx.NamedExecContext(ctx, `
+	INSERT INTO refresh_tokens (uid, family_uid, token_hash, expires_at, user_agent, ip_address, user_id, created_at, updated_at)
+	VALUES (:uid, :family_uid, :token_hash, :expires_at, :user_agent, :ip_address, :user_id, :created_at, :updated_at);
+	`, refreshTokenPayload)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseRefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyUID string, revokedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, `
+	UPDATE refresh_tokens SET revoked_at = $1, updated_at = $1
+	WHERE family_uid = $2 AND revoked_at IS NULL;
+	`, revokedAt, familyUID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseRefreshTokenRepository) RevokeRefreshTokensByUserID(ctx context.Context, userID int, revokedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, `
+	UPDATE refresh_tokens SET revoked_at = $1, updated_at = $1
+	WHERE user_id = $2 AND revoked_at IS NULL;
+	`, revokedAt, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListActiveSessionsByUserID returns a session for every family that still has a usable refresh token.
+func (b *baseRefreshTokenRepository) ListActiveSessionsByUserID(ctx context.Context, userID int, now time.Time) ([]*domain.SessionModel, error) {
+	sessions := []*domain.SessionModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &sessions, `
+	SELECT rt.family_uid, rt.user_agent, rt.ip_address, rt.created_at AS last_seen_at, rt.expires_at,
+	(SELECT MIN(created_at) FROM refresh_tokens WHERE family_uid = rt.family_uid) AS created_at
+	FROM refresh_tokens rt
+	WHERE rt.user_id = $1 AND rt.used_at IS NULL AND rt.revoked_at IS NULL AND rt.expires_at > $2
+	ORDER BY rt.created_at DESC;
+	`, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
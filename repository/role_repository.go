@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type baseRoleRepository struct {
+	db *sqlx.DB
+}
+
+func NewRoleRepository(db *sqlx.DB) domain.RoleRepository {
+	return &baseRoleRepository{db: db}
+}
+
+func (b *baseRoleRepository) ListRoles(ctx context.Context) ([]*domain.RoleModel, error) {
+	roles := []*domain.RoleModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &roles, "SELECT * FROM roles ORDER BY id;")
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (b *baseRoleRepository) ListRolesByUserID(ctx context.Context, userID int) ([]*domain.RoleModel, error) {
+	roles := []*domain.RoleModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &roles, `
+	SELECT r.* FROM roles r
+	JOIN user_roles ur ON ur.role_id = r.id
+	WHERE ur.user_id = $1
+	ORDER BY r.id;
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (b *baseRoleRepository) GetRoleByName(ctx context.Context, name string) (*domain.RoleModel, error) {
+	var role domain.RoleModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &role, "SELECT * FROM roles WHERE name = $1;", name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (b *baseRoleRepository) ListPermissionsByRoleID(ctx context.Context, roleID int) ([]string, error) {
+	permissions := []string{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &permissions, `
+	SELECT p.name FROM permissions p
+	JOIN role_permissions rp ON rp.permission_id = p.id
+	WHERE rp.role_id = $1
+	ORDER BY p.name;
+	`, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (b *baseRoleRepository) HasPermission(ctx context.Context, userID int, permission string) (bool, error) {
+	var hasPermission bool
+
+	err := conn(ctx, b.db).GetContext(ctx, &hasPermission, `
+	SELECT EXISTS (
+		SELECT 1 FROM user_roles ur
+		JOIN role_permissions rp ON rp.role_id = ur.role_id
+		JOIN permissions p ON p.id = rp.permission_id
+		WHERE ur.user_id = $1 AND p.name = $2
+	);
+	`, userID, permission)
+	if err != nil {
+		return false, err
+	}
+
+	return hasPermission, nil
+}
+
+// AssignRole returns domain.ErrRoleAlreadyExist if the user already has the role.
+func (b *baseRoleRepository) AssignRole(ctx context.Context, userID, roleID int) error {
+	res, err := conn(ctx, b.db).ExecContext(ctx, `
+	INSERT INTO user_roles (user_id, role_id) VALUES ($1, $2)
+	ON CONFLICT DO NOTHING;
+	`, userID, roleID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrRoleAlreadyExist
+	}
+
+	return nil
+}
+
+// RemoveRole returns domain.ErrUserRoleNotFound if the user does not have the role.
+func (b *baseRoleRepository) RemoveRole(ctx context.Context, userID, roleID int) error {
+	res, err := conn(ctx, b.db).ExecContext(ctx, "DELETE FROM user_roles WHERE user_id = $1 AND role_id = $2;", userID, roleID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrUserRoleNotFound
+	}
+
+	return nil
+}
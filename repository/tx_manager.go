@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type txContextKey struct{}
+
+type baseTxManager struct {
+	db *sqlx.DB
+}
+
+func NewTxManager(db *sqlx.DB) domain.TxManager {
+	return &baseTxManager{db: db}
+}
+
+func (b *baseTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := b.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	err = fn(context.WithValue(ctx, txContextKey{}, tx))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// executor is implemented by both *sqlx.DB and *sqlx.Tx.
+type executor interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+// conn returns the transaction of ctx, or db when ctx is not within a transaction.
+func conn(ctx context.Context, db *sqlx.DB) executor {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+// txScope is the transaction of a repository method. It joins the transaction of ctx when there is one,
+// Commit and Rollback are then left to the outer WithinTransaction.
+type txScope struct {
+	*sqlx.Tx
+	owned bool
+}
+
+func beginTx(ctx context.Context, db *sqlx.DB) (*txScope, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return &txScope{Tx: tx}, nil
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txScope{Tx: tx, owned: true}, nil
+}
+
+func (t *txScope) Commit() error {
+	if !t.owned {
+		return nil
+	}
+
+	return t.Tx.Commit()
+}
+
+func (t *txScope) Rollback() error {
+	if !t.owned {
+		return nil
+	}
+
+	return t.Tx.Rollback()
+}
@@ -1,12 +1,13 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
-	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
 )
 
 type baseUserRepository struct {
@@ -17,16 +18,9 @@ func NewUserRepository(db *sqlx.DB) domain.UserRepository {
 	return &baseUserRepository{db: db}
 }
 
-func (b *baseUserRepository) CreateUser(userPayload *domain.UserRepositoryPayloadCreateUser) (int, error) {
-	tx, err := b.db.Beginx()
-	if err != nil {
-		return 0, err
-	}
-	defer func() {
-		tx.Rollback()
-	}()
-
-	query, args, err := tx.BindNamed(`
+func (b *baseUserRepository) CreateUser(ctx context.Context, userPayload *domain.UserRepositoryPayloadCreateUser) (int, error) {
+	db := conn(ctx, b.db)
+	query, args, err := db.BindNamed(`
 	INSERT INTO users (uid, firebase_uid, email, name, phone, profile_image, created_at, updated_at)
 	VALUES (:uid, :firebase_uid, :email, :name, :phone, :profile_image, :created_at, :updated_at)
 	RETURNING id;
@@ -35,43 +29,7 @@ func (b *baseUserRepository) CreateUser(userPayload *domain.UserRepositoryPayloa
 		return 0, err
 	}
 	var userID int
-	err = tx.Get(&userID, query, args...)
-	if err != nil {
-		return 0, err
-	}
-
-	metadata := utils.GenerateMetadata()
-	if userPayload.IsAdmin {
-		_, err := tx.Exec(`
-		INSERT INTO admins (uid, email, user_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5);
-		`, metadata.UID(), userPayload.Email, userID, metadata.CreatedAt, metadata.UpdatedAt)
-		if err != nil {
-			return 0, err
-		}
-	}
-
-	metadata = utils.GenerateMetadata()
-	cart := domain.CartModel{
-		UID:              metadata.UID(),
-		Quantity:         0,
-		TotalPrice:       "Rp 0",
-		TotalPriceValue:  0,
-		TotalWeight:      "Rp 0",
-		TotalWeightValue: 0,
-		UserID:           userID,
-		CreatedAt:        metadata.CreatedAt,
-		UpdatedAt:        metadata.UpdatedAt,
-	}
-	_, err = tx.NamedExec(`
-	INSERT INTO carts (uid, quantity, total_price, total_price_value, total_weight, total_weight_value, user_id, created_at, updated_at)
-	VALUES (:uid, :quantity, :total_price, :total_price_value, :total_weight, :total_weight_value, :user_id, :created_at, :updated_at)
-	`, cart)
-	if err != nil {
-		return 0, err
-	}
-
-	err = tx.Commit()
+	err = db.GetContext(ctx, &userID, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -79,8 +37,8 @@ func (b *baseUserRepository) CreateUser(userPayload *domain.UserRepositoryPayloa
 	return userID, nil
 }
 
-func (b *baseUserRepository) CreateAdmin(adminPayload *domain.UserRepositoryPayloadCreateAdmin) error {
-	_, err := b.db.Exec(`
+func (b *baseUserRepository) CreateAdmin(ctx context.Context, adminPayload *domain.UserRepositoryPayloadCreateAdmin) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, `
 		INSERT INTO admins (uid, email, user_id, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5);
 		`, adminPayload.UID, adminPayload.Email, adminPayload.UserID, adminPayload.CreatedAt, adminPayload.UpdatedAt)
@@ -91,10 +49,25 @@ func (b *baseUserRepository) CreateAdmin(adminPayload *domain.UserRepositoryPayl
 	return nil
 }
 
-func (b *baseUserRepository) GetUserByEmail(email string) (*domain.UserModel, error) {
+func (b *baseUserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.UserModel, error) {
+	var user domain.UserModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1;", email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (b *baseUserRepository) GetUserByFirebaseUID(ctx context.Context, UID string) (*domain.UserModel, error) {
 	var user domain.UserModel
 
-	err := b.db.Get(&user, "SELECT * FROM users WHERE email = $1;", email)
+	err := conn(ctx, b.db).GetContext(ctx, &user, "SELECT * FROM users WHERE firebase_uid = $1;", UID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -106,10 +79,10 @@ func (b *baseUserRepository) GetUserByEmail(email string) (*domain.UserModel, er
 	return &user, nil
 }
 
-func (b *baseUserRepository) GetUserByFirebaseUID(UID string) (*domain.UserModel, error) {
+func (b *baseUserRepository) GetUserByID(ctx context.Context, ID int) (*domain.UserModel, error) {
 	var user domain.UserModel
 
-	err := b.db.Get(&user, "SELECT * FROM users WHERE firebase_uid = $1;", UID)
+	err := conn(ctx, b.db).GetContext(ctx, &user, "SELECT * FROM users WHERE id = $1;", ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -121,10 +94,10 @@ func (b *baseUserRepository) GetUserByFirebaseUID(UID string) (*domain.UserModel
 	return &user, nil
 }
 
-func (b *baseUserRepository) GetUserByUID(UID string) (*domain.UserModel, error) {
+func (b *baseUserRepository) GetUserByUID(ctx context.Context, UID string) (*domain.UserModel, error) {
 	var user domain.UserModel
 
-	err := b.db.Get(&user, "SELECT * FROM users WHERE uid = $1;", UID)
+	err := conn(ctx, b.db).GetContext(ctx, &user, "SELECT * FROM users WHERE uid = $1;", UID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -136,10 +109,10 @@ func (b *baseUserRepository) GetUserByUID(UID string) (*domain.UserModel, error)
 	return &user, nil
 }
 
-func (b *baseUserRepository) GetAdminByUserID(userID int) (*domain.AdminModel, error) {
+func (b *baseUserRepository) GetAdminByUserID(ctx context.Context, userID int) (*domain.AdminModel, error) {
 	var admin domain.AdminModel
 
-	err := b.db.Get(&admin, "SELECT * FROM admins WHERE user_id = $1;", userID)
+	err := conn(ctx, b.db).GetContext(ctx, &admin, "SELECT * FROM admins WHERE user_id = $1;", userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -150,3 +123,199 @@ func (b *baseUserRepository) GetAdminByUserID(userID int) (*domain.AdminModel, e
 
 	return &admin, nil
 }
+
+func (b *baseUserRepository) GetAdminByUID(ctx context.Context, UID string) (*domain.AdminModel, error) {
+	var admin domain.AdminModel
+
+	err := conn(ctx, b.db).GetContext(ctx, &admin, "SELECT * FROM admins WHERE uid = $1;", UID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &admin, nil
+}
+
+func (b *baseUserRepository) ListAdmins(ctx context.Context) ([]*domain.AdminModel, error) {
+	admins := []*domain.AdminModel{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &admins, "SELECT * FROM admins ORDER BY id;")
+	if err != nil {
+		return nil, err
+	}
+
+	return admins, nil
+}
+
+// DeleteAdminByUID locks every admin row before counting them, so concurrent revokes can not remove the last admin.
+func (b *baseUserRepository) DeleteAdminByUID(ctx context.Context, UID string) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	var adminUIDs []string
+	err = tx.SelectContext(ctx, &adminUIDs, "SELECT uid FROM admins ORDER BY id FOR UPDATE;")
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, adminUID := range adminUIDs {
+		if adminUID == UID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return domain.ErrAdminNotFound
+	}
+	if len(adminUIDs) == 1 {
+		return domain.ErrLastAdmin
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM admins WHERE uid = $1;", UID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseUserRepository) RevokeTokens(ctx context.Context, userID int, revokedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE users SET tokens_revoked_at = $1, updated_at = $1 WHERE id = $2;", revokedAt, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProfile keeps the email of the admins row in sync with the user, a changed email has to be verified again.
+// Unset fields keep the value of the row, so concurrent updates of other fields are not overwritten.
+func (b *baseUserRepository) UpdateProfile(ctx context.Context, userPayload *domain.UserRepositoryPayloadUpdateProfile) (*domain.UserModel, error) {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	var user domain.UserModel
+	err = tx.GetContext(ctx, &user, `
+	UPDATE users SET email = COALESCE($1, email), name = COALESCE($2, name), phone = COALESCE($3, phone),
+	profile_image = COALESCE($4, profile_image), updated_at = $5,
+	email_verified_at = CASE WHEN email = COALESCE($1, email) THEN email_verified_at END
+	WHERE id = $6
+	RETURNING *;
+	`, userPayload.Email, userPayload.Name, userPayload.Phone, userPayload.ProfileImage, userPayload.UpdatedAt, userPayload.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if userPayload.Email != nil {
+		_, err = tx.ExecContext(ctx, "UPDATE admins SET email = $1, updated_at = $2 WHERE user_id = $3;", user.Email, userPayload.UpdatedAt, user.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (b *baseUserRepository) MarkEmailVerified(ctx context.Context, email string, verifiedAt time.Time) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "UPDATE users SET email_verified_at = $1, updated_at = $1 WHERE email = $2 AND email_verified_at IS NULL;", verifiedAt, email)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AnonymizeUser keeps the users row so orders still reference it, deleting the cart also releases its stock reservations.
+func (b *baseUserRepository) AnonymizeUser(ctx context.Context, userID int, anonymizedAt time.Time) error {
+	tx, err := beginTx(ctx, b.db)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	// The auth UID is overwritten below, it is kept in the queue until the auth provider user is deleted
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO auth_user_deletions (auth_uid, created_at)
+	SELECT firebase_uid, $1 FROM users WHERE id = $2 AND deleted_at IS NULL
+	ON CONFLICT (auth_uid) DO NOTHING;
+	`, anonymizedAt, userID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+	UPDATE users
+	SET email = 'deleted+' || uid || '@deleted.invalid', firebase_uid = 'deleted:' || uid, name = '', phone = '', profile_image = '',
+	email_verified_at = NULL, tokens_revoked_at = $1, deleted_at = $1, updated_at = $1
+	WHERE id = $2;
+	`, anonymizedAt, userID)
+	if err != nil {
+		return err
+	}
+	for _, query := range []string{
+		"DELETE FROM admins WHERE user_id = $1;",
+		"DELETE FROM user_roles WHERE user_id = $1;",
+		"DELETE FROM addresses WHERE user_id = $1;",
+		"DELETE FROM carts WHERE user_id = $1;",
+		"DELETE FROM refresh_tokens WHERE user_id = $1;",
+		"DELETE FROM data_exports WHERE user_id = $1;",
+	} {
+		_, err = tx.ExecContext(ctx, query, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *baseUserRepository) ListAuthUserDeletions(ctx context.Context) ([]string, error) {
+	authUIDs := []string{}
+
+	err := conn(ctx, b.db).SelectContext(ctx, &authUIDs, "SELECT auth_uid FROM auth_user_deletions ORDER BY created_at;")
+	if err != nil {
+		return nil, err
+	}
+
+	return authUIDs, nil
+}
+
+func (b *baseUserRepository) DeleteAuthUserDeletion(ctx context.Context, authUID string) error {
+	_, err := conn(ctx, b.db).ExecContext(ctx, "DELETE FROM auth_user_deletions WHERE auth_uid = $1;", authUID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+const dataExportOrderPageSize = 100
+
+type baseAccountUsecase struct {
+	loggerUtil           domain.LoggerUtil
+	txManager            domain.TxManager
+	userRepository       domain.UserRepository
+	addressRepository    domain.AddressRepository
+	cartRepository       domain.CartRepository
+	orderRepository      domain.OrderRepository
+	dataExportRepository domain.DataExportRepository
+	auditLogRepository   domain.AuditLogRepository
+	authUtil             domain.AuthUtil
+}
+
+func NewAccountUsecase(loggerUtil domain.LoggerUtil, txManager domain.TxManager, userRepository domain.UserRepository, addressRepository domain.AddressRepository, cartRepository domain.CartRepository, orderRepository domain.OrderRepository, dataExportRepository domain.DataExportRepository, auditLogRepository domain.AuditLogRepository, authUtil domain.AuthUtil) domain.AccountUsecase {
+	return &baseAccountUsecase{
+		loggerUtil:           loggerUtil,
+		txManager:            txManager,
+		userRepository:       userRepository,
+		addressRepository:    addressRepository,
+		cartRepository:       cartRepository,
+		orderRepository:      orderRepository,
+		dataExportRepository: dataExportRepository,
+		auditLogRepository:   auditLogRepository,
+		authUtil:             authUtil,
+	}
+}
+
+// DeleteAccount refuses to delete the last admin. The anonymisation is committed before the auth provider
+// user is deleted, a failed deletion stays queued for DeleteQueuedAuthUsers.
+func (b *baseAccountUsecase) DeleteAccount(ctx context.Context, user *domain.UserModel, ipAddress string) error {
+	err := b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		admin, err := b.userRepository.GetAdminByUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if admin != nil {
+			err = b.userRepository.DeleteAdminByUID(ctx, admin.UID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = b.userRepository.AnonymizeUser(ctx, user.ID, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+
+		return b.createAuditLog(ctx, domain.AuditActionAccountDelete, user.ID, ipAddress)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = b.deleteAuthUser(ctx, user.FirebaseUID)
+	if err != nil {
+		b.loggerUtil.Errorf("failed to delete auth user %s, it will be retried: %v", user.FirebaseUID, err)
+	}
+
+	return nil
+}
+
+func (b *baseAccountUsecase) DeleteQueuedAuthUsers(ctx context.Context) error {
+	authUIDs, err := b.userRepository.ListAuthUserDeletions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, authUID := range authUIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err = b.deleteAuthUser(ctx, authUID)
+		if err != nil {
+			b.loggerUtil.Errorf("failed to delete auth user %s: %v", authUID, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteAuthUser removes the auth UID from the queue once the provider deleted it, deleting a user that is
+// already gone succeeds so a retry is safe.
+func (b *baseAccountUsecase) deleteAuthUser(ctx context.Context, authUID string) error {
+	err := b.authUtil.DeleteUser(ctx, authUID)
+	if err != nil {
+		return err
+	}
+
+	return b.userRepository.DeleteAuthUserDeletion(ctx, authUID)
+}
+
+func (b *baseAccountUsecase) ExportData(ctx context.Context, user *domain.UserModel, ipAddress string) (*domain.AccountControllerResponseDataExport, error) {
+	dataExport, err := b.dataExportRepository.GetLatestDataExportByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if dataExport != nil && !b.isDataExportStale(dataExport) {
+		return b.toResponse(dataExport), nil
+	}
+
+	metadata := utils.GenerateMetadata()
+	dataExportPayload := &domain.DataExportRepositoryPayloadCreateDataExport{
+		UID:       metadata.UID(),
+		UserID:    user.ID,
+		Status:    domain.DataExportStatusPending,
+		CreatedAt: metadata.CreatedAt,
+	}
+	err = b.dataExportRepository.CreateDataExport(ctx, dataExportPayload)
+	if err != nil {
+		return nil, err
+	}
+	err = b.createAuditLog(ctx, domain.AuditActionAccountExport, user.ID, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AccountControllerResponseDataExport{
+		UID:       dataExportPayload.UID,
+		Status:    dataExportPayload.Status,
+		CreatedAt: dataExportPayload.CreatedAt,
+	}, nil
+}
+
+// isDataExportStale reports whether a new export should be started. Pending exports are only stale once they
+// are DataExportMaxPendingAge old, so repeated requests do not start more than one.
+func (b *baseAccountUsecase) isDataExportStale(dataExport *domain.DataExportModel) bool {
+	switch dataExport.Status {
+	case domain.DataExportStatusPending:
+		return time.Since(dataExport.CreatedAt) > domain.DataExportMaxPendingAge
+	case domain.DataExportStatusReady:
+		return time.Since(dataExport.CreatedAt) > domain.DataExportTTL
+	default:
+		return true
+	}
+}
+
+// BuildPendingDataExports picks up exports left pending by a stopped instance too, those older than
+// DataExportMaxPendingAge are marked as failed instead.
+func (b *baseAccountUsecase) BuildPendingDataExports(ctx context.Context) error {
+	now := time.Now().UTC()
+	staleBefore := now.Add(-domain.DataExportMaxPendingAge)
+	failed, err := b.dataExportRepository.FailPendingDataExports(ctx, staleBefore, "export abandoned", now)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		b.loggerUtil.Warnf("marked %d abandoned data exports as failed", failed)
+	}
+
+	dataExports, err := b.dataExportRepository.ListPendingDataExports(ctx, staleBefore)
+	if err != nil {
+		return err
+	}
+	for _, dataExport := range dataExports {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		b.buildDataExport(ctx, dataExport)
+	}
+
+	return nil
+}
+
+// buildDataExport marks the export as failed when ctx is cancelled by the shutdown, so it is not left pending.
+func (b *baseAccountUsecase) buildDataExport(ctx context.Context, dataExport *domain.DataExportModel) {
+	archive, err := b.buildArchive(ctx, dataExport.UserID)
+	if err == nil {
+		err = b.dataExportRepository.CompleteDataExport(ctx, dataExport.UID, archive, time.Now().UTC())
+	}
+	if err != nil {
+		b.loggerUtil.Errorf("data export %s failed: %v", dataExport.UID, err)
+		err = b.dataExportRepository.FailDataExport(context.WithoutCancel(ctx), dataExport.UID, "export failed", time.Now().UTC())
+		if err != nil {
+			b.loggerUtil.Errorf("failed to mark data export %s as failed: %v", dataExport.UID, err)
+		}
+	}
+}
+
+func (b *baseAccountUsecase) buildArchive(ctx context.Context, userID int) ([]byte, error) {
+	user, err := b.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	addresses, err := b.addressRepository.ListAddressesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	cart, err := b.cartRepository.GetCartByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := []*domain.OrderModel{}
+	for offset := 0; ; offset += dataExportOrderPageSize {
+		page, err := b.orderRepository.ListOrdersByUserID(ctx, userID, dataExportOrderPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range page {
+			// The list does not load order items and status history
+			order, err = b.orderRepository.GetOrderByUIDAndUserID(ctx, order.UID, userID)
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, order)
+		}
+		if len(page) < dataExportOrderPageSize {
+			break
+		}
+	}
+
+	return json.Marshal(&domain.AccountDataArchive{
+		Profile:    user,
+		Addresses:  addresses,
+		Cart:       cart,
+		Orders:     orders,
+		ExportedAt: time.Now().UTC(),
+	})
+}
+
+func (b *baseAccountUsecase) toResponse(dataExport *domain.DataExportModel) *domain.AccountControllerResponseDataExport {
+	res := &domain.AccountControllerResponseDataExport{
+		UID:       dataExport.UID,
+		Status:    dataExport.Status,
+		Archive:   dataExport.Archive,
+		CreatedAt: dataExport.CreatedAt,
+	}
+	if dataExport.CompletedAt.Valid {
+		res.CompletedAt = &dataExport.CompletedAt.Time
+	}
+
+	return res
+}
+
+func (b *baseAccountUsecase) createAuditLog(ctx context.Context, action string, userID int, IPAddress string) error {
+	metadata := utils.GenerateMetadata()
+	return b.auditLogRepository.CreateAuditLog(ctx, &domain.AuditLogRepositoryPayloadCreateAuditLog{
+		UID:          metadata.UID(),
+		Action:       action,
+		ActorUserID:  sql.NullInt64{Int64: int64(userID), Valid: true},
+		TargetUserID: sql.NullInt64{Int64: int64(userID), Valid: true},
+		IPAddress:    IPAddress,
+		CreatedAt:    metadata.CreatedAt,
+	})
+}
@@ -0,0 +1,198 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountUsecaseSuite struct {
+	suite.Suite
+	db             *sqlx.DB
+	pool           *dockertest.Pool
+	resource       *dockertest.Resource
+	ctx            context.Context
+	userRepo       domain.UserRepository
+	addressRepo    domain.AddressRepository
+	dataExportRepo domain.DataExportRepository
+	auditLogRepo   domain.AuditLogRepository
+	authUtilMock   *mocks.AuthUtilMock
+	uc             domain.AccountUsecase
+	user           *domain.UserModel
+}
+
+func (s *AccountUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	productUtil := utils.NewProductUtil()
+	s.ctx = context.Background()
+	s.userRepo = repository.NewUserRepository(s.db)
+	s.addressRepo = repository.NewAddressRepository(s.db)
+	s.dataExportRepo = repository.NewDataExportRepository(s.db)
+	s.auditLogRepo = repository.NewAuditLogRepository(s.db)
+	s.authUtilMock = &mocks.AuthUtilMock{}
+	s.uc = usecase.NewAccountUsecase(utils.NewLoggerUtil(env), repository.NewTxManager(s.db), s.userRepo, s.addressRepo, repository.NewCartRepository(s.db, productUtil), repository.NewOrderRepository(s.db, productUtil), s.dataExportRepo, s.auditLogRepo, s.authUtilMock)
+
+	metadata := utils.GenerateMetadata()
+	email := gofakeit.Email()
+	_, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+		UID:         metadata.UID(),
+		FirebaseUID: gofakeit.UUID(),
+		Email:       email,
+		Name:        gofakeit.Name(),
+		CreatedAt:   metadata.CreatedAt,
+		UpdatedAt:   metadata.UpdatedAt,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.user, err = s.userRepo.GetUserByEmail(s.ctx, email)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = s.addressRepo.CreateAddress(s.ctx, &domain.AddressRepositoryPayloadCreateAddress{
+		UID:           metadata.UID(),
+		UserID:        s.user.ID,
+		Label:         "Rumah",
+		RecipientName: s.user.Name,
+		Phone:         "+6281234567890",
+		Street:        gofakeit.Street(),
+		Province:      "DKI Jakarta",
+		City:          "Jakarta Selatan",
+		District:      "Kebayoran Baru",
+		SubDistrict:   "Senayan",
+		PostalCode:    "12190",
+		IsDefault:     true,
+		CreatedAt:     metadata.CreatedAt,
+		UpdatedAt:     metadata.UpdatedAt,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (s *AccountUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestAccountUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(AccountUsecaseSuite))
+}
+
+func (s *AccountUsecaseSuite) TestDeleteAccount() {
+	s.Run("Delete account should delete the auth user and anonymise the user", func() {
+		err := s.uc.DeleteAccount(s.ctx, s.user, "127.0.0.1")
+		s.NoError(err)
+		_, authUID := s.authUtilMock.DeleteUserArgsForCall(0)
+		s.Equal(s.user.FirebaseUID, authUID)
+
+		user, err := s.userRepo.GetUserByUID(s.ctx, s.user.UID)
+		s.NoError(err)
+		s.NotEqual(s.user.Email, user.Email)
+		s.Empty(user.Name)
+		s.True(user.DeletedAt.Valid)
+
+		addresses, err := s.addressRepo.ListAddressesByUserID(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Empty(addresses)
+
+		auditLogs, err := s.auditLogRepo.ListAuditLogsByTargetUserID(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Len(auditLogs, 1)
+		s.Equal(domain.AuditActionAccountDelete, auditLogs[0].Action)
+
+		authUIDs, err := s.userRepo.ListAuthUserDeletions(s.ctx)
+		s.NoError(err)
+		s.Empty(authUIDs)
+	})
+}
+
+func (s *AccountUsecaseSuite) TestDeleteAccountRetry() {
+	s.authUtilMock.DeleteUserReturns(errors.New("auth provider unavailable"))
+
+	err := s.uc.DeleteAccount(s.ctx, s.user, "127.0.0.1")
+	s.NoError(err)
+
+	user, err := s.userRepo.GetUserByUID(s.ctx, s.user.UID)
+	s.NoError(err)
+	s.True(user.DeletedAt.Valid)
+	authUIDs, err := s.userRepo.ListAuthUserDeletions(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{s.user.FirebaseUID}, authUIDs)
+
+	s.authUtilMock.DeleteUserReturns(nil)
+	s.NoError(s.uc.DeleteQueuedAuthUsers(s.ctx))
+	_, authUID := s.authUtilMock.DeleteUserArgsForCall(1)
+	s.Equal(s.user.FirebaseUID, authUID)
+	authUIDs, err = s.userRepo.ListAuthUserDeletions(s.ctx)
+	s.NoError(err)
+	s.Empty(authUIDs)
+}
+
+func (s *AccountUsecaseSuite) TestExportData() {
+	s.Run("Export data should queue the archive for the data export builder", func() {
+		res, err := s.uc.ExportData(s.ctx, s.user, "127.0.0.1")
+		s.NoError(err)
+		s.Equal(domain.DataExportStatusPending, res.Status)
+
+		s.NoError(s.uc.BuildPendingDataExports(s.ctx))
+		res, err = s.uc.ExportData(s.ctx, s.user, "127.0.0.1")
+		s.NoError(err)
+		s.Equal(domain.DataExportStatusReady, res.Status)
+
+		var archive domain.AccountDataArchive
+		s.NoError(json.Unmarshal(res.Archive, &archive))
+		s.Equal(s.user.UID, archive.Profile.UID)
+		s.Len(archive.Addresses, 1)
+
+		auditLogs, err := s.auditLogRepo.ListAuditLogsByTargetUserID(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Len(auditLogs, 1)
+		s.Equal(domain.AuditActionAccountExport, auditLogs[0].Action)
+	})
+
+	s.Run("Export data should start a new export once the pending one is abandoned", func() {
+		abandonedUID := gofakeit.UUID()
+		err := s.dataExportRepo.CreateDataExport(s.ctx, &domain.DataExportRepositoryPayloadCreateDataExport{
+			UID:       abandonedUID,
+			UserID:    s.user.ID,
+			Status:    domain.DataExportStatusPending,
+			CreatedAt: time.Now().UTC().Add(-domain.DataExportMaxPendingAge - time.Minute),
+		})
+		s.NoError(err)
+
+		res, err := s.uc.ExportData(s.ctx, s.user, "127.0.0.1")
+		s.NoError(err)
+		s.Equal(domain.DataExportStatusPending, res.Status)
+		s.NotEqual(abandonedUID, res.UID)
+
+		s.NoError(s.uc.BuildPendingDataExports(s.ctx))
+		dataExport, err := s.dataExportRepo.GetLatestDataExportByUserID(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Equal(res.UID, dataExport.UID)
+		s.Equal(domain.DataExportStatusReady, dataExport.Status)
+	})
+}
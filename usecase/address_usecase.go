@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+type baseAddressUsecase struct {
+	addressRepository domain.AddressRepository
+}
+
+func NewAddressUsecase(addressRepository domain.AddressRepository) domain.AddressUsecase {
+	return &baseAddressUsecase{addressRepository: addressRepository}
+}
+
+func (b *baseAddressUsecase) ListAddresses(ctx context.Context, userID int) ([]*domain.AddressControllerResponseAddress, error) {
+	addresses, err := b.addressRepository.ListAddressesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*domain.AddressControllerResponseAddress, len(addresses))
+	for i, address := range addresses {
+		res[i] = b.toResponse(address)
+	}
+
+	return res, nil
+}
+
+func (b *baseAddressUsecase) GetAddress(ctx context.Context, userID int, UID string) (*domain.AddressControllerResponseAddress, error) {
+	address, err := b.addressRepository.GetAddressByUID(ctx, userID, UID)
+	if err != nil {
+		return nil, err
+	}
+	if address == nil {
+		return nil, domain.ErrAddressNotFound
+	}
+
+	return b.toResponse(address), nil
+}
+
+// CreateAddress makes the first address of a user the default one.
+func (b *baseAddressUsecase) CreateAddress(ctx context.Context, userID int, payload *domain.AddressControllerPayloadCreateAddress) (*domain.AddressControllerResponseAddress, error) {
+	phone, err := utils.NormalizeIndonesianPhone(payload.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	isDefault := payload.IsDefault
+	if !isDefault {
+		addresses, err := b.addressRepository.ListAddressesByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		isDefault = len(addresses) == 0
+	}
+
+	metadata := utils.GenerateMetadata()
+	addressPayload := &domain.AddressRepositoryPayloadCreateAddress{
+		UID:           metadata.UID(),
+		UserID:        userID,
+		Label:         payload.Label,
+		RecipientName: payload.RecipientName,
+		Phone:         phone,
+		Street:        payload.Street,
+		Province:      payload.Province,
+		City:          payload.City,
+		District:      payload.District,
+		SubDistrict:   payload.SubDistrict,
+		PostalCode:    payload.PostalCode,
+		IsDefault:     isDefault,
+		CreatedAt:     metadata.CreatedAt,
+		UpdatedAt:     metadata.UpdatedAt,
+	}
+	err = b.addressRepository.CreateAddress(ctx, addressPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.GetAddress(ctx, userID, addressPayload.UID)
+}
+
+func (b *baseAddressUsecase) UpdateAddress(ctx context.Context, userID int, UID string, payload *domain.AddressControllerPayloadUpdateAddress) (*domain.AddressControllerResponseAddress, error) {
+	phone, err := utils.NormalizeIndonesianPhone(payload.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.addressRepository.UpdateAddress(ctx, &domain.AddressRepositoryPayloadUpdateAddress{
+		UID:           UID,
+		UserID:        userID,
+		Label:         payload.Label,
+		RecipientName: payload.RecipientName,
+		Phone:         phone,
+		Street:        payload.Street,
+		Province:      payload.Province,
+		City:          payload.City,
+		District:      payload.District,
+		SubDistrict:   payload.SubDistrict,
+		PostalCode:    payload.PostalCode,
+		UpdatedAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.GetAddress(ctx, userID, UID)
+}
+
+func (b *baseAddressUsecase) SetDefaultAddress(ctx context.Context, userID int, UID string) error {
+	return b.addressRepository.SetDefaultAddress(ctx, userID, UID, time.Now().UTC())
+}
+
+func (b *baseAddressUsecase) DeleteAddress(ctx context.Context, userID int, UID string) error {
+	return b.addressRepository.DeleteAddress(ctx, userID, UID, time.Now().UTC())
+}
+
+func (b *baseAddressUsecase) toResponse(address *domain.AddressModel) *domain.AddressControllerResponseAddress {
+	return &domain.AddressControllerResponseAddress{
+		UID:           address.UID,
+		Label:         address.Label,
+		RecipientName: address.RecipientName,
+		Phone:         address.Phone,
+		Street:        address.Street,
+		Province:      address.Province,
+		City:          address.City,
+		District:      address.District,
+		SubDistrict:   address.SubDistrict,
+		PostalCode:    address.PostalCode,
+		IsDefault:     address.IsDefault,
+		CreatedAt:     address.CreatedAt,
+		UpdatedAt:     address.UpdatedAt,
+	}
+}
@@ -0,0 +1,188 @@
+package usecase_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddressUsecaseSuite struct {
+	suite.Suite
+	db          *sqlx.DB
+	pool        *dockertest.Pool
+	resource    *dockertest.Resource
+	ctx         context.Context
+	userRepo    domain.UserRepository
+	addressRepo domain.AddressRepository
+	user        *domain.UserModel
+	otherUser   *domain.UserModel
+}
+
+func (s *AddressUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	s.ctx = context.Background()
+	s.userRepo = repository.NewUserRepository(s.db)
+	s.addressRepo = repository.NewAddressRepository(s.db)
+	s.user = s.createUser()
+	s.otherUser = s.createUser()
+}
+
+func (s *AddressUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestAddressUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(AddressUsecaseSuite))
+}
+
+func (s *AddressUsecaseSuite) createUser() *domain.UserModel {
+	metadata := utils.GenerateMetadata()
+	email := gofakeit.Email()
+	_, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+		UID:       metadata.UID(),
+		Email:     email,
+		Name:      gofakeit.Name(),
+		CreatedAt: metadata.CreatedAt,
+		UpdatedAt: metadata.UpdatedAt,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	user, err := s.userRepo.GetUserByEmail(s.ctx, email)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return user
+}
+
+func (s *AddressUsecaseSuite) addressPayload(isDefault bool) *domain.AddressControllerPayloadCreateAddress {
+	return &domain.AddressControllerPayloadCreateAddress{
+		Label:         "Rumah",
+		RecipientName: gofakeit.Name(),
+		Phone:         "081234567890",
+		Street:        "Jl. Merdeka No. 10",
+		Province:      "Jawa Barat",
+		City:          "Kota Bandung",
+		District:      "Sumur Bandung",
+		SubDistrict:   "Braga",
+		PostalCode:    "40111",
+		IsDefault:     isDefault,
+	}
+}
+
+func (s *AddressUsecaseSuite) TestAddressUsecase() {
+	uc := usecase.NewAddressUsecase(s.addressRepo)
+
+	s.Run("Create first address should make it default and normalize phone", func() {
+		address, err := uc.CreateAddress(s.ctx, s.user.ID, s.addressPayload(false))
+		s.NoError(err)
+		s.True(address.IsDefault)
+		s.Equal("+6281234567890", address.Phone)
+	})
+
+	s.Run("Create default address should clear the previous default", func() {
+		address, err := uc.CreateAddress(s.ctx, s.user.ID, s.addressPayload(true))
+		s.NoError(err)
+		s.True(address.IsDefault)
+
+		addresses, err := uc.ListAddresses(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Len(addresses, 2)
+		s.Equal(address.UID, addresses[0].UID)
+		s.False(addresses[1].IsDefault)
+	})
+
+	s.Run("Create address with invalid phone should fail", func() {
+		payload := s.addressPayload(false)
+		payload.Phone = "12345"
+		address, err := uc.CreateAddress(s.ctx, s.user.ID, payload)
+		s.ErrorIs(err, domain.ErrInvalidPhone)
+		s.Nil(address)
+	})
+
+	s.Run("Set default address should move the default flag", func() {
+		addresses, err := uc.ListAddresses(s.ctx, s.user.ID)
+		s.NoError(err)
+		err = uc.SetDefaultAddress(s.ctx, s.user.ID, addresses[1].UID)
+		s.NoError(err)
+
+		address, err := uc.GetAddress(s.ctx, s.user.ID, addresses[1].UID)
+		s.NoError(err)
+		s.True(address.IsDefault)
+		address, err = uc.GetAddress(s.ctx, s.user.ID, addresses[0].UID)
+		s.NoError(err)
+		s.False(address.IsDefault)
+	})
+
+	s.Run("Update address should replace its fields", func() {
+		addresses, err := uc.ListAddresses(s.ctx, s.user.ID)
+		s.NoError(err)
+		address, err := uc.UpdateAddress(s.ctx, s.user.ID, addresses[0].UID, &domain.AddressControllerPayloadUpdateAddress{
+			Label:         "Kantor",
+			RecipientName: "Siti",
+			Phone:         "+6285712345678",
+			Street:        "Jl. Sudirman Kav. 1",
+			Province:      "DKI Jakarta",
+			City:          "Kota Jakarta Selatan",
+			District:      "Setiabudi",
+			SubDistrict:   "Karet",
+			PostalCode:    "12920",
+		})
+		s.NoError(err)
+		s.Equal("Kantor", address.Label)
+		s.Equal("12920", address.PostalCode)
+		s.True(address.IsDefault)
+	})
+
+	s.Run("Addresses of another user should not be found", func() {
+		addresses, err := uc.ListAddresses(s.ctx, s.user.ID)
+		s.NoError(err)
+		UID := addresses[0].UID
+
+		otherAddresses, err := uc.ListAddresses(s.ctx, s.otherUser.ID)
+		s.NoError(err)
+		s.Empty(otherAddresses)
+
+		address, err := uc.GetAddress(s.ctx, s.otherUser.ID, UID)
+		s.ErrorIs(err, domain.ErrAddressNotFound)
+		s.Nil(address)
+		_, err = uc.UpdateAddress(s.ctx, s.otherUser.ID, UID, &domain.AddressControllerPayloadUpdateAddress{Phone: "081234567890"})
+		s.ErrorIs(err, domain.ErrAddressNotFound)
+		s.ErrorIs(uc.SetDefaultAddress(s.ctx, s.otherUser.ID, UID), domain.ErrAddressNotFound)
+		s.ErrorIs(uc.DeleteAddress(s.ctx, s.otherUser.ID, UID), domain.ErrAddressNotFound)
+	})
+
+	s.Run("Delete default address should promote the latest remaining address", func() {
+		addresses, err := uc.ListAddresses(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.True(addresses[0].IsDefault)
+
+		err = uc.DeleteAddress(s.ctx, s.user.ID, addresses[0].UID)
+		s.NoError(err)
+
+		addresses, err = uc.ListAddresses(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Len(addresses, 1)
+		s.True(addresses[0].IsDefault)
+	})
+}
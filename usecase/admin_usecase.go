@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+type baseAdminUsecase struct {
+	txManager          domain.TxManager
+	userRepository     domain.UserRepository
+	roleRepository     domain.RoleRepository
+	auditLogRepository domain.AuditLogRepository
+}
+
+// NewAdminUsecase keeps the super_admin role in sync with the admins table, admins get every permission.
+func NewAdminUsecase(txManager domain.TxManager, userRepository domain.UserRepository, roleRepository domain.RoleRepository, auditLogRepository domain.AuditLogRepository) domain.AdminUsecase {
+	return &baseAdminUsecase{
+		txManager:          txManager,
+		userRepository:     userRepository,
+		roleRepository:     roleRepository,
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (b *baseAdminUsecase) ListAdmins(ctx context.Context) ([]*domain.AdminControllerResponseAdmin, error) {
+	admins, err := b.userRepository.ListAdmins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*domain.AdminControllerResponseAdmin, 0, len(admins))
+	for _, admin := range admins {
+		res = append(res, &domain.AdminControllerResponseAdmin{
+			UID:       admin.UID,
+			Email:     admin.Email,
+			CreatedAt: admin.CreatedAt,
+			UpdatedAt: admin.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+func (b *baseAdminUsecase) GrantAdmin(ctx context.Context, payload *domain.AdminUsecasePayloadGrantAdmin) (string, error) {
+	user, err := b.userRepository.GetUserByEmail(ctx, payload.Email)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", domain.ErrUserNotFound
+	}
+
+	return b.createAdmin(ctx, user, payload.ActorUserID, payload.IPAddress)
+}
+
+func (b *baseAdminUsecase) RevokeAdmin(ctx context.Context, payload *domain.AdminUsecasePayloadRevokeAdmin) error {
+	return b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		admin, err := b.userRepository.GetAdminByUID(ctx, payload.UID)
+		if err != nil {
+			return err
+		}
+		if admin == nil {
+			return domain.ErrAdminNotFound
+		}
+
+		err = b.userRepository.DeleteAdminByUID(ctx, payload.UID)
+		if err != nil {
+			return err
+		}
+		role, err := b.getSuperAdminRole(ctx)
+		if err != nil {
+			return err
+		}
+		err = b.roleRepository.RemoveRole(ctx, admin.UserID, role.ID)
+		if err != nil && !errors.Is(err, domain.ErrUserRoleNotFound) {
+			return err
+		}
+
+		return b.createAuditLog(ctx, domain.AuditActionAdminRevoke, payload.ActorUserID, admin.UserID, payload.IPAddress)
+	})
+}
+
+// CreateFirstAdmin is used by the create-admin command, it refuses to run once an admin exists so the
+// command can not be used to bypass the admin API.
+func (b *baseAdminUsecase) CreateFirstAdmin(ctx context.Context, email string) (string, error) {
+	admins, err := b.userRepository.ListAdmins(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(admins) > 0 {
+		return "", domain.ErrFirstAdminCreated
+	}
+
+	user, err := b.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", domain.ErrUserNotFound
+	}
+
+	return b.createAdmin(ctx, user, 0, "")
+}
+
+// createAdmin creates the admin, assigns the super_admin role and records the audit log in one transaction.
+func (b *baseAdminUsecase) createAdmin(ctx context.Context, user *domain.UserModel, actorUserID int, IPAddress string) (string, error) {
+	metadata := utils.GenerateMetadata()
+	UID := metadata.UID()
+	err := b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		admin, err := b.userRepository.GetAdminByUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if admin != nil {
+			return domain.ErrAdminAlreadyExist
+		}
+
+		err = b.userRepository.CreateAdmin(ctx, &domain.UserRepositoryPayloadCreateAdmin{
+			UID:       UID,
+			Email:     user.Email,
+			UserID:    user.ID,
+			CreatedAt: metadata.CreatedAt,
+			UpdatedAt: metadata.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		role, err := b.getSuperAdminRole(ctx)
+		if err != nil {
+			return err
+		}
+		err = b.roleRepository.AssignRole(ctx, user.ID, role.ID)
+		if err != nil && !errors.Is(err, domain.ErrRoleAlreadyExist) {
+			return err
+		}
+
+		return b.createAuditLog(ctx, domain.AuditActionAdminGrant, actorUserID, user.ID, IPAddress)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return UID, nil
+}
+
+func (b *baseAdminUsecase) getSuperAdminRole(ctx context.Context) (*domain.RoleModel, error) {
+	role, err := b.roleRepository.GetRoleByName(ctx, domain.RoleSuperAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, domain.ErrRoleNotFound
+	}
+
+	return role, nil
+}
+
+// createAuditLog records actorUserID 0 as null, which means the action was run from the CLI.
+func (b *baseAdminUsecase) createAuditLog(ctx context.Context, action string, actorUserID, targetUserID int, IPAddress string) error {
+	metadata := utils.GenerateMetadata()
+	return b.auditLogRepository.CreateAuditLog(ctx, &domain.AuditLogRepositoryPayloadCreateAuditLog{
+		UID:          metadata.UID(),
+		Action:       action,
+		ActorUserID:  sql.NullInt64{Int64: int64(actorUserID), Valid: actorUserID != 0},
+		TargetUserID: sql.NullInt64{Int64: int64(targetUserID), Valid: true},
+		IPAddress:    IPAddress,
+		CreatedAt:    metadata.CreatedAt,
+	})
+}
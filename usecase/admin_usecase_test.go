@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdminUsecaseSuite struct {
+	suite.Suite
+	db           *sqlx.DB
+	pool         *dockertest.Pool
+	resource     *dockertest.Resource
+	ctx          context.Context
+	userRepo     domain.UserRepository
+	auditLogRepo domain.AuditLogRepository
+	roleRepo     domain.RoleRepository
+	users        []*domain.UserModel
+}
+
+func (s *AdminUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	s.ctx = context.Background()
+	s.userRepo = repository.NewUserRepository(s.db)
+	s.auditLogRepo = repository.NewAuditLogRepository(s.db)
+	s.roleRepo = repository.NewRoleRepository(s.db)
+
+	s.users = nil
+	for i := 0; i < 2; i++ {
+		metadata := utils.GenerateMetadata()
+		email := gofakeit.Email()
+		_, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+			UID:       metadata.UID(),
+			Email:     email,
+			Name:      gofakeit.Name(),
+			CreatedAt: metadata.CreatedAt,
+			UpdatedAt: metadata.UpdatedAt,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		user, err := s.userRepo.GetUserByEmail(s.ctx, email)
+		if err != nil {
+			log.Fatal(err)
+		}
+		s.users = append(s.users, user)
+	}
+}
+
+func (s *AdminUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestAdminUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(AdminUsecaseSuite))
+}
+
+func (s *AdminUsecaseSuite) TestAdminUsecase() {
+	uc := usecase.NewAdminUsecase(repository.NewTxManager(s.db), s.userRepo, s.roleRepo, s.auditLogRepo)
+	var firstAdminUID string
+
+	s.Run("Create first admin should return error given unknown email", func() {
+		_, err := uc.CreateFirstAdmin(s.ctx, "notfound@email.com")
+		s.ErrorIs(err, domain.ErrUserNotFound)
+	})
+
+	s.Run("Create first admin should grant admin without actor", func() {
+		UID, err := uc.CreateFirstAdmin(s.ctx, s.users[0].Email)
+		s.NoError(err)
+		firstAdminUID = UID
+
+		auditLogs, err := s.auditLogRepo.ListAuditLogsByTargetUserID(s.ctx, s.users[0].ID)
+		s.NoError(err)
+		s.Len(auditLogs, 1)
+		s.Equal(domain.AuditActionAdminGrant, auditLogs[0].Action)
+		s.False(auditLogs[0].ActorUserID.Valid)
+
+		hasPermission, err := s.roleRepo.HasPermission(s.ctx, s.users[0].ID, domain.PermissionRoleManage)
+		s.NoError(err)
+		s.True(hasPermission)
+	})
+
+	s.Run("Create first admin should return error once an admin exists", func() {
+		_, err := uc.CreateFirstAdmin(s.ctx, s.users[1].Email)
+		s.ErrorIs(err, domain.ErrFirstAdminCreated)
+	})
+
+	s.Run("Revoke admin should return error given the last admin", func() {
+		err := uc.RevokeAdmin(s.ctx, &domain.AdminUsecasePayloadRevokeAdmin{UID: firstAdminUID, ActorUserID: s.users[0].ID})
+		s.ErrorIs(err, domain.ErrLastAdmin)
+	})
+
+	s.Run("Grant admin should be audit logged", func() {
+		_, err := uc.GrantAdmin(s.ctx, &domain.AdminUsecasePayloadGrantAdmin{Email: s.users[1].Email, ActorUserID: s.users[0].ID, IPAddress: "127.0.0.1"})
+		s.NoError(err)
+
+		_, err = uc.GrantAdmin(s.ctx, &domain.AdminUsecasePayloadGrantAdmin{Email: s.users[1].Email, ActorUserID: s.users[0].ID})
+		s.ErrorIs(err, domain.ErrAdminAlreadyExist)
+
+		admins, err := uc.ListAdmins(s.ctx)
+		s.NoError(err)
+		s.Len(admins, 2)
+
+		auditLogs, err := s.auditLogRepo.ListAuditLogsByTargetUserID(s.ctx, s.users[1].ID)
+		s.NoError(err)
+		s.Len(auditLogs, 1)
+		s.Equal(int64(s.users[0].ID), auditLogs[0].ActorUserID.Int64)
+		s.Equal("127.0.0.1", auditLogs[0].IPAddress)
+	})
+
+	s.Run("Revoke admin should be audit logged", func() {
+		err := uc.RevokeAdmin(s.ctx, &domain.AdminUsecasePayloadRevokeAdmin{UID: firstAdminUID, ActorUserID: s.users[1].ID})
+		s.NoError(err)
+
+		admin, err := s.userRepo.GetAdminByUserID(s.ctx, s.users[0].ID)
+		s.NoError(err)
+		s.Nil(admin)
+
+		hasPermission, err := s.roleRepo.HasPermission(s.ctx, s.users[0].ID, domain.PermissionRoleManage)
+		s.NoError(err)
+		s.False(hasPermission)
+
+		auditLogs, err := s.auditLogRepo.ListAuditLogsByTargetUserID(s.ctx, s.users[0].ID)
+		s.NoError(err)
+		s.Len(auditLogs, 2)
+		s.Equal(domain.AuditActionAdminRevoke, auditLogs[1].Action)
+	})
+}
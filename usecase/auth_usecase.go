@@ -3,35 +3,53 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
 	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
 )
 
 type baseAuthUsecase struct {
-	env            *domain.Env
-	userRepository domain.UserRepository
-	authUtil       domain.AuthUtil
+	env                    *domain.Env
+	loggerUtil             domain.LoggerUtil
+	txManager              domain.TxManager
+	userRepository         domain.UserRepository
+	cartRepository         domain.CartRepository
+	refreshTokenRepository domain.RefreshTokenRepository
+	loginAttemptRepository domain.LoginAttemptRepository
+	authUtil               domain.AuthUtil
+	hashUtil               domain.HashUtil
+	jwtUtil                domain.JWTUtil
+	mailerUtil             domain.MailerUtil
 }
 
-func NewAuthUsecase(env *domain.Env, userRepository domain.UserRepository, authUtil domain.AuthUtil) domain.AuthUsecase {
+func NewAuthUsecase(env *domain.Env, loggerUtil domain.LoggerUtil, txManager domain.TxManager, userRepository domain.UserRepository, cartRepository domain.CartRepository, refreshTokenRepository domain.RefreshTokenRepository, loginAttemptRepository domain.LoginAttemptRepository, authUtil domain.AuthUtil, hashUtil domain.HashUtil, jwtUtil domain.JWTUtil, mailerUtil domain.MailerUtil) domain.AuthUsecase {
 	return &baseAuthUsecase{
-		env:            env,
-		userRepository: userRepository,
-		authUtil:       authUtil,
+		env:                    env,
+		loggerUtil:             loggerUtil,
+		txManager:              txManager,
+		userRepository:         userRepository,
+		cartRepository:         cartRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		authUtil:               authUtil,
+		hashUtil:               hashUtil,
+		jwtUtil:                jwtUtil,
+		mailerUtil:             mailerUtil,
 	}
 }
 
-func (b *baseAuthUsecase) SignUp(ctx context.Context, email, password string, isAdmin bool) error {
-	user, err := b.userRepository.GetUserByEmail(email)
+func (b *baseAuthUsecase) SignUp(ctx context.Context, email, password string) error {
+	user, err := b.userRepository.GetUserByEmail(ctx, email)
 	if user != nil {
-		return errors.New("user already exist")
+		return domain.ErrUserAlreadyExist
 	}
 	if err != nil {
 		return err
 	}
 
-	firebaseUID, err := b.authUtil.CreateUser(email, password)
+	firebaseUID, err := b.authUtil.CreateUser(ctx, email, password)
 	if err != nil {
 		return err
 	}
@@ -41,11 +59,170 @@ func (b *baseAuthUsecase) SignUp(ctx context.Context, email, password string, is
 		UID:         metadata.UID(),
 		FirebaseUID: firebaseUID,
 		Email:       email,
-		IsAdmin:     isAdmin,
 		CreatedAt:   metadata.CreatedAt,
 		UpdatedAt:   metadata.UpdatedAt,
 	}
-	_, err = b.userRepository.CreateUser(userPayload)
+	err = b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		userID, err := b.userRepository.CreateUser(ctx, userPayload)
+		if err != nil {
+			return err
+		}
+
+		return b.cartRepository.CreateCart(ctx, userID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The account is usable without a verified email, the link can be requested again with SendEmailVerification
+	err = b.sendEmailVerification(ctx, email)
+	if err != nil {
+		b.loggerUtil.Errorf("failed to send email verification to %s: %v", email, err)
+	}
+
+	return nil
+}
+
+// GetAccessToken checks the credential against the auth provider, then issues an access and refresh token
+// pair that starts a new token family. Unknown emails and wrong passwords both return ErrInvalidCredentials
+// and count towards the lockout of the email and the IP address.
+func (b *baseAuthUsecase) GetAccessToken(ctx context.Context, email, password string, client *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error) {
+	emailKey := "email:" + strings.ToLower(email)
+	ipKey := "ip:" + client.IPAddress
+	now := time.Now().UTC()
+
+	for _, key := range []string{emailKey, ipKey} {
+		loginAttempt, err := b.loginAttemptRepository.GetLoginAttempt(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if loginAttempt != nil && loginAttempt.LockedUntil.Valid && loginAttempt.LockedUntil.Time.After(now) {
+			return nil, domain.ErrTooManyLoginAttempts
+		}
+	}
+
+	user, err := b.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	// The provider is asked for unknown emails too, so the response time does not tell which accounts exist
+	accessToken, err := b.authUtil.GetAccessToken(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || accessToken == "" {
+		err = b.recordFailedLogin(ctx, emailKey, domain.LoginMaxFailuresPerEmail, now)
+		if err != nil {
+			return nil, err
+		}
+		err = b.recordFailedLogin(ctx, ipKey, domain.LoginMaxFailuresPerIP, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	// Failures of the IP address are kept, one valid account must not reset the limit of a whole address
+	err = b.loginAttemptRepository.DeleteLoginAttempt(ctx, emailKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPair, refreshTokenPayload, err := b.generateTokenPair(ctx, user, utils.GenerateMetadata().UID(), client)
+	if err != nil {
+		return nil, err
+	}
+	err = b.refreshTokenRepository.CreateRefreshToken(ctx, refreshTokenPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenPair, nil
+}
+
+// RefreshToken rotates the refresh token. Presenting a token that has already been rotated means it
+// has leaked, so the whole family is revoked and the user has to log in again.
+func (b *baseAuthUsecase) RefreshToken(ctx context.Context, refreshToken string, client *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, error) {
+	authUID, err := b.jwtUtil.ParseUserUID(refreshToken, false)
+	if err != nil {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	storedToken, err := b.refreshTokenRepository.GetRefreshTokenByHash(ctx, b.hashUtil.HashToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if storedToken == nil || storedToken.RevokedAt.Valid {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	now := time.Now().UTC()
+	if storedToken.UsedAt.Valid {
+		err = b.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, storedToken.FamilyUID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, domain.ErrRefreshTokenReused
+	}
+	if storedToken.ExpiresAt.Before(now) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	user, err := b.userRepository.GetUserByFirebaseUID(ctx, authUID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.ID != storedToken.UserID {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	tokenPair, refreshTokenPayload, err := b.generateTokenPair(ctx, user, storedToken.FamilyUID, client)
+	if err != nil {
+		return nil, err
+	}
+	err = b.refreshTokenRepository.RotateRefreshToken(ctx, storedToken.ID, now, refreshTokenPayload)
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			revokeErr := b.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, storedToken.FamilyUID, now)
+			if revokeErr != nil {
+				return nil, revokeErr
+			}
+		}
+
+		return nil, err
+	}
+
+	return tokenPair, nil
+}
+
+// Logout ends the session of the refresh token, its access token stays valid until it expires.
+func (b *baseAuthUsecase) Logout(ctx context.Context, userID int, refreshToken string) error {
+	storedToken, err := b.refreshTokenRepository.GetRefreshTokenByHash(ctx, b.hashUtil.HashToken(refreshToken))
+	if err != nil {
+		return err
+	}
+	if storedToken == nil || storedToken.UserID != userID {
+		return domain.ErrInvalidRefreshToken
+	}
+
+	return b.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, storedToken.FamilyUID, time.Now().UTC())
+}
+
+// LogoutAll ends every session of the user, including the ones of the auth provider.
+func (b *baseAuthUsecase) LogoutAll(ctx context.Context, user *domain.UserModel) error {
+	now := time.Now().UTC()
+
+	err := b.refreshTokenRepository.RevokeRefreshTokensByUserID(ctx, user.ID, now)
+	if err != nil {
+		return err
+	}
+	err = b.userRepository.RevokeTokens(ctx, user.ID, now)
+	if err != nil {
+		return err
+	}
+	err = b.authUtil.RevokeTokens(ctx, user.FirebaseUID)
 	if err != nil {
 		return err
 	}
@@ -53,22 +230,151 @@ func (b *baseAuthUsecase) SignUp(ctx context.Context, email, password string, is
 	return nil
 }
 
-func (b *baseAuthUsecase) GetAccessToken(ctx context.Context, email, password string) (string, error) {
-	user, err := b.userRepository.GetUserByEmail(email)
+func (b *baseAuthUsecase) ListSessions(ctx context.Context, userID int) ([]*domain.AuthControllerResponseSession, error) {
+	sessions, err := b.refreshTokenRepository.ListActiveSessionsByUserID(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*domain.AuthControllerResponseSession, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, &domain.AuthControllerResponseSession{
+			UID:        session.FamilyUID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+		})
+	}
+
+	return res, nil
+}
+
+// generateTokenPair pairs an access token of the auth provider with a refresh token of our own, refresh tokens
+// are rotated and revoked by us whatever the provider.
+func (b *baseAuthUsecase) generateTokenPair(ctx context.Context, user *domain.UserModel, familyUID string, client *domain.AuthUsecasePropertyClient) (*domain.AuthControllerResponseTokenPair, *domain.RefreshTokenRepositoryPayloadCreateRefreshToken, error) {
+	accessToken, accessTokenExpiresAt, err := b.authUtil.IssueAccessToken(ctx, user.FirebaseUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	refreshToken, refreshTokenExpiresAt, err := b.jwtUtil.GenerateRefreshToken(user.FirebaseUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := utils.GenerateMetadata()
+	refreshTokenPayload := &domain.RefreshTokenRepositoryPayloadCreateRefreshToken{
+		UID:       metadata.UID(),
+		FamilyUID: familyUID,
+		TokenHash: b.hashUtil.HashToken(refreshToken),
+		ExpiresAt: refreshTokenExpiresAt,
+		UserAgent: client.UserAgent,
+		IPAddress: client.IPAddress,
+		UserID:    user.ID,
+		CreatedAt: metadata.CreatedAt,
+		UpdatedAt: metadata.UpdatedAt,
+	}
+	tokenPair := &domain.AuthControllerResponseTokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+	}
+
+	return tokenPair, refreshTokenPayload, nil
+}
+
+// ForgotPassword does not report whether the email is registered, to not leak which emails have an account.
+func (b *baseAuthUsecase) ForgotPassword(ctx context.Context, email string) error {
+	user, err := b.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return nil
+	}
+
+	link, err := b.authUtil.GeneratePasswordResetLink(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	return b.mailerUtil.SendMail(ctx, &domain.Mail{
+		To:      email,
+		Subject: "Reset your password",
+		Body:    "We received a request to reset the password of your account.\n\nOpen the link below to choose a new password:\n" + link + "\n\nIf you did not request this, you can ignore this email.",
+	})
+}
+
+// ResetPassword signs the user out of every session once the password has changed.
+func (b *baseAuthUsecase) ResetPassword(ctx context.Context, code, newPassword string) error {
+	email, err := b.authUtil.ResetPassword(ctx, code, newPassword)
+	if err != nil {
+		return err
+	}
+
+	user, err := b.userRepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
 	if user == nil {
-		return "", errors.New("user not found")
+		return nil
+	}
+
+	return b.LogoutAll(ctx, user)
+}
+
+func (b *baseAuthUsecase) VerifyEmail(ctx context.Context, code string) error {
+	email, err := b.authUtil.VerifyEmail(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	return b.userRepository.MarkEmailVerified(ctx, email, time.Now().UTC())
+}
+
+func (b *baseAuthUsecase) SendEmailVerification(ctx context.Context, user *domain.UserModel) error {
+	if user.EmailVerifiedAt.Valid {
+		return domain.ErrEmailAlreadyVerified
 	}
+
+	return b.sendEmailVerification(ctx, user.Email)
+}
+
+func (b *baseAuthUsecase) sendEmailVerification(ctx context.Context, email string) error {
+	link, err := b.authUtil.GenerateEmailVerificationLink(ctx, email)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	accessToken, err := b.authUtil.GetAccessToken(email, password)
+	return b.mailerUtil.SendMail(ctx, &domain.Mail{
+		To:      email,
+		Subject: "Verify your email",
+		Body:    "Welcome to Ayobeli!\n\nOpen the link below to verify your email:\n" + link,
+	})
+}
+
+// recordFailedLogin locks key once it reaches maxFailures, the lockout doubles with every further failure.
+func (b *baseAuthUsecase) recordFailedLogin(ctx context.Context, key string, maxFailures int, now time.Time) error {
+	loginAttempt, err := b.loginAttemptRepository.RecordFailedLoginAttempt(ctx, key, now)
 	if err != nil {
-		return "", err
+		return err
+	}
+	if loginAttempt.Failures < maxFailures {
+		return nil
+	}
+
+	lockout := domain.LoginMaxLockout
+	if exponent := loginAttempt.Failures - maxFailures; exponent < 16 {
+		lockout = min(domain.LoginBaseLockout<<exponent, domain.LoginMaxLockout)
 	}
-	if accessToken == "" {
-		return "", errors.New("invalid password")
+	lockedUntil := now.Add(lockout)
+	err = b.loginAttemptRepository.LockLoginAttempt(ctx, key, lockedUntil)
+	if err != nil {
+		return err
 	}
+	b.loggerUtil.Warnf("login locked for %s until %s after %d failed attempts", key, lockedUntil.Format(time.RFC3339), loginAttempt.Failures)
 
-	return accessToken, nil
+	return nil
 }
@@ -2,7 +2,10 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,17 +24,25 @@ import (
 
 type AuthUsecaseSuite struct {
 	suite.Suite
-	env      *domain.Env
-	db       *sqlx.DB
-	pool     *dockertest.Pool
-	resource *dockertest.Resource
-	ctx      context.Context
-	now      time.Time
-	nowUTC   time.Time
-	userRepo domain.UserRepository
-	cartRepo domain.CartRepository
-	email    string
-	password string
+	env              *domain.Env
+	db               *sqlx.DB
+	pool             *dockertest.Pool
+	resource         *dockertest.Resource
+	ctx              context.Context
+	now              time.Time
+	nowUTC           time.Time
+	txManager        domain.TxManager
+	userRepo         domain.UserRepository
+	cartRepo         domain.CartRepository
+	refreshTokenRepo domain.RefreshTokenRepository
+	loginAttemptRepo domain.LoginAttemptRepository
+	loggerUtil       domain.LoggerUtil
+	hashUtil         domain.HashUtil
+	jwtUtil          domain.JWTUtil
+	mailerUtil       *mocks.MailerUtilMock
+	client           *domain.AuthUsecasePropertyClient
+	email            string
+	password         string
 }
 
 func (s *AuthUsecaseSuite) SetupTest() {
@@ -45,13 +56,21 @@ func (s *AuthUsecaseSuite) SetupTest() {
 	ctx := context.Background()
 	now := time.Now()
 	userRepo := repository.NewUserRepository(s.db)
-	cartRepo := repository.NewCartRepository(s.db)
+	cartRepo := repository.NewCartRepository(s.db, utils.NewProductUtil())
 
 	s.ctx = ctx
 	s.now = now
 	s.nowUTC = now.UTC()
+	s.txManager = repository.NewTxManager(s.db)
 	s.userRepo = userRepo
 	s.cartRepo = cartRepo
+	s.refreshTokenRepo = repository.NewRefreshTokenRepository(s.db)
+	s.loginAttemptRepo = repository.NewMemoryLoginAttemptRepository()
+	s.loggerUtil = utils.NewLoggerUtil(env)
+	s.hashUtil = utils.NewHashUtil()
+	s.jwtUtil = utils.NewJWTUtil([]byte("access"), []byte("refresh"), 1, 24)
+	s.mailerUtil = &mocks.MailerUtilMock{}
+	s.client = &domain.AuthUsecasePropertyClient{UserAgent: gofakeit.UserAgent(), IPAddress: gofakeit.IPv4Address()}
 	s.email = "test@email.com"
 	s.password = "test1234"
 }
@@ -62,93 +81,378 @@ func (s *AuthUsecaseSuite) TearDownTest() {
 	}
 }
 
+// newAuthUtilMock returns an AuthUtil mock whose access tokens are signed by the suite's JWT util.
+func (s *AuthUsecaseSuite) newAuthUtilMock() *mocks.AuthUtilMock {
+	authUtilMock := &mocks.AuthUtilMock{}
+	authUtilMock.IssueAccessTokenStub = func(_ context.Context, authUID string) (string, time.Time, error) {
+		return s.jwtUtil.GenerateAccessToken(authUID)
+	}
+
+	return authUtilMock
+}
+
 func TestAuthUsecase(t *testing.T) {
 	suite.Run(t, new(AuthUsecaseSuite))
 }
 
 func (s *AuthUsecaseSuite) TestAuthUsecase() {
-	s.Run("Signup as admin should be successful", func() {
-		authUtilMock := &mocks.AuthUtilMock{}
-		uc := usecase.NewAuthUsecase(s.env, s.userRepo, authUtilMock)
+	s.Run("Signup should be successful", func() {
+		authUtilMock := s.newAuthUtilMock()
+		uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
 		expectedFirebaseUID := gofakeit.UUID()
 		authUtilMock.CreateUserReturns(expectedFirebaseUID, nil)
 
-		err := uc.SignUp(s.ctx, s.email, s.password, true)
+		err := uc.SignUp(s.ctx, s.email, s.password)
 		s.NoError(err)
 
 		// Validate created user
-		user, err := s.userRepo.GetUserByEmail(s.email)
+		user, err := s.userRepo.GetUserByEmail(s.ctx, s.email)
 		s.NoError(err)
 		s.NotNil(user)
 		s.Equal(s.email, user.Email)
 		s.Equal(expectedFirebaseUID, user.FirebaseUID)
 
-		admin, err := s.userRepo.GetAdminByUserID(user.ID)
+		// Signup never creates an admin
+		admin, err := s.userRepo.GetAdminByUserID(s.ctx, user.ID)
 		s.NoError(err)
-		s.Equal(user.ID, admin.UserID)
+		s.Nil(admin)
 
 		// Validate created cart
-		cart, err := s.cartRepo.GetCartByUserID(user.ID)
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, user.ID)
 		s.NoError(err)
 		s.NotNil(cart)
 	})
 
-	s.Run("Signup as non-admin should be successful", func() {
-		authUtilMock := &mocks.AuthUtilMock{}
-		uc := usecase.NewAuthUsecase(s.env, s.userRepo, authUtilMock)
-		expectedFirebaseUID := gofakeit.UUID()
-		authUtilMock.CreateUserReturns(expectedFirebaseUID, nil)
+	s.Run("Signup should return an error if user already exist", func() {
+		authUtilMock := s.newAuthUtilMock()
+		uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
 
-		email := gofakeit.Email()
-		err := uc.SignUp(s.ctx, email, s.password, false)
+		err := uc.SignUp(s.ctx, s.email, s.password)
+		s.ErrorIs(err, domain.ErrUserAlreadyExist)
+	})
+
+	s.Run("Get access token should be successful", func() {
+		authUtilMock := s.newAuthUtilMock()
+		uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
+		authUtilMock.GetAccessTokenReturns(gofakeit.UUID(), nil)
+
+		tokenPair, err := uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
 		s.NoError(err)
+		s.NotEmpty(tokenPair.AccessToken)
+		s.NotEmpty(tokenPair.RefreshToken)
 
-		// Validate created user
-		user, err := s.userRepo.GetUserByEmail(email)
+		user, err := s.userRepo.GetUserByEmail(s.ctx, s.email)
 		s.NoError(err)
-		s.NotNil(user)
-		s.Equal(email, user.Email)
-		s.Equal(expectedFirebaseUID, user.FirebaseUID)
+		authUID, err := s.jwtUtil.ParseUserUID(tokenPair.AccessToken, true)
+		s.NoError(err)
+		s.Equal(user.FirebaseUID, authUID)
 
-		// Validate created cart
-		cart, err := s.cartRepo.GetCartByUserID(user.ID)
+		refreshToken, err := s.refreshTokenRepo.GetRefreshTokenByHash(s.ctx, s.hashUtil.HashToken(tokenPair.RefreshToken))
 		s.NoError(err)
-		s.NotNil(cart)
+		s.NotNil(refreshToken)
+		s.Equal(user.ID, refreshToken.UserID)
 	})
 
-	s.Run("Signup should return an error if user already exist", func() {
-		authUtilMock := &mocks.AuthUtilMock{}
-		uc := usecase.NewAuthUsecase(s.env, s.userRepo, authUtilMock)
+	s.Run("Get access token should return an error if user not found", func() {
+		authUtilMock := s.newAuthUtilMock()
+		authUtilMock.GetAccessTokenReturns(gofakeit.UUID(), nil)
+		uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
 
-		err := uc.SignUp(s.ctx, s.email, s.password, false)
-		s.Error(err)
+		_, err := uc.GetAccessToken(s.ctx, "notfound@email.com", s.password, s.client)
+		s.ErrorIs(err, domain.ErrInvalidCredentials)
+		// The provider is still asked so unknown emails take as long as known ones
+		s.Equal(1, authUtilMock.GetAccessTokenCallCount())
 	})
 
-	s.Run("Get access token should be successful", func() {
-		authUtilMock := &mocks.AuthUtilMock{}
-		uc := usecase.NewAuthUsecase(s.env, s.userRepo, authUtilMock)
-		expectedAccessToken := gofakeit.UUID()
-		authUtilMock.GetAccessTokenReturns(expectedAccessToken, nil)
+	s.Run("Get access token should return an error if result is empty which indicate invalid password", func() {
+		authUtilMock := s.newAuthUtilMock()
+		authUtilMock.GetAccessTokenReturns("", nil)
+		uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
+
+		_, err := uc.GetAccessToken(s.ctx, s.email, "invalid", s.client)
+		s.ErrorIs(err, domain.ErrInvalidCredentials)
+	})
+}
+
+func (s *AuthUsecaseSuite) TestAuthUsecaseLocalProvider() {
+	localEnv := &domain.Env{AuthProvider: domain.AuthProviderLocal, AuthActionURL: "https://ayobeli.test/auth/action", AuthActionTokenSecret: "action"}
+	authUtil := utils.NewAuthUtil(localEnv, nil, repository.NewCredentialRepository(s.db), repository.NewActionTokenRepository(s.db), s.hashUtil, s.jwtUtil)
+	uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtil, s.hashUtil, s.jwtUtil, s.mailerUtil)
+
+	s.Run("Signup should store credential", func() {
+		err := uc.SignUp(s.ctx, s.email, s.password)
+		s.NoError(err)
 
-		accessToken, err := uc.GetAccessToken(s.ctx, s.email, s.password)
+		var passwordHash string
+		err = s.db.Get(&passwordHash, "SELECT password_hash FROM credentials WHERE email = $1;", s.email)
 		s.NoError(err)
-		s.Equal(expectedAccessToken, accessToken)
+		s.NotEqual(s.password, passwordHash)
 	})
 
-	s.Run("Get access token should return an error if user not found", func() {
-		authUtilMock := &mocks.AuthUtilMock{}
-		uc := usecase.NewAuthUsecase(s.env, s.userRepo, authUtilMock)
+	s.Run("Get access token should return a token that verifies to the user", func() {
+		tokenPair, err := uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
+		s.NoError(err)
+
+		authUID, err := authUtil.VerifyToken(s.ctx, tokenPair.AccessToken)
+		s.NoError(err)
+		user, err := s.userRepo.GetUserByFirebaseUID(s.ctx, authUID)
+		s.NoError(err)
+		s.NotNil(user)
+		s.Equal(s.email, user.Email)
+	})
 
-		_, err := uc.GetAccessToken(s.ctx, "notfound@email.com", s.password)
+	s.Run("Get access token should return an error given invalid password", func() {
+		_, err := uc.GetAccessToken(s.ctx, s.email, "invalid1234", s.client)
 		s.Error(err)
 	})
 
-	s.Run("Get access token should return an error if result is empty which indicate invalid password", func() {
-		authUtilMock := &mocks.AuthUtilMock{}
-		authUtilMock.GetAccessTokenReturns("", nil)
-		uc := usecase.NewAuthUsecase(s.env, s.userRepo, authUtilMock)
+	s.Run("Signup should email a verification link that verifies the email once", func() {
+		_, mail := s.mailerUtil.SendMailArgsForCall(0)
+		s.Equal(s.email, mail.To)
+		code := s.oobCode(mail.Body, domain.ActionModeVerifyEmail)
+
+		err := uc.VerifyEmail(s.ctx, code)
+		s.NoError(err)
+		user, err := s.userRepo.GetUserByEmail(s.ctx, s.email)
+		s.NoError(err)
+		s.True(user.EmailVerifiedAt.Valid)
+
+		err = uc.VerifyEmail(s.ctx, code)
+		s.ErrorIs(err, domain.ErrInvalidActionCode)
+		err = uc.SendEmailVerification(s.ctx, user)
+		s.ErrorIs(err, domain.ErrEmailAlreadyVerified)
+	})
 
-		_, err := uc.GetAccessToken(s.ctx, s.email, "invalid")
+	s.Run("Forgot password should not send an email given unknown email", func() {
+		callCount := s.mailerUtil.SendMailCallCount()
+		err := uc.ForgotPassword(s.ctx, gofakeit.Email())
+		s.NoError(err)
+		s.Equal(callCount, s.mailerUtil.SendMailCallCount())
+	})
+
+	s.Run("Reset password should change the password and end every session", func() {
+		tokenPair, err := uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
+		s.NoError(err)
+
+		err = uc.ForgotPassword(s.ctx, s.email)
+		s.NoError(err)
+		_, mail := s.mailerUtil.SendMailArgsForCall(s.mailerUtil.SendMailCallCount() - 1)
+		code := s.oobCode(mail.Body, domain.ActionModeResetPassword)
+
+		err = uc.VerifyEmail(s.ctx, code)
+		s.ErrorIs(err, domain.ErrInvalidActionCode)
+		err = uc.ResetPassword(s.ctx, code, "newpassword1234")
+		s.NoError(err)
+		err = uc.ResetPassword(s.ctx, code, "otherpassword1234")
+		s.ErrorIs(err, domain.ErrInvalidActionCode)
+
+		_, err = uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
 		s.Error(err)
+		_, err = uc.GetAccessToken(s.ctx, s.email, "newpassword1234", s.client)
+		s.NoError(err)
+		_, err = uc.RefreshToken(s.ctx, tokenPair.RefreshToken, s.client)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+	})
+}
+
+// oobCode returns the code of the action link in body, the link has to be of the given mode.
+func (s *AuthUsecaseSuite) oobCode(body, mode string) string {
+	start := strings.Index(body, "https://")
+	s.Require().NotEqual(-1, start)
+	end := strings.IndexAny(body[start:], " \n")
+	if end == -1 {
+		end = len(body) - start
+	}
+	link, err := url.Parse(body[start : start+end])
+	s.Require().NoError(err)
+	s.Require().Equal(mode, link.Query().Get("mode"))
+
+	return link.Query().Get("oobCode")
+}
+
+func (s *AuthUsecaseSuite) TestAuthUsecaseRefreshToken() {
+	authUtilMock := s.newAuthUtilMock()
+	authUtilMock.CreateUserReturns(gofakeit.UUID(), nil)
+	authUtilMock.GetAccessTokenReturns(gofakeit.UUID(), nil)
+	uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
+
+	err := uc.SignUp(s.ctx, s.email, s.password)
+	s.NoError(err)
+	tokenPair, err := uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
+	s.NoError(err)
+	firstRefreshToken := tokenPair.RefreshToken
+
+	s.Run("Refresh token should return error given invalid token", func() {
+		_, err := uc.RefreshToken(s.ctx, "invalid", s.client)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+
+		_, err = uc.RefreshToken(s.ctx, tokenPair.AccessToken, s.client)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+	})
+
+	s.Run("Refresh token should rotate refresh token", func() {
+		res, err := uc.RefreshToken(s.ctx, firstRefreshToken, s.client)
+		s.NoError(err)
+		s.NotEqual(firstRefreshToken, res.RefreshToken)
+
+		oldToken, err := s.refreshTokenRepo.GetRefreshTokenByHash(s.ctx, s.hashUtil.HashToken(firstRefreshToken))
+		s.NoError(err)
+		newToken, err := s.refreshTokenRepo.GetRefreshTokenByHash(s.ctx, s.hashUtil.HashToken(res.RefreshToken))
+		s.NoError(err)
+		s.True(oldToken.UsedAt.Valid)
+		s.Equal(oldToken.FamilyUID, newToken.FamilyUID)
+
+		tokenPair = res
+	})
+
+	s.Run("Refresh token should revoke the family given reused refresh token", func() {
+		_, err := uc.RefreshToken(s.ctx, firstRefreshToken, s.client)
+		s.ErrorIs(err, domain.ErrRefreshTokenReused)
+
+		_, err = uc.RefreshToken(s.ctx, tokenPair.RefreshToken, s.client)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+	})
+}
+
+func (s *AuthUsecaseSuite) TestAuthUsecaseLogout() {
+	authUtilMock := s.newAuthUtilMock()
+	authUtilMock.CreateUserReturns(gofakeit.UUID(), nil)
+	authUtilMock.GetAccessTokenReturns(gofakeit.UUID(), nil)
+	uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
+
+	err := uc.SignUp(s.ctx, s.email, s.password)
+	s.NoError(err)
+	user, err := s.userRepo.GetUserByEmail(s.ctx, s.email)
+	s.NoError(err)
+	firstTokenPair, err := uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
+	s.NoError(err)
+	secondTokenPair, err := uc.GetAccessToken(s.ctx, s.email, s.password, s.client)
+	s.NoError(err)
+
+	s.Run("List sessions should return every login", func() {
+		sessions, err := uc.ListSessions(s.ctx, user.ID)
+		s.NoError(err)
+		s.Len(sessions, 2)
+		s.Equal(s.client.UserAgent, sessions[0].UserAgent)
+		s.Equal(s.client.IPAddress, sessions[0].IPAddress)
+	})
+
+	s.Run("Logout should return error given refresh token of another user", func() {
+		err := uc.Logout(s.ctx, user.ID+1, firstTokenPair.RefreshToken)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+	})
+
+	s.Run("Logout should end the current session only", func() {
+		err := uc.Logout(s.ctx, user.ID, firstTokenPair.RefreshToken)
+		s.NoError(err)
+
+		_, err = uc.RefreshToken(s.ctx, firstTokenPair.RefreshToken, s.client)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+
+		sessions, err := uc.ListSessions(s.ctx, user.ID)
+		s.NoError(err)
+		s.Len(sessions, 1)
 	})
+
+	s.Run("Logout all should end every session and set revocation timestamp", func() {
+		err := uc.LogoutAll(s.ctx, user)
+		s.NoError(err)
+		s.Equal(1, authUtilMock.RevokeTokensCallCount())
+
+		_, err = uc.RefreshToken(s.ctx, secondTokenPair.RefreshToken, s.client)
+		s.ErrorIs(err, domain.ErrInvalidRefreshToken)
+
+		sessions, err := uc.ListSessions(s.ctx, user.ID)
+		s.NoError(err)
+		s.Empty(sessions)
+
+		user, err := s.userRepo.GetUserByEmail(s.ctx, s.email)
+		s.NoError(err)
+		s.True(user.TokensRevokedAt.Valid)
+	})
+}
+
+func (s *AuthUsecaseSuite) TestAuthUsecaseLoginLockout() {
+	loginAttemptRepos := map[string]domain.LoginAttemptRepository{
+		domain.LoginAttemptStoreMemory:   repository.NewMemoryLoginAttemptRepository(),
+		domain.LoginAttemptStorePostgres: repository.NewPostgresLoginAttemptRepository(s.db),
+	}
+	authUtilMock := s.newAuthUtilMock()
+	authUtilMock.CreateUserReturns(gofakeit.UUID(), nil)
+	err := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil).SignUp(s.ctx, s.email, s.password)
+	s.NoError(err)
+
+	for store, loginAttemptRepo := range loginAttemptRepos {
+		uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, s.cartRepo, s.refreshTokenRepo, loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
+
+		s.Run(store+": email should be locked after max failures even with the right password", func() {
+			authUtilMock.GetAccessTokenReturns("", nil)
+			client := &domain.AuthUsecasePropertyClient{IPAddress: gofakeit.IPv4Address()}
+			for i := 0; i < domain.LoginMaxFailuresPerEmail; i++ {
+				_, err := uc.GetAccessToken(s.ctx, s.email, "invalid1234", client)
+				s.ErrorIs(err, domain.ErrInvalidCredentials)
+			}
+
+			authUtilMock.GetAccessTokenReturns(gofakeit.UUID(), nil)
+			_, err := uc.GetAccessToken(s.ctx, s.email, s.password, client)
+			s.ErrorIs(err, domain.ErrTooManyLoginAttempts)
+
+			loginAttempt, err := loginAttemptRepo.GetLoginAttempt(s.ctx, "email:"+s.email)
+			s.NoError(err)
+			s.True(loginAttempt.LockedUntil.Valid)
+			s.WithinDuration(time.Now().Add(domain.LoginBaseLockout), loginAttempt.LockedUntil.Time, 5*time.Second)
+		})
+
+		s.Run(store+": unknown emails should count towards the ip lockout", func() {
+			authUtilMock.GetAccessTokenReturns("", nil)
+			client := &domain.AuthUsecasePropertyClient{IPAddress: gofakeit.IPv4Address()}
+			for i := 0; i < domain.LoginMaxFailuresPerIP; i++ {
+				_, err := uc.GetAccessToken(s.ctx, gofakeit.Email(), "invalid1234", client)
+				s.ErrorIs(err, domain.ErrInvalidCredentials)
+			}
+
+			_, err := uc.GetAccessToken(s.ctx, gofakeit.Email(), "invalid1234", client)
+			s.ErrorIs(err, domain.ErrTooManyLoginAttempts)
+		})
+
+		s.Run(store+": failures should start over after the failure window", func() {
+			key := "email:" + gofakeit.Email()
+			now := time.Now().UTC()
+			for i := 0; i < domain.LoginMaxFailuresPerEmail+2; i++ {
+				_, err := loginAttemptRepo.RecordFailedLoginAttempt(s.ctx, key, now)
+				s.NoError(err)
+			}
+			loginAttempt, err := loginAttemptRepo.GetLoginAttempt(s.ctx, key)
+			s.NoError(err)
+			s.Equal(domain.LoginMaxFailuresPerEmail+2, loginAttempt.Failures)
+
+			_, err = loginAttemptRepo.RecordFailedLoginAttempt(s.ctx, key, now.Add(domain.LoginFailureWindow+time.Second))
+			s.NoError(err)
+			loginAttempt, err = loginAttemptRepo.GetLoginAttempt(s.ctx, key)
+			s.NoError(err)
+			s.Equal(1, loginAttempt.Failures)
+		})
+	}
+}
+
+// failingCartRepository fails cart creation so SignUp has to roll back the user it created.
+type failingCartRepository struct {
+	domain.CartRepository
+}
+
+func (f *failingCartRepository) CreateCart(ctx context.Context, userID int) error {
+	return errors.New("cart creation failed")
+}
+
+func (s *AuthUsecaseSuite) TestSignUpRollback() {
+	authUtilMock := s.newAuthUtilMock()
+	authUtilMock.CreateUserReturns(gofakeit.UUID(), nil)
+	uc := usecase.NewAuthUsecase(s.env, s.loggerUtil, s.txManager, s.userRepo, &failingCartRepository{s.cartRepo}, s.refreshTokenRepo, s.loginAttemptRepo, authUtilMock, s.hashUtil, s.jwtUtil, s.mailerUtil)
+
+	err := uc.SignUp(s.ctx, s.email, s.password)
+	s.Error(err)
+
+	user, err := s.userRepo.GetUserByEmail(s.ctx, s.email)
+	s.NoError(err)
+	s.Nil(user)
 }
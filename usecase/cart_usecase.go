@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
@@ -10,17 +12,62 @@ import (
 )
 
 type baseCartUsecase struct {
+	env            *domain.Env
 	cartRepository domain.CartRepository
 	cartUtil       domain.CartUtil
+	aesEncryptUtil domain.AesEncryptUtil
 }
 
-func NewCartUsecase(cartRepository domain.CartRepository, cartUtil domain.CartUtil) domain.CartUsecase {
-	return &baseCartUsecase{cartRepository: cartRepository, cartUtil: cartUtil}
+func NewCartUsecase(env *domain.Env, cartRepository domain.CartRepository, cartUtil domain.CartUtil, aesEncryptUtil domain.AesEncryptUtil) domain.CartUsecase {
+	return &baseCartUsecase{env: env, cartRepository: cartRepository, cartUtil: cartUtil, aesEncryptUtil: aesEncryptUtil}
+}
+
+// validateStock checks that the product can be sold and that quantity, the total quantity of the
+// product in the cart, does not exceed its stock.
+func (b *baseCartUsecase) validateStock(product *domain.ProductModel, quantity int) error {
+	if product.Status != "ACTIVE" {
+		return fmt.Errorf("%w: %s", domain.ErrProductUnavailable, product.Name)
+	}
+	if quantity > product.Stock {
+		return fmt.Errorf("%w: %s", domain.ErrInsufficientStock, product.Name)
+	}
+
+	return nil
+}
+
+// reservedUntil returns when a reservation made now expires, or an invalid time when reservation is disabled.
+func (b *baseCartUsecase) reservedUntil(now time.Time) sql.NullTime {
+	if b.env.CartReservationTTLMinutes <= 0 {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: now.Add(time.Duration(b.env.CartReservationTTLMinutes) * time.Minute), Valid: true}
+}
+
+// cartProductQuantity sums the quantity of a product across the items of a cart, skipping the item with excludeUID.
+func (b *baseCartUsecase) cartProductQuantity(cart *domain.CartModel, productID int, excludeUID string) int {
+	quantity := 0
+	for _, cartItem := range cart.CartItems {
+		if cartItem.ProductID == productID && cartItem.UID != excludeUID {
+			quantity += cartItem.Quantity
+		}
+	}
+
+	return quantity
+}
+
+func (b *baseCartUsecase) GetProductByUID(ctx context.Context, UID string) (*domain.ProductModel, error) {
+	product, err := b.cartRepository.GetProductByUID(ctx, UID)
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
 }
 
 func (b *baseCartUsecase) GetCartByUserID(ctx context.Context, userID int) (*domain.CartControllerResponseGetCart, error) {
 	var res domain.CartControllerResponseGetCart
-	cart, err := b.cartRepository.GetCartByUserID(userID)
+	cart, err := b.cartRepository.GetCartByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +84,7 @@ func (b *baseCartUsecase) GetCartByUserID(ctx context.Context, userID int) (*dom
 }
 
 func (b *baseCartUsecase) GetCartByUserIDMiddleware(ctx context.Context, userID int) (*domain.CartModel, error) {
-	cart, err := b.cartRepository.GetCartByUserID(userID)
+	cart, err := b.cartRepository.GetCartByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -45,16 +92,87 @@ func (b *baseCartUsecase) GetCartByUserIDMiddleware(ctx context.Context, userID
 	return cart, nil
 }
 
+// CreateGuestCart creates a cart without a user and returns its token, the encrypted cart uid.
+func (b *baseCartUsecase) CreateGuestCart(ctx context.Context) (string, error) {
+	UID, err := b.cartRepository.CreateGuestCart(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return b.aesEncryptUtil.Encrypt(UID)
+}
+
+func (b *baseCartUsecase) GetGuestCart(ctx context.Context, token string) (*domain.CartControllerResponseGetCart, error) {
+	var res domain.CartControllerResponseGetCart
+	cart, err := b.GetGuestCartMiddleware(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil {
+		return nil, nil
+	}
+
+	err = copier.Copy(&res, &cart)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetGuestCartMiddleware returns the guest cart of the token, or nil if the token is invalid or the
+// cart no longer exists or has been claimed by a user.
+func (b *baseCartUsecase) GetGuestCartMiddleware(ctx context.Context, token string) (*domain.CartModel, error) {
+	UID, err := b.aesEncryptUtil.Decrypt(token)
+	if err != nil {
+		return nil, nil
+	}
+
+	cart, err := b.cartRepository.GetCartByUID(ctx, UID)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil || cart.UserID.Valid {
+		return nil, nil
+	}
+
+	return cart, nil
+}
+
+// MergeGuestCart folds the guest cart of the token into the cart of the user. Invalid tokens and
+// carts that were already merged are ignored.
+func (b *baseCartUsecase) MergeGuestCart(ctx context.Context, token string, userID int) error {
+	guestCart, err := b.GetGuestCartMiddleware(ctx, token)
+	if err != nil {
+		return err
+	}
+	if guestCart == nil {
+		return nil
+	}
+
+	userCart, err := b.cartRepository.GetCartByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if userCart == nil {
+		return domain.ErrCartNotFound
+	}
+
+	return b.cartRepository.MergeCart(ctx, guestCart.ID, userCart.ID)
+}
+
 func (b *baseCartUsecase) CreateCartItem(ctx context.Context, payload *domain.CartUsecasePayloadCreateCartItem) (string, error) {
+	err := b.validateStock(payload.Product, b.cartProductQuantity(payload.Cart, payload.Product.ID, "")+payload.Quantity)
+	if err != nil {
+		return "", err
+	}
+
 	metadata := utils.GenerateMetadata()
 	calculatedCart, err := b.cartUtil.CalculateCreateCartItem(payload)
 	if err != nil {
 		return "", err
 	}
 
-	log.Println(calculatedCart.CartTotalWeightValue)
-	log.Println(calculatedCart.CartItemTotalWeightValue)
-
 	cartItemPayload := domain.CartRepositoryPayloadCreateCartItem{
 		UID:                metadata.UID(),
 		Quantity:           payload.Quantity,
@@ -72,23 +190,15 @@ func (b *baseCartUsecase) CreateCartItem(ctx context.Context, payload *domain.Ca
 		OfferPrice:         payload.Product.OfferPrice,
 		OfferPriceValue:    payload.Product.OfferPriceValue,
 		Discount:           payload.Product.Discount,
+		ProductStatus:      payload.Product.Status,
 		CartID:             payload.Cart.ID,
 		ProductID:          payload.Product.ID,
+		ReservedUntil:      b.reservedUntil(metadata.UpdatedAt),
 		CreatedAt:          metadata.CreatedAt,
 		UpdatedAt:          metadata.UpdatedAt,
 	}
 
-	cartPayload := domain.CartRepositoryPayloadUpdateCart{
-		UID:              payload.Cart.UID,
-		Quantity:         calculatedCart.CartQuantity,
-		TotalPriceValue:  calculatedCart.CartTotalPriceValue,
-		TotalPrice:       calculatedCart.CartTotalPrice,
-		TotalWeightValue: calculatedCart.CartTotalWeightValue,
-		TotalWeight:      calculatedCart.CartTotalWeight,
-		UpdatedAt:        metadata.UpdatedAt,
-	}
-
-	UID, err := b.cartRepository.CreateCartItem(cartItemPayload, cartPayload)
+	UID, err := b.cartRepository.CreateCartItem(ctx, cartItemPayload)
 	if err != nil {
 		return "", err
 	}
@@ -97,7 +207,7 @@ func (b *baseCartUsecase) CreateCartItem(ctx context.Context, payload *domain.Ca
 }
 
 func (b *baseCartUsecase) GetCartItemByUID(ctx context.Context, UID string) (*domain.CartItemModel, error) {
-	cartItem, err := b.cartRepository.GetCartItemByUID(UID)
+	cartItem, err := b.cartRepository.GetCartItemByUID(ctx, UID)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +215,8 @@ func (b *baseCartUsecase) GetCartItemByUID(ctx context.Context, UID string) (*do
 	return cartItem, nil
 }
 
-func (b *baseCartUsecase) GetCartItemByProductID(ctx context.Context, productID int) (*domain.CartItemModel, error) {
-	cartItem, err := b.cartRepository.GetCartItemByProductID(productID)
+func (b *baseCartUsecase) GetCartItemByProductID(ctx context.Context, cartID, productID int) (*domain.CartItemModel, error) {
+	cartItem, err := b.cartRepository.GetCartItemByProductID(ctx, cartID, productID)
 	if err != nil {
 		return nil, err
 	}
@@ -115,33 +225,29 @@ func (b *baseCartUsecase) GetCartItemByProductID(ctx context.Context, productID
 }
 
 func (b *baseCartUsecase) UpdateCartItem(ctx context.Context, payload *domain.CartUsecasePayloadUpdateCartItem) error {
-	metadata := utils.GenerateMetadata()
-	calculatedCart, err := b.cartUtil.CalculateUpdateCartItem(payload)
+	product, err := b.cartRepository.GetProductByID(ctx, payload.CartItem.ProductID)
 	if err != nil {
 		return err
 	}
-
-	cartItemPayload := domain.CartRepositoryPayloadUpdateCartItem{
-		UID:              payload.UID,
-		Quantity:         payload.Quantity,
-		TotalPriceValue:  calculatedCart.CartItemTotalPriceValue,
-		TotalPrice:       calculatedCart.CartItemTotalPrice,
-		TotalWeightValue: calculatedCart.CartItemTotalWeightValue,
-		TotalWeight:      calculatedCart.CartItemTotalWeight,
-		UpdatedAt:        metadata.UpdatedAt,
+	if product == nil {
+		return fmt.Errorf("%w: %s", domain.ErrProductUnavailable, payload.CartItem.ProductName)
+	}
+	err = b.validateStock(product, b.cartProductQuantity(payload.Cart, product.ID, payload.UID)+payload.Quantity)
+	if err != nil {
+		return err
 	}
 
-	cartPayload := domain.CartRepositoryPayloadUpdateCart{
-		UID:              payload.Cart.UID,
-		Quantity:         calculatedCart.CartQuantity,
-		TotalPriceValue:  calculatedCart.CartTotalPriceValue,
-		TotalPrice:       calculatedCart.CartTotalPrice,
-		TotalWeightValue: calculatedCart.CartTotalWeightValue,
-		TotalWeight:      calculatedCart.CartTotalWeight,
-		UpdatedAt:        metadata.UpdatedAt,
+	metadata := utils.GenerateMetadata()
+	cartItemPayload := domain.CartRepositoryPayloadUpdateCartItem{
+		UID:           payload.UID,
+		Quantity:      payload.Quantity,
+		CartID:        payload.Cart.ID,
+		ProductID:     product.ID,
+		ReservedUntil: b.reservedUntil(metadata.UpdatedAt),
+		UpdatedAt:     metadata.UpdatedAt,
 	}
 
-	err = b.cartRepository.UpdateCartItem(cartItemPayload, cartPayload)
+	err = b.cartRepository.UpdateCartItem(ctx, cartItemPayload)
 	if err != nil {
 		return err
 	}
@@ -150,23 +256,7 @@ func (b *baseCartUsecase) UpdateCartItem(ctx context.Context, payload *domain.Ca
 }
 
 func (b *baseCartUsecase) DeleteCartItemByUID(ctx context.Context, payload *domain.CartUsecasePayloadDeleteCartItem) error {
-	metadata := utils.GenerateMetadata()
-	calculatedCart, err := b.cartUtil.CalculateDeleteCartItem(payload)
-	if err != nil {
-		return err
-	}
-
-	cartPayload := domain.CartRepositoryPayloadUpdateCart{
-		UID:              payload.Cart.UID,
-		Quantity:         payload.Cart.Quantity - payload.CartItem.Quantity,
-		TotalPriceValue:  calculatedCart.CartTotalPriceValue,
-		TotalPrice:       calculatedCart.CartTotalPrice,
-		TotalWeightValue: calculatedCart.CartTotalWeightValue,
-		TotalWeight:      calculatedCart.CartTotalWeight,
-		UpdatedAt:        metadata.UpdatedAt,
-	}
-
-	err = b.cartRepository.DeleteCartItemByUID(payload.UID, cartPayload)
+	err := b.cartRepository.DeleteCartItemByUID(ctx, payload.UID, payload.Cart.ID)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ type CartUsecaseSuite struct {
 	pool           *dockertest.Pool
 	resource       *dockertest.Resource
 	ctx            context.Context
+	env            *domain.Env
 	now            time.Time
 	nowUTC         time.Time
 	userRepo       domain.UserRepository
@@ -40,7 +42,7 @@ type CartUsecaseSuite struct {
 
 func (s *CartUsecaseSuite) BeforeTest(suiteName, testName string) {
 	metadata := utils.GenerateMetadata()
-	ID, err := s.userRepo.CreateUser(&domain.UserRepositoryPayloadCreateUser{
+	ID, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
 		UID:          metadata.UID(),
 		Email:        gofakeit.Email(),
 		Name:         gofakeit.Name(),
@@ -52,6 +54,10 @@ func (s *CartUsecaseSuite) BeforeTest(suiteName, testName string) {
 	if err != nil {
 		log.Fatal(err)
 	}
+	err = s.cartRepo.CreateCart(s.ctx, ID)
+	if err != nil {
+		log.Fatal(err)
+	}
 	s.userID = ID
 
 	for i := 1; i <= 10; i++ {
@@ -61,7 +67,7 @@ func (s *CartUsecaseSuite) BeforeTest(suiteName, testName string) {
 		weight := s.productUtil.FormatWeight(weightValue)
 		basePriceValue := gofakeit.IntRange(5000, 1_000_000)
 		discount := gofakeit.IntRange(0, 100)
-		stock := gofakeit.IntRange(0, 100)
+		stock := gofakeit.IntRange(10, 100)
 		computedPrice, _ := s.productUtil.CalculatePrice(basePriceValue, discount)
 		sku := gofakeit.LoremIpsumWord() + fmt.Sprint(i)
 
@@ -85,7 +91,7 @@ func (s *CartUsecaseSuite) BeforeTest(suiteName, testName string) {
 			UpdatedAt:       metadata.UpdatedAt,
 		}
 
-		_, err := s.productRepo.Create(payload)
+		_, err := s.productRepo.Create(s.ctx, payload)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -105,13 +111,14 @@ func (s *CartUsecaseSuite) SetupTest() {
 	ctx := context.Background()
 	now := time.Now()
 	userRepo := repository.NewUserRepository(s.db)
-	cartRepo := repository.NewCartRepository(s.db)
-	productRepo := repository.NewProductRepository(s.db)
-	aesEncryptUtil := utils.NewAesEncrypt(env.AesSecret)
 	productUtil := utils.NewProductUtil()
+	cartRepo := repository.NewCartRepository(s.db, productUtil)
+	productRepo := repository.NewProductRepository(s.db, productUtil)
+	aesEncryptUtil := utils.NewAesEncrypt(env.AesSecret)
 	cartUtil := utils.NewCartUtil(productUtil)
 
 	s.ctx = ctx
+	s.env = env
 	s.now = now
 	s.nowUTC = now.UTC()
 	s.userRepo = userRepo
@@ -134,14 +141,14 @@ func TestCartUsecaseSuite(t *testing.T) {
 
 func (s *CartUsecaseSuite) TestCartUsecase() {
 	s.Run("Create n cart items", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
-		cart, err := s.cartRepo.GetCartByUserID(1)
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, 1)
 		s.NoError(err)
 		s.NotNil(cart)
 
 		for i := 0; i < 3; i++ {
-			product, err := s.productRepo.GetByUID(s.productUIDS[i])
+			product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[i])
 			s.NoError(err)
 
 			payload := &domain.CartUsecasePayloadCreateCartItem{
@@ -155,7 +162,7 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 
 			calculatedCart, err := s.cartUtil.CalculateCreateCartItem(payload)
 			s.NoError(err)
-			cart, err = s.cartRepo.GetCartByUserID(1)
+			cart, err = s.cartRepo.GetCartByUserID(s.ctx, 1)
 			s.NoError(err)
 			s.Equal(product.BasePrice, cart.CartItems[i].BasePrice)
 			s.Equal(product.BasePriceValue, cart.CartItems[i].BasePriceValue)
@@ -181,12 +188,12 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 	})
 
 	s.Run("Update cart item by uid", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
-		cart, err := s.cartRepo.GetCartByUserID(1)
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, 1)
 		s.NoError(err)
 		s.NotNil(cart)
-		cartItem, err := s.cartRepo.GetCartItemByUID(s.cartItemUID)
+		cartItem, err := s.cartRepo.GetCartItemByUID(s.ctx, s.cartItemUID)
 		s.NoError(err)
 		s.NotNil(cartItem)
 
@@ -201,9 +208,9 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 
 		calculatedCart, err := s.cartUtil.CalculateUpdateCartItem(payload)
 		s.NoError(err)
-		cart, err = s.cartRepo.GetCartByUserID(1)
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, 1)
 		s.NoError(err)
-		cartItem, err = s.cartRepo.GetCartItemByUID(s.cartItemUID)
+		cartItem, err = s.cartRepo.GetCartItemByUID(s.ctx, s.cartItemUID)
 		s.NoError(err)
 
 		s.Equal(payload.Quantity, cartItem.Quantity)
@@ -218,8 +225,25 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 		s.Equal(calculatedCart.CartItemTotalWeightValue, cartItem.TotalWeightValue)
 	})
 
+	s.Run("Get product by uid", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		product, err := uc.GetProductByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+		s.NotNil(product)
+		s.Equal(s.productUIDS[0], product.UID)
+	})
+
+	s.Run("Get product by uid return nil given invalid uid", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		product, err := uc.GetProductByUID(s.ctx, "invalid")
+		s.NoError(err)
+		s.Nil(product)
+	})
+
 	s.Run("Get cart by user id", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
 		cart, err := uc.GetCartByUserID(s.ctx, s.userID)
 		s.NoError(err)
@@ -227,7 +251,7 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 	})
 
 	s.Run("Get cart by user id return nil given invalid user id", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
 		cart, err := uc.GetCartByUserID(s.ctx, 2)
 		s.NoError(err)
@@ -235,15 +259,15 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 	})
 
 	s.Run("Get cart by user id middleware", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
 		cart, err := uc.GetCartByUserIDMiddleware(s.ctx, s.userID)
 		s.NoError(err)
-		s.Equal(s.userID, cart.UserID)
+		s.Equal(int64(s.userID), cart.UserID.Int64)
 	})
 
 	s.Run("Get cart by user id middleware return nil given invalid user id", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
 		cart, err := uc.GetCartByUserIDMiddleware(s.ctx, 2)
 		s.NoError(err)
@@ -251,7 +275,7 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 	})
 
 	s.Run("Get cart item by uid", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
 		cartItem, err := uc.GetCartItemByUID(s.ctx, s.cartItemUID)
 		s.NoError(err)
@@ -259,7 +283,7 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 	})
 
 	s.Run("Get cart item by uid return nil given invalid uid", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
 		cartItem, err := uc.GetCartItemByUID(s.ctx, "invalid")
 		s.NoError(err)
@@ -267,20 +291,35 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 	})
 
 	s.Run("Get cart item by product id", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
-		product, err := s.productRepo.GetByUID(s.productUIDS[0])
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
 		s.NoError(err)
 
-		cartItem, err := uc.GetCartItemByProductID(s.ctx, product.ID)
+		cartItem, err := uc.GetCartItemByProductID(s.ctx, cart.ID, product.ID)
 		s.NoError(err)
 		s.NotNil(cartItem)
 	})
 
+	s.Run("Get cart item by product id return nil given another cart id", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+
+		cartItem, err := uc.GetCartItemByProductID(s.ctx, cart.ID+1, product.ID)
+		s.NoError(err)
+		s.Nil(cartItem)
+	})
+
 	s.Run("Delete cart item by uid", func() {
-		uc := usecase.NewCartUsecase(s.cartRepo, s.cartUtil)
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
 
-		cart, err := s.cartRepo.GetCartByUserID(1)
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, 1)
 		s.NoError(err)
 		s.NotNil(cart)
 
@@ -294,7 +333,7 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 
 		calculatedCart, err := s.cartUtil.CalculateDeleteCartItem(payload)
 		s.NoError(err)
-		cart, err = s.cartRepo.GetCartByUserID(1)
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, 1)
 		s.NoError(err)
 		s.Equal(calculatedCart.CartQuantity, cart.Quantity)
 		s.Equal(calculatedCart.CartTotalPrice, cart.TotalPrice)
@@ -302,8 +341,398 @@ func (s *CartUsecaseSuite) TestCartUsecase() {
 		s.Equal(calculatedCart.CartTotalWeight, cart.TotalWeight)
 		s.Equal(calculatedCart.CartTotalWeightValue, cart.TotalWeightValue)
 
-		cartItem, err := s.cartRepo.GetCartItemByUID(s.cartItemUID)
+		cartItem, err := s.cartRepo.GetCartItemByUID(s.ctx, s.cartItemUID)
 		s.NoError(err)
 		s.Nil(cartItem)
 	})
 }
+
+func (s *CartUsecaseSuite) TestCartUsecaseStock() {
+	s.Run("Create cart item should return error given quantity above stock", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: product.Stock + 1,
+		})
+		s.ErrorIs(err, domain.ErrInsufficientStock)
+	})
+
+	s.Run("Create cart item should return error given inactive product", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		_, err := s.db.Exec("UPDATE products SET status = 'INACTIVE' WHERE uid = $1;", s.productUIDS[1])
+		s.NoError(err)
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[1])
+		s.NoError(err)
+
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: 1,
+		})
+		s.ErrorIs(err, domain.ErrProductUnavailable)
+	})
+
+	s.Run("Reservation should hold stock until it expires", func() {
+		uc := usecase.NewCartUsecase(&domain.Env{CartReservationTTLMinutes: 15}, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		_, err := s.db.Exec("UPDATE products SET stock = 5 WHERE uid = $1;", s.productUIDS[2])
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[2])
+		s.NoError(err)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: 4,
+		})
+		s.NoError(err)
+
+		metadata := utils.GenerateMetadata()
+		otherUserID, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+			UID:          metadata.UID(),
+			Email:        gofakeit.Email(),
+			Name:         gofakeit.Name(),
+			Phone:        gofakeit.Phone(),
+			ProfileImage: gofakeit.ImageURL(100, 100),
+			CreatedAt:    metadata.CreatedAt,
+			UpdatedAt:    metadata.UpdatedAt,
+		})
+		s.NoError(err)
+		s.NoError(s.cartRepo.CreateCart(s.ctx, otherUserID))
+		otherCart, err := s.cartRepo.GetCartByUserID(s.ctx, otherUserID)
+		s.NoError(err)
+		s.NotNil(otherCart)
+
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     otherCart,
+			Product:  product,
+			Quantity: 2,
+		})
+		s.ErrorIs(err, domain.ErrInsufficientStock)
+
+		deleted, err := s.cartRepo.DeleteExpiredReservations(s.ctx, time.Now().Add(time.Hour))
+		s.NoError(err)
+		s.Equal(int64(1), deleted)
+
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     otherCart,
+			Product:  product,
+			Quantity: 2,
+		})
+		s.NoError(err)
+	})
+}
+
+func (s *CartUsecaseSuite) TestCartUsecaseConcurrency() {
+	s.Run("Parallel create cart items should keep cart totals equal to the sum of its items", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, len(s.productUIDS))
+		for _, productUID := range s.productUIDS {
+			product, err := s.productRepo.GetByUID(s.ctx, productUID)
+			s.NoError(err)
+
+			wg.Add(1)
+			go func(product *domain.ProductModel) {
+				defer wg.Done()
+				_, err := uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+					Cart:     cart,
+					Product:  product,
+					Quantity: gofakeit.IntRange(1, 10),
+				})
+				errs <- err
+			}(product)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			s.NoError(err)
+		}
+
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Len(cart.CartItems, len(s.productUIDS))
+
+		quantity := 0
+		totalPriceValue := 0
+		totalWeightValue := 0.0
+		for _, cartItem := range cart.CartItems {
+			quantity += cartItem.Quantity
+			totalPriceValue += cartItem.TotalPriceValue
+			totalWeightValue += cartItem.TotalWeightValue
+		}
+		totalPrice, err := s.productUtil.FormatRupiah(totalPriceValue)
+		s.NoError(err)
+		s.Equal(quantity, cart.Quantity)
+		s.Equal(totalPriceValue, cart.TotalPriceValue)
+		s.Equal(totalPrice, cart.TotalPrice)
+		s.InDelta(totalWeightValue, cart.TotalWeightValue, 0.01)
+	})
+
+	s.Run("Recalculate cart should fix totals that drifted from its items", func() {
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+
+		_, err = s.db.Exec("UPDATE carts SET quantity = 0, total_price_value = 0, total_weight_value = 0 WHERE id = $1;", cart.ID)
+		s.NoError(err)
+
+		err = s.cartRepo.RecalculateCart(s.ctx, cart.ID)
+		s.NoError(err)
+
+		recalculatedCart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Equal(cart.Quantity, recalculatedCart.Quantity)
+		s.Equal(cart.TotalPrice, recalculatedCart.TotalPrice)
+		s.Equal(cart.TotalPriceValue, recalculatedCart.TotalPriceValue)
+		s.Equal(cart.TotalWeight, recalculatedCart.TotalWeight)
+		s.Equal(cart.TotalWeightValue, recalculatedCart.TotalWeightValue)
+	})
+}
+
+func (s *CartUsecaseSuite) TestCartUsecaseProductUpdate() {
+	s.Run("Product update should refresh cart items and flag price change", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: 3,
+		})
+		s.NoError(err)
+
+		basePriceValue := product.BasePriceValue + 1000
+		computedPrice, err := s.productUtil.CalculatePrice(basePriceValue, product.Discount)
+		s.NoError(err)
+		err = s.productRepo.UpdateByUID(s.ctx, &domain.ProductRepositoryPayloadUpdateProduct{
+			UID:             product.UID,
+			Name:            product.Name + " Updated",
+			Slug:            product.Slug + "-updated",
+			SKU:             product.SKU.String,
+			Description:     product.Description,
+			Images:          domain.StringSlice{"updated.jpg"},
+			Weight:          product.Weight,
+			WeightValue:     product.WeightValue,
+			BasePrice:       computedPrice.Base,
+			BasePriceValue:  basePriceValue,
+			OfferPrice:      computedPrice.Offer,
+			OfferPriceValue: computedPrice.OfferValue,
+			Discount:        product.Discount,
+			Stock:           product.Stock,
+			Status:          "INACTIVE",
+			UpdatedAt:       time.Now().UTC(),
+		})
+		s.NoError(err)
+
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Len(cart.CartItems, 1)
+		cartItem := cart.CartItems[0]
+		s.Equal(product.Name+" Updated", cartItem.ProductName)
+		s.Equal("updated.jpg", cartItem.ProductImage)
+		s.Equal("INACTIVE", cartItem.ProductStatus)
+		s.Equal(computedPrice.OfferValue, cartItem.OfferPriceValue)
+		s.Equal(3*computedPrice.OfferValue, cartItem.TotalPriceValue)
+		s.Equal(computedPrice.OfferValue != product.OfferPriceValue, cartItem.PriceChanged)
+		s.Equal(cartItem.TotalPriceValue, cart.TotalPriceValue)
+	})
+
+	s.Run("Product delete should remove its cart items and recalculate the cart", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[1])
+		s.NoError(err)
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: 2,
+		})
+		s.NoError(err)
+
+		err = s.productRepo.DeleteByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		if s.Len(cart.CartItems, 1) {
+			cartItem := cart.CartItems[0]
+			s.Equal(product.ID, cartItem.ProductID)
+			s.Equal(cartItem.Quantity, cart.Quantity)
+			s.Equal(cartItem.TotalPriceValue, cart.TotalPriceValue)
+			s.Equal(cartItem.TotalPrice, cart.TotalPrice)
+			s.Equal(cartItem.TotalWeightValue, cart.TotalWeightValue)
+		}
+	})
+}
+
+func (s *CartUsecaseSuite) TestCartUsecaseMergeCartItem() {
+	s.Run("Create cart item should increase quantity of existing line given same product", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+
+		firstUID, err := uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: 2,
+		})
+		s.NoError(err)
+
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		secondUID, err := uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: 3,
+		})
+		s.NoError(err)
+		s.Equal(firstUID, secondUID)
+
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Len(cart.CartItems, 1)
+		s.Equal(5, cart.CartItems[0].Quantity)
+		s.Equal(5*product.OfferPriceValue, cart.CartItems[0].TotalPriceValue)
+		s.Equal(5, cart.Quantity)
+		s.Equal(5*product.OfferPriceValue, cart.TotalPriceValue)
+
+		cartItem, err := uc.GetCartItemByProductID(s.ctx, cart.ID, product.ID)
+		s.NoError(err)
+		s.Equal(firstUID, cartItem.UID)
+	})
+
+	s.Run("Create cart item should return error given merged quantity above stock", func() {
+		uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+			Cart:     cart,
+			Product:  product,
+			Quantity: product.Stock - 4,
+		})
+		s.ErrorIs(err, domain.ErrInsufficientStock)
+	})
+
+	s.Run("Unique constraint should reject duplicate lines", func() {
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+
+		_, err = s.db.Exec(`
+		INSERT INTO cart_items (uid, quantity, total_price, total_price_value, total_weight, total_weight_value, product_name, product_slug, product_image, product_weight, product_weight_value, base_price, base_price_value, offer_price, offer_price_value, discount, cart_id, product_id, updated_at)
+		SELECT 'duplicate', quantity, total_price, total_price_value, total_weight, total_weight_value, product_name, product_slug, product_image, product_weight, product_weight_value, base_price, base_price_value, offer_price, offer_price_value, discount, cart_id, product_id, updated_at
+		FROM cart_items
+		WHERE cart_id = $1;
+		`, cart.ID)
+		s.Error(err)
+	})
+}
+
+func (s *CartUsecaseSuite) TestCartUsecaseGuestCart() {
+	uc := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, s.aesEncryptUtil)
+	var token string
+
+	s.Run("Create guest cart should return token of a cart without user", func() {
+		var err error
+		token, err = uc.CreateGuestCart(s.ctx)
+		s.NoError(err)
+
+		cart, err := uc.GetGuestCartMiddleware(s.ctx, token)
+		s.NoError(err)
+		s.NotNil(cart)
+		s.False(cart.UserID.Valid)
+	})
+
+	s.Run("Get guest cart should return nil given invalid token", func() {
+		cart, err := uc.GetGuestCartMiddleware(s.ctx, "invalid")
+		s.NoError(err)
+		s.Nil(cart)
+	})
+
+	s.Run("Merge guest cart should fold items into user cart respecting stock", func() {
+		guestCart, err := uc.GetGuestCartMiddleware(s.ctx, token)
+		s.NoError(err)
+
+		_, err = s.db.Exec("UPDATE products SET stock = 5 WHERE uid = $1;", s.productUIDS[0])
+		s.NoError(err)
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[0])
+		s.NoError(err)
+		otherProduct, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[1])
+		s.NoError(err)
+
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{Cart: guestCart, Product: product, Quantity: 4})
+		s.NoError(err)
+		guestCart, err = uc.GetGuestCartMiddleware(s.ctx, token)
+		s.NoError(err)
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{Cart: guestCart, Product: otherProduct, Quantity: 1})
+		s.NoError(err)
+
+		userCart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		_, err = uc.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{Cart: userCart, Product: product, Quantity: 3})
+		s.NoError(err)
+
+		err = uc.MergeGuestCart(s.ctx, token, s.userID)
+		s.NoError(err)
+
+		userCart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Len(userCart.CartItems, 2)
+		cartItem, err := s.cartRepo.GetCartItemByProductID(s.ctx, userCart.ID, product.ID)
+		s.NoError(err)
+		s.Equal(5, cartItem.Quantity)
+		cartItem, err = s.cartRepo.GetCartItemByProductID(s.ctx, userCart.ID, otherProduct.ID)
+		s.NoError(err)
+		s.Equal(1, cartItem.Quantity)
+		s.Equal(6, userCart.Quantity)
+
+		guestCart, err = uc.GetGuestCartMiddleware(s.ctx, token)
+		s.NoError(err)
+		s.Nil(guestCart)
+	})
+
+	s.Run("Merge guest cart should ignore token of merged cart", func() {
+		err := uc.MergeGuestCart(s.ctx, token, s.userID)
+		s.NoError(err)
+	})
+
+	s.Run("Delete guest carts should only delete guest carts older than given time", func() {
+		_, err := uc.CreateGuestCart(s.ctx)
+		s.NoError(err)
+
+		deleted, err := s.cartRepo.DeleteGuestCartsUpdatedBefore(s.ctx, time.Now().Add(time.Hour))
+		s.NoError(err)
+		s.Equal(int64(1), deleted)
+
+		userCart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.NotNil(userCart)
+	})
+}
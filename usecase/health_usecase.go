@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+)
+
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+type baseHealthUsecase struct {
+	env              *domain.Env
+	loggerUtil       domain.LoggerUtil
+	healthRepository domain.HealthRepository
+	checks           []healthCheck
+
+	mu        sync.Mutex
+	readiness *domain.HealthControllerResponseReadiness
+	inFlight  *readinessRun
+}
+
+// readinessRun is a round of checks in progress, probes that arrive meanwhile wait for its result.
+type readinessRun struct {
+	done      chan struct{}
+	readiness *domain.HealthControllerResponseReadiness
+}
+
+func NewHealthUsecase(env *domain.Env, loggerUtil domain.LoggerUtil, healthRepository domain.HealthRepository, authUtil domain.AuthUtil) domain.HealthUsecase {
+	b := &baseHealthUsecase{
+		env:              env,
+		loggerUtil:       loggerUtil,
+		healthRepository: healthRepository,
+	}
+	b.checks = []healthCheck{
+		{name: domain.HealthDependencyPostgres, check: healthRepository.Ping},
+		{name: domain.HealthDependencyMigrations, check: b.checkMigrations},
+	}
+	// The auth provider is only checked on demand, Firebase counts every probe against the project quota.
+	if env.HealthCheckAuthProvider {
+		b.checks = append(b.checks, healthCheck{name: domain.HealthDependencyAuthProvider, check: authUtil.Ping})
+	}
+
+	return b
+}
+
+func (b *baseHealthUsecase) Readiness(ctx context.Context) *domain.HealthControllerResponseReadiness {
+	b.mu.Lock()
+	if b.readiness != nil && time.Since(b.readiness.CheckedAt) < domain.ReadinessCacheTTL {
+		readiness := b.readiness
+		b.mu.Unlock()
+		return readiness
+	}
+	if run := b.inFlight; run != nil {
+		b.mu.Unlock()
+		<-run.done
+		return run.readiness
+	}
+	run := &readinessRun{done: make(chan struct{})}
+	b.inFlight = run
+	b.mu.Unlock()
+
+	// The result is shared with the probes that follow, so it must not depend on this request being cancelled.
+	run.readiness = b.check(context.WithoutCancel(ctx))
+
+	b.mu.Lock()
+	b.readiness = run.readiness
+	b.inFlight = nil
+	b.mu.Unlock()
+	close(run.done)
+
+	return run.readiness
+}
+
+func (b *baseHealthUsecase) check(ctx context.Context) *domain.HealthControllerResponseReadiness {
+	readiness := &domain.HealthControllerResponseReadiness{
+		Status:       domain.HealthStatusUp,
+		CheckedAt:    time.Now(),
+		Dependencies: make([]*domain.HealthControllerResponseDependency, len(b.checks)),
+	}
+	var wg sync.WaitGroup
+	for i, check := range b.checks {
+		wg.Add(1)
+		go func(i int, check healthCheck) {
+			defer wg.Done()
+			readiness.Dependencies[i] = b.runCheck(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	for _, dependency := range readiness.Dependencies {
+		if dependency.Status != domain.HealthStatusUp {
+			readiness.Status = domain.HealthStatusDown
+		}
+	}
+
+	return readiness
+}
+
+func (b *baseHealthUsecase) runCheck(ctx context.Context, check healthCheck) *domain.HealthControllerResponseDependency {
+	ctx, cancel := context.WithTimeout(ctx, domain.HealthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.check(ctx)
+
+	dependency := &domain.HealthControllerResponseDependency{
+		Name:      check.name,
+		Status:    domain.HealthStatusUp,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		b.loggerUtil.Errorf("readiness check %s failed: %v", check.name, err)
+		dependency.Status = domain.HealthStatusDown
+		// Errors may reveal hosts and credentials, they are only logged in production.
+		if !b.env.IsProduction() {
+			dependency.Error = err.Error()
+		}
+	}
+
+	return dependency
+}
+
+// checkMigrations fails while the database is behind the migrations this build ships with. A database that is
+// ahead is fine, it happens during a rolling deploy once a newer instance has migrated.
+func (b *baseHealthUsecase) checkMigrations(ctx context.Context) error {
+	latest, err := b.healthRepository.LatestMigrationVersion()
+	if err != nil {
+		return err
+	}
+
+	version, dirty, err := b.healthRepository.MigrationVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < latest {
+		return fmt.Errorf("database is at migration %d, latest is %d", version, latest)
+	}
+
+	return nil
+}
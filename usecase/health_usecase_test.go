@@ -0,0 +1,140 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain/mocks"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthUsecaseSuite struct {
+	suite.Suite
+	db         *sqlx.DB
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	ctx        context.Context
+	env        *domain.Env
+	loggerUtil domain.LoggerUtil
+	healthRepo domain.HealthRepository
+}
+
+func (s *HealthUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	s.ctx = context.Background()
+	s.env = env
+	s.loggerUtil = utils.NewLoggerUtil(env)
+	s.healthRepo = repository.NewHealthRepository(s.db, "../migrations")
+}
+
+func (s *HealthUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestHealthUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(HealthUsecaseSuite))
+}
+
+func (s *HealthUsecaseSuite) TestReadiness() {
+	s.Run("Readiness should be up given a migrated database", func() {
+		uc := usecase.NewHealthUsecase(s.env, s.loggerUtil, s.healthRepo, &mocks.AuthUtilMock{})
+
+		readiness := uc.Readiness(s.ctx)
+		s.Equal(domain.HealthStatusUp, readiness.Status)
+		if s.Len(readiness.Dependencies, 2) {
+			s.Equal(domain.HealthDependencyPostgres, readiness.Dependencies[0].Name)
+			s.Equal(domain.HealthStatusUp, readiness.Dependencies[0].Status)
+			s.Equal(domain.HealthDependencyMigrations, readiness.Dependencies[1].Name)
+			s.Equal(domain.HealthStatusUp, readiness.Dependencies[1].Status)
+		}
+	})
+
+	s.Run("Readiness should be down given the database is behind the migrations", func() {
+		healthRepoMock := &mocks.HealthRepositoryMock{}
+		healthRepoMock.LatestMigrationVersionReturns(2, nil)
+		healthRepoMock.MigrationVersionReturns(1, false, nil)
+		uc := usecase.NewHealthUsecase(s.env, s.loggerUtil, healthRepoMock, &mocks.AuthUtilMock{})
+
+		readiness := uc.Readiness(s.ctx)
+		s.Equal(domain.HealthStatusDown, readiness.Status)
+		s.Equal(domain.HealthStatusUp, readiness.Dependencies[0].Status)
+		s.Equal(domain.HealthStatusDown, readiness.Dependencies[1].Status)
+		s.Equal("database is at migration 1, latest is 2", readiness.Dependencies[1].Error)
+	})
+
+	s.Run("Readiness should be down given a dirty migration", func() {
+		healthRepoMock := &mocks.HealthRepositoryMock{}
+		healthRepoMock.LatestMigrationVersionReturns(2, nil)
+		healthRepoMock.MigrationVersionReturns(2, true, nil)
+		uc := usecase.NewHealthUsecase(s.env, s.loggerUtil, healthRepoMock, &mocks.AuthUtilMock{})
+
+		readiness := uc.Readiness(s.ctx)
+		s.Equal(domain.HealthStatusDown, readiness.Status)
+		s.Equal("migration 2 is dirty", readiness.Dependencies[1].Error)
+	})
+
+	s.Run("Readiness should be cached", func() {
+		healthRepoMock := &mocks.HealthRepositoryMock{}
+		uc := usecase.NewHealthUsecase(s.env, s.loggerUtil, healthRepoMock, &mocks.AuthUtilMock{})
+
+		first := uc.Readiness(s.ctx)
+		second := uc.Readiness(s.ctx)
+		s.Same(first, second)
+		s.Equal(1, healthRepoMock.PingCallCount())
+		s.Equal(1, healthRepoMock.MigrationVersionCallCount())
+	})
+
+	s.Run("Readiness should run the checks once given concurrent probes", func() {
+		healthRepoMock := &mocks.HealthRepositoryMock{}
+		release := make(chan struct{})
+		healthRepoMock.PingStub = func(ctx context.Context) error {
+			<-release
+			return nil
+		}
+		uc := usecase.NewHealthUsecase(s.env, s.loggerUtil, healthRepoMock, &mocks.AuthUtilMock{})
+
+		first := make(chan *domain.HealthControllerResponseReadiness)
+		go func() { first <- uc.Readiness(s.ctx) }()
+		s.Eventually(func() bool { return healthRepoMock.PingCallCount() == 1 }, time.Second, time.Millisecond)
+		second := make(chan *domain.HealthControllerResponseReadiness)
+		go func() { second <- uc.Readiness(s.ctx) }()
+		close(release)
+
+		s.Same(<-first, <-second)
+		s.Equal(1, healthRepoMock.PingCallCount())
+	})
+
+	s.Run("Readiness should check the auth provider given HEALTH_CHECK_AUTH_PROVIDER", func() {
+		env := *s.env
+		env.HealthCheckAuthProvider = true
+		authUtilMock := &mocks.AuthUtilMock{}
+		authUtilMock.PingReturns(errors.New("auth provider unavailable"))
+		uc := usecase.NewHealthUsecase(&env, s.loggerUtil, s.healthRepo, authUtilMock)
+
+		readiness := uc.Readiness(s.ctx)
+		s.Equal(domain.HealthStatusDown, readiness.Status)
+		if s.Len(readiness.Dependencies, 3) {
+			s.Equal(domain.HealthDependencyAuthProvider, readiness.Dependencies[2].Name)
+			s.Equal(domain.HealthStatusDown, readiness.Dependencies[2].Status)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/jinzhu/copier"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+// orderTransitions lists the statuses an order can move to from each status.
+// A paid order must be cancelled (which restocks it) before it can be refunded.
+var orderTransitions = map[string][]string{
+	domain.OrderStatusPendingPayment: {domain.OrderStatusPaid, domain.OrderStatusCancelled},
+	domain.OrderStatusPaid:           {domain.OrderStatusProcessing, domain.OrderStatusCancelled},
+	domain.OrderStatusProcessing:     {domain.OrderStatusShipped, domain.OrderStatusCancelled},
+	domain.OrderStatusShipped:        {domain.OrderStatusDelivered},
+	domain.OrderStatusDelivered:      {domain.OrderStatusRefunded},
+	domain.OrderStatusCancelled:      {domain.OrderStatusRefunded},
+	domain.OrderStatusRefunded:       {},
+}
+
+func canTransitionOrder(from, to string) bool {
+	for _, status := range orderTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+type baseOrderUsecase struct {
+	orderRepository domain.OrderRepository
+	cartRepository  domain.CartRepository
+}
+
+func NewOrderUsecase(orderRepository domain.OrderRepository, cartRepository domain.CartRepository) domain.OrderUsecase {
+	return &baseOrderUsecase{
+		orderRepository: orderRepository,
+		cartRepository:  cartRepository,
+	}
+}
+
+// Checkout creates an order from the cart of the user. The stock, status and price of each item are checked
+// by the repository while the cart and its products are locked.
+func (b *baseOrderUsecase) Checkout(ctx context.Context, userID int) (string, error) {
+	cart, err := b.cartRepository.GetCartByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if cart == nil || len(cart.CartItems) == 0 {
+		return "", domain.ErrCartEmpty
+	}
+
+	metadata := utils.GenerateMetadata()
+	orderPayload := &domain.OrderRepositoryPayloadCreateOrder{
+		UID:       metadata.UID(),
+		Status:    domain.OrderStatusPendingPayment,
+		UserID:    userID,
+		CartID:    cart.ID,
+		CreatedAt: metadata.CreatedAt,
+		UpdatedAt: metadata.UpdatedAt,
+	}
+
+	UID, err := b.orderRepository.CreateOrder(ctx, orderPayload)
+	if err != nil {
+		return "", err
+	}
+
+	return UID, nil
+}
+
+func (b *baseOrderUsecase) toListResponse(_orders []*domain.OrderModel, limit, page int) (*domain.OrderControllerResponseListOrders, error) {
+	orders := []*domain.OrderControllerResponseGetOrder{}
+	err := copier.Copy(&orders, &_orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OrderControllerResponseListOrders{
+		Orders: orders,
+		Page:   page,
+		Limit:  limit,
+	}, nil
+}
+
+func (b *baseOrderUsecase) toResponse(order *domain.OrderModel) (*domain.OrderControllerResponseGetOrder, error) {
+	if order == nil {
+		return nil, nil
+	}
+
+	var res domain.OrderControllerResponseGetOrder
+	err := copier.Copy(&res, &order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (b *baseOrderUsecase) ListOrdersByUserID(ctx context.Context, userID, limit, page int) (*domain.OrderControllerResponseListOrders, error) {
+	orders, err := b.orderRepository.ListOrdersByUserID(ctx, userID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toListResponse(orders, limit, page)
+}
+
+func (b *baseOrderUsecase) GetOrderByUIDAndUserID(ctx context.Context, UID string, userID int) (*domain.OrderControllerResponseGetOrder, error) {
+	order, err := b.orderRepository.GetOrderByUIDAndUserID(ctx, UID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toResponse(order)
+}
+
+func (b *baseOrderUsecase) ListOrders(ctx context.Context, limit, page int, status string) (*domain.OrderControllerResponseListOrders, error) {
+	orders, err := b.orderRepository.ListOrders(ctx, limit, (page-1)*limit, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toListResponse(orders, limit, page)
+}
+
+func (b *baseOrderUsecase) GetOrderByUID(ctx context.Context, UID string) (*domain.OrderControllerResponseGetOrder, error) {
+	order, err := b.orderRepository.GetOrderByUID(ctx, UID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toResponse(order)
+}
+
+func (b *baseOrderUsecase) transitionOrder(ctx context.Context, order *domain.OrderModel, status, note string, changedByUserID int) error {
+	if !canTransitionOrder(order.Status, status) {
+		return &domain.OrderTransitionError{From: order.Status, To: status}
+	}
+
+	metadata := utils.GenerateMetadata()
+	return b.orderRepository.UpdateOrderStatus(ctx, &domain.OrderRepositoryPayloadUpdateOrderStatus{
+		FromStatus:      order.Status,
+		ToStatus:        status,
+		Note:            note,
+		Restock:         status == domain.OrderStatusCancelled,
+		OrderID:         order.ID,
+		ChangedByUserID: changedByUserID,
+		CreatedAt:       metadata.CreatedAt,
+		UpdatedAt:       metadata.UpdatedAt,
+	})
+}
+
+func (b *baseOrderUsecase) CancelOrder(ctx context.Context, UID string, userID int) error {
+	order, err := b.orderRepository.GetOrderByUIDAndUserID(ctx, UID, userID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return domain.ErrOrderNotFound
+	}
+
+	return b.transitionOrder(ctx, order, domain.OrderStatusCancelled, "cancelled by user", userID)
+}
+
+func (b *baseOrderUsecase) UpdateOrderStatus(ctx context.Context, payload *domain.OrderUsecasePayloadUpdateOrderStatus) error {
+	order, err := b.orderRepository.GetOrderByUID(ctx, payload.UID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return domain.ErrOrderNotFound
+	}
+
+	return b.transitionOrder(ctx, order, payload.Status, payload.Note, payload.ChangedByUserID)
+}
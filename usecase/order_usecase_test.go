@@ -0,0 +1,300 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderUsecaseSuite struct {
+	suite.Suite
+	db          *sqlx.DB
+	pool        *dockertest.Pool
+	resource    *dockertest.Resource
+	ctx         context.Context
+	env         *domain.Env
+	userRepo    domain.UserRepository
+	cartRepo    domain.CartRepository
+	productRepo domain.ProductRepository
+	orderRepo   domain.OrderRepository
+	cartUtil    domain.CartUtil
+	productUtil domain.ProductUtil
+	productUIDS []string
+	userID      int
+}
+
+func (s *OrderUsecaseSuite) BeforeTest(suiteName, testName string) {
+	metadata := utils.GenerateMetadata()
+	ID, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+		UID:          metadata.UID(),
+		Email:        gofakeit.Email(),
+		Name:         gofakeit.Name(),
+		Phone:        gofakeit.Phone(),
+		ProfileImage: gofakeit.ImageURL(100, 100),
+		CreatedAt:    metadata.CreatedAt,
+		UpdatedAt:    metadata.UpdatedAt,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = s.cartRepo.CreateCart(s.ctx, ID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.userID = ID
+
+	for i := 1; i <= 3; i++ {
+		metadata := utils.GenerateMetadata()
+		name := fmt.Sprintf("Product Test %d", i)
+		weightValue := gofakeit.Float64Range(100.0, 100_000.0)
+		basePriceValue := gofakeit.IntRange(5000, 1_000_000)
+		discount := gofakeit.IntRange(0, 100)
+		computedPrice, _ := s.productUtil.CalculatePrice(basePriceValue, discount)
+
+		payload := &domain.ProductRepositoryPayloadCreateProduct{
+			UID:             metadata.UID(),
+			Name:            name,
+			Slug:            metadata.Slug(name),
+			SKU:             gofakeit.LoremIpsumWord() + fmt.Sprint(i),
+			Description:     gofakeit.Sentence(100),
+			Images:          domain.StringSlice{"test.jpg"},
+			Weight:          s.productUtil.FormatWeight(weightValue),
+			WeightValue:     weightValue,
+			BasePrice:       computedPrice.Base,
+			BasePriceValue:  basePriceValue,
+			OfferPrice:      computedPrice.Offer,
+			OfferPriceValue: computedPrice.OfferValue,
+			Status:          "ACTIVE",
+			Discount:        discount,
+			Stock:           10,
+			CreatedAt:       metadata.CreatedAt,
+			UpdatedAt:       metadata.UpdatedAt,
+		}
+
+		_, err := s.productRepo.Create(s.ctx, payload)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		s.productUIDS = append(s.productUIDS, payload.UID)
+	}
+}
+
+func (s *OrderUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	productUtil := utils.NewProductUtil()
+
+	s.ctx = context.Background()
+	s.env = env
+	s.userRepo = repository.NewUserRepository(s.db)
+	s.cartRepo = repository.NewCartRepository(s.db, productUtil)
+	s.productRepo = repository.NewProductRepository(s.db, productUtil)
+	s.orderRepo = repository.NewOrderRepository(s.db, s.productUtil)
+	s.cartUtil = utils.NewCartUtil(productUtil)
+	s.productUtil = productUtil
+}
+
+func (s *OrderUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestOrderUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(OrderUsecaseSuite))
+}
+
+func (s *OrderUsecaseSuite) addToCart(productUID string, quantity int) {
+	cartUsecase := usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, utils.NewAesEncrypt(s.env.AesSecret))
+
+	cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+	s.NoError(err)
+	product, err := s.productRepo.GetByUID(s.ctx, productUID)
+	s.NoError(err)
+
+	_, err = cartUsecase.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+		Cart:     cart,
+		Product:  product,
+		Quantity: quantity,
+	})
+	s.NoError(err)
+}
+
+func (s *OrderUsecaseSuite) TestOrderUsecase() {
+	uc := usecase.NewOrderUsecase(s.orderRepo, s.cartRepo)
+
+	s.Run("Checkout should return error given empty cart", func() {
+		_, err := uc.Checkout(s.ctx, s.userID)
+		s.ErrorIs(err, domain.ErrCartEmpty)
+	})
+
+	s.Run("Checkout should return error given quantity above stock", func() {
+		s.addToCart(s.productUIDS[0], 10)
+		_, err := s.db.Exec("UPDATE products SET stock = 5 WHERE uid = $1;", s.productUIDS[0])
+		s.NoError(err)
+
+		_, err = uc.Checkout(s.ctx, s.userID)
+		s.ErrorIs(err, domain.ErrInsufficientStock)
+
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Len(cart.CartItems, 1)
+		err = usecase.NewCartUsecase(s.env, s.cartRepo, s.cartUtil, utils.NewAesEncrypt(s.env.AesSecret)).DeleteCartItemByUID(s.ctx, &domain.CartUsecasePayloadDeleteCartItem{
+			Cart:     cart,
+			CartItem: &cart.CartItems[0],
+			UID:      cart.CartItems[0].UID,
+		})
+		s.NoError(err)
+	})
+
+	s.Run("Checkout should create order, decrement stock and empty cart", func() {
+		s.addToCart(s.productUIDS[1], 2)
+		s.addToCart(s.productUIDS[2], 3)
+		cart, err := s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+
+		UID, err := uc.Checkout(s.ctx, s.userID)
+		s.NoError(err)
+
+		order, err := uc.GetOrderByUIDAndUserID(s.ctx, UID, s.userID)
+		s.NoError(err)
+		s.NotNil(order)
+		s.Equal(domain.OrderStatusPendingPayment, order.Status)
+		s.Equal(5, order.Quantity)
+		s.Equal(cart.TotalPriceValue, order.TotalPriceValue)
+		s.Equal(cart.TotalPrice, order.TotalPrice)
+		s.Len(order.OrderItems, 2)
+
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[1])
+		s.NoError(err)
+		s.Equal(8, product.Stock)
+		product, err = s.productRepo.GetByUID(s.ctx, s.productUIDS[2])
+		s.NoError(err)
+		s.Equal(7, product.Stock)
+
+		cart, err = s.cartRepo.GetCartByUserID(s.ctx, s.userID)
+		s.NoError(err)
+		s.Empty(cart.CartItems)
+		s.Equal(0, cart.Quantity)
+		s.Equal(0, cart.TotalPriceValue)
+	})
+
+	s.Run("List orders by user id", func() {
+		res, err := uc.ListOrdersByUserID(s.ctx, s.userID, 10, 1)
+		s.NoError(err)
+		s.Len(res.Orders, 1)
+
+		res, err = uc.ListOrdersByUserID(s.ctx, s.userID, 10, 2)
+		s.NoError(err)
+		s.Empty(res.Orders)
+	})
+
+	s.Run("List orders filtered by status", func() {
+		res, err := uc.ListOrders(s.ctx, 10, 1, domain.OrderStatusPendingPayment)
+		s.NoError(err)
+		s.Len(res.Orders, 1)
+
+		res, err = uc.ListOrders(s.ctx, 10, 1, domain.OrderStatusPaid)
+		s.NoError(err)
+		s.Empty(res.Orders)
+	})
+
+	s.Run("Get order by uid and user id return nil given another user", func() {
+		res, err := uc.ListOrders(s.ctx, 10, 1, "")
+		s.NoError(err)
+		s.Len(res.Orders, 1)
+
+		order, err := uc.GetOrderByUIDAndUserID(s.ctx, res.Orders[0].UID, s.userID+1)
+		s.NoError(err)
+		s.Nil(order)
+
+		order, err = uc.GetOrderByUID(s.ctx, res.Orders[0].UID)
+		s.NoError(err)
+		s.NotNil(order)
+	})
+
+	s.Run("Update order status should return transition error given illegal transition", func() {
+		res, err := uc.ListOrders(s.ctx, 10, 1, "")
+		s.NoError(err)
+
+		err = uc.UpdateOrderStatus(s.ctx, &domain.OrderUsecasePayloadUpdateOrderStatus{
+			UID:             res.Orders[0].UID,
+			Status:          domain.OrderStatusShipped,
+			ChangedByUserID: s.userID,
+		})
+		var transitionErr *domain.OrderTransitionError
+		s.ErrorAs(err, &transitionErr)
+		s.Equal(domain.OrderStatusPendingPayment, transitionErr.From)
+		s.Equal(domain.OrderStatusShipped, transitionErr.To)
+	})
+
+	s.Run("Update order status should record status history", func() {
+		res, err := uc.ListOrders(s.ctx, 10, 1, "")
+		s.NoError(err)
+		UID := res.Orders[0].UID
+
+		for _, status := range []string{domain.OrderStatusPaid, domain.OrderStatusProcessing} {
+			err = uc.UpdateOrderStatus(s.ctx, &domain.OrderUsecasePayloadUpdateOrderStatus{
+				UID:             UID,
+				Status:          status,
+				Note:            "test",
+				ChangedByUserID: s.userID,
+			})
+			s.NoError(err)
+		}
+
+		order, err := uc.GetOrderByUID(s.ctx, UID)
+		s.NoError(err)
+		s.Equal(domain.OrderStatusProcessing, order.Status)
+		s.Len(order.StatusHistory, 3)
+		s.Equal("", order.StatusHistory[0].FromStatus)
+		s.Equal(domain.OrderStatusPendingPayment, order.StatusHistory[0].ToStatus)
+		s.Equal(domain.OrderStatusPaid, order.StatusHistory[2].FromStatus)
+		s.Equal(domain.OrderStatusProcessing, order.StatusHistory[2].ToStatus)
+	})
+
+	s.Run("Cancel order should restock products", func() {
+		res, err := uc.ListOrders(s.ctx, 10, 1, "")
+		s.NoError(err)
+		UID := res.Orders[0].UID
+
+		err = uc.CancelOrder(s.ctx, UID, s.userID+1)
+		s.ErrorIs(err, domain.ErrOrderNotFound)
+
+		err = uc.CancelOrder(s.ctx, UID, s.userID)
+		s.NoError(err)
+
+		order, err := uc.GetOrderByUID(s.ctx, UID)
+		s.NoError(err)
+		s.Equal(domain.OrderStatusCancelled, order.Status)
+
+		product, err := s.productRepo.GetByUID(s.ctx, s.productUIDS[1])
+		s.NoError(err)
+		s.Equal(10, product.Stock)
+		product, err = s.productRepo.GetByUID(s.ctx, s.productUIDS[2])
+		s.NoError(err)
+		s.Equal(10, product.Stock)
+
+		var transitionErr *domain.OrderTransitionError
+		err = uc.CancelOrder(s.ctx, UID, s.userID)
+		s.ErrorAs(err, &transitionErr)
+	})
+}
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+// paymentChannels lists the channels supported by each payment method. QRIS has no channel.
+var paymentChannels = map[string][]string{
+	domain.PaymentMethodVirtualAccount: {"bca", "bni", "bri", "permata"},
+	domain.PaymentMethodQRIS:           {""},
+	domain.PaymentMethodEWallet:        {"gopay", "shopeepay"},
+}
+
+type basePaymentUsecase struct {
+	txManager         domain.TxManager
+	paymentRepository domain.PaymentRepository
+	orderRepository   domain.OrderRepository
+	paymentGateway    domain.PaymentGateway
+}
+
+func NewPaymentUsecase(txManager domain.TxManager, paymentRepository domain.PaymentRepository, orderRepository domain.OrderRepository, paymentGateway domain.PaymentGateway) domain.PaymentUsecase {
+	return &basePaymentUsecase{
+		txManager:         txManager,
+		paymentRepository: paymentRepository,
+		orderRepository:   orderRepository,
+		paymentGateway:    paymentGateway,
+	}
+}
+
+func (b *basePaymentUsecase) toResponse(payment *domain.PaymentModel) *domain.PaymentControllerResponseGetPayment {
+	res := &domain.PaymentControllerResponseGetPayment{
+		UID:           payment.UID,
+		Gateway:       payment.Gateway,
+		Method:        payment.Method,
+		Channel:       payment.Channel,
+		Amount:        payment.Amount,
+		Currency:      payment.Currency,
+		Status:        payment.Status,
+		TransactionID: payment.TransactionID,
+		VANumber:      payment.VANumber.String,
+		QRString:      payment.QRString.String,
+		ActionURL:     payment.ActionURL.String,
+		CreatedAt:     payment.CreatedAt,
+		UpdatedAt:     payment.UpdatedAt,
+	}
+	if payment.ExpiresAt.Valid {
+		res.ExpiresAt = &payment.ExpiresAt.Time
+	}
+	if payment.PaidAt.Valid {
+		res.PaidAt = &payment.PaidAt.Time
+	}
+
+	return res
+}
+
+func (b *basePaymentUsecase) CreatePayment(ctx context.Context, payload *domain.PaymentUsecasePayloadCreatePayment) (*domain.PaymentControllerResponseGetPayment, error) {
+	supported := false
+	for _, channel := range paymentChannels[payload.Method] {
+		if channel == payload.Channel {
+			supported = true
+		}
+	}
+	if !supported {
+		return nil, domain.ErrUnsupportedPaymentChannel
+	}
+
+	order, err := b.orderRepository.GetOrderByUIDAndUserID(ctx, payload.OrderUID, payload.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	// The order stays locked until the payment is stored, so concurrent requests can't both create a charge.
+	var res *domain.PaymentControllerResponseGetPayment
+	err = b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := b.orderRepository.LockOrder(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		order, err = b.orderRepository.GetOrderByUID(ctx, order.UID)
+		if err != nil {
+			return err
+		}
+		if order.Status != domain.OrderStatusPendingPayment {
+			return domain.ErrOrderNotPayable
+		}
+
+		// Reuse a pending charge that has not expired so retried requests don't create duplicate charges.
+		activePayment, err := b.paymentRepository.GetActivePaymentByOrderID(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		if activePayment != nil {
+			if activePayment.Status == domain.PaymentStatusPaid {
+				return domain.ErrOrderNotPayable
+			}
+			if !activePayment.ExpiresAt.Valid || activePayment.ExpiresAt.Time.After(time.Now()) {
+				res = b.toResponse(activePayment)
+				return nil
+			}
+
+			// The expired charge is superseded, a late payment notification for it is rejected.
+			err = b.paymentRepository.ExpirePayment(ctx, activePayment.ID, time.Now().UTC())
+			if err != nil {
+				return err
+			}
+		}
+
+		payment, err := b.createCharge(ctx, order, payload)
+		if err != nil {
+			return err
+		}
+		res = b.toResponse(payment)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// createCharge creates a charge for the order total at the gateway and stores it as a pending payment.
+func (b *basePaymentUsecase) createCharge(ctx context.Context, order *domain.OrderModel, payload *domain.PaymentUsecasePayloadCreatePayment) (*domain.PaymentModel, error) {
+	metadata := utils.GenerateMetadata()
+	chargePayload := &domain.PaymentGatewayPayloadCreateCharge{
+		ReferenceID:   metadata.UID(),
+		Amount:        order.TotalPriceValue,
+		Method:        payload.Method,
+		Channel:       payload.Channel,
+		CustomerName:  payload.User.Name,
+		CustomerEmail: payload.User.Email,
+		CustomerPhone: payload.User.Phone,
+	}
+	for _, orderItem := range order.OrderItems {
+		chargePayload.Items = append(chargePayload.Items, domain.PaymentGatewayPropertyItem{
+			ID:       orderItem.UID,
+			Name:     orderItem.ProductName,
+			Price:    orderItem.OfferPriceValue,
+			Quantity: orderItem.Quantity,
+		})
+	}
+
+	charge, err := b.paymentGateway.CreateCharge(ctx, chargePayload)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentPayload := &domain.PaymentRepositoryPayloadCreatePayment{
+		UID:           chargePayload.ReferenceID,
+		Gateway:       b.paymentGateway.Name(),
+		Method:        payload.Method,
+		Channel:       payload.Channel,
+		Amount:        order.TotalPriceValue,
+		Currency:      domain.PaymentCurrencyIDR,
+		Status:        domain.PaymentStatusPending,
+		TransactionID: charge.TransactionID,
+		VANumber:      sql.NullString{String: charge.VANumber, Valid: charge.VANumber != ""},
+		QRString:      sql.NullString{String: charge.QRString, Valid: charge.QRString != ""},
+		ActionURL:     sql.NullString{String: charge.ActionURL, Valid: charge.ActionURL != ""},
+		OrderID:       order.ID,
+		CreatedAt:     metadata.CreatedAt,
+		UpdatedAt:     metadata.UpdatedAt,
+	}
+	if charge.ExpiresAt != nil {
+		paymentPayload.ExpiresAt = sql.NullTime{Time: *charge.ExpiresAt, Valid: true}
+	}
+
+	_, err = b.paymentRepository.CreatePayment(ctx, paymentPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentModel{
+		UID:           paymentPayload.UID,
+		Gateway:       paymentPayload.Gateway,
+		Method:        paymentPayload.Method,
+		Channel:       paymentPayload.Channel,
+		Amount:        paymentPayload.Amount,
+		Currency:      paymentPayload.Currency,
+		Status:        paymentPayload.Status,
+		TransactionID: paymentPayload.TransactionID,
+		VANumber:      paymentPayload.VANumber,
+		QRString:      paymentPayload.QRString,
+		ActionURL:     paymentPayload.ActionURL,
+		ExpiresAt:     paymentPayload.ExpiresAt,
+		OrderID:       paymentPayload.OrderID,
+		CreatedAt:     paymentPayload.CreatedAt,
+		UpdatedAt:     paymentPayload.UpdatedAt,
+	}, nil
+}
+
+func (b *basePaymentUsecase) GetPaymentByOrderUID(ctx context.Context, orderUID string, userID int) (*domain.PaymentControllerResponseGetPayment, error) {
+	order, err := b.orderRepository.GetOrderByUIDAndUserID(ctx, orderUID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	payment, err := b.paymentRepository.GetActivePaymentByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	if payment == nil {
+		return nil, domain.ErrPaymentNotFound
+	}
+
+	return b.toResponse(payment), nil
+}
+
+func (b *basePaymentUsecase) HandleWebhook(ctx context.Context, header http.Header, body []byte) error {
+	notification, err := b.paymentGateway.VerifyWebhook(ctx, header, body)
+	if err != nil {
+		return err
+	}
+
+	return b.paymentRepository.ApplyNotification(ctx, &domain.PaymentRepositoryPayloadApplyNotification{
+		TransactionID: notification.TransactionID,
+		Status:        notification.Status,
+		Amount:        notification.Amount,
+		UpdatedAt:     time.Now().UTC(),
+	})
+}
+
+func (b *basePaymentUsecase) SimulatePayment(ctx context.Context, transactionID, status string) error {
+	simulator, ok := b.paymentGateway.(domain.PaymentSimulator)
+	if !ok {
+		return domain.ErrPaymentSimulatorDisabled
+	}
+
+	header, body, err := simulator.Simulate(ctx, transactionID, status)
+	if err != nil {
+		return err
+	}
+
+	return b.HandleWebhook(ctx, header, body)
+}
+
+func (b *basePaymentUsecase) RefundPayment(ctx context.Context, orderUID, reason string, changedByUserID int) error {
+	order, err := b.orderRepository.GetOrderByUID(ctx, orderUID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return domain.ErrOrderNotFound
+	}
+	if !canTransitionOrder(order.Status, domain.OrderStatusRefunded) {
+		return &domain.OrderTransitionError{From: order.Status, To: domain.OrderStatusRefunded}
+	}
+
+	payment, err := b.paymentRepository.GetActivePaymentByOrderID(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	if payment == nil || payment.Status != domain.PaymentStatusPaid {
+		return domain.ErrPaymentNotRefundable
+	}
+
+	err = b.paymentGateway.Refund(ctx, &domain.PaymentGatewayPayloadRefund{
+		TransactionID: payment.TransactionID,
+		ReferenceID:   payment.UID,
+		Amount:        payment.Amount,
+		Reason:        reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.paymentRepository.RefundPayment(ctx, &domain.PaymentRepositoryPayloadRefundPayment{
+		PaymentID:       payment.ID,
+		OrderID:         order.ID,
+		OrderFromStatus: order.Status,
+		Note:            reason,
+		ChangedByUserID: changedByUserID,
+		UpdatedAt:       time.Now().UTC(),
+	})
+}
@@ -0,0 +1,252 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentUsecaseSuite struct {
+	suite.Suite
+	db             *sqlx.DB
+	pool           *dockertest.Pool
+	resource       *dockertest.Resource
+	ctx            context.Context
+	env            *domain.Env
+	user           *domain.UserModel
+	orderRepo      domain.OrderRepository
+	paymentRepo    domain.PaymentRepository
+	paymentGateway domain.PaymentGateway
+	orderUsecase   domain.OrderUsecase
+	orderUID       string
+}
+
+func (s *PaymentUsecaseSuite) BeforeTest(suiteName, testName string) {
+	userRepo := repository.NewUserRepository(s.db)
+	productUtil := utils.NewProductUtil()
+	cartRepo := repository.NewCartRepository(s.db, productUtil)
+	productRepo := repository.NewProductRepository(s.db, productUtil)
+	cartUsecase := usecase.NewCartUsecase(s.env, cartRepo, utils.NewCartUtil(productUtil), utils.NewAesEncrypt(s.env.AesSecret))
+
+	metadata := utils.GenerateMetadata()
+	ID, err := userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+		UID:          metadata.UID(),
+		Email:        gofakeit.Email(),
+		Name:         gofakeit.Name(),
+		Phone:        gofakeit.Phone(),
+		ProfileImage: gofakeit.ImageURL(100, 100),
+		CreatedAt:    metadata.CreatedAt,
+		UpdatedAt:    metadata.UpdatedAt,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = cartRepo.CreateCart(s.ctx, ID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.user = &domain.UserModel{ID: ID, Name: gofakeit.Name(), Email: gofakeit.Email()}
+
+	name := "Product Test"
+	computedPrice, _ := productUtil.CalculatePrice(150_000, 10)
+	productPayload := &domain.ProductRepositoryPayloadCreateProduct{
+		UID:             metadata.UID(),
+		Name:            name,
+		Slug:            metadata.Slug(name),
+		SKU:             gofakeit.LoremIpsumWord(),
+		Description:     gofakeit.Sentence(100),
+		Images:          domain.StringSlice{"test.jpg"},
+		Weight:          productUtil.FormatWeight(500),
+		WeightValue:     500,
+		BasePrice:       computedPrice.Base,
+		BasePriceValue:  150_000,
+		OfferPrice:      computedPrice.Offer,
+		OfferPriceValue: computedPrice.OfferValue,
+		Status:          "ACTIVE",
+		Discount:        10,
+		Stock:           10,
+		CreatedAt:       metadata.CreatedAt,
+		UpdatedAt:       metadata.UpdatedAt,
+	}
+	_, err = productRepo.Create(s.ctx, productPayload)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cart, err := cartRepo.GetCartByUserID(s.ctx, ID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	product, err := productRepo.GetByUID(s.ctx, productPayload.UID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	_, err = cartUsecase.CreateCartItem(s.ctx, &domain.CartUsecasePayloadCreateCartItem{
+		Cart:     cart,
+		Product:  product,
+		Quantity: 2,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s.orderUsecase = usecase.NewOrderUsecase(s.orderRepo, cartRepo)
+	s.orderUID, err = s.orderUsecase.Checkout(s.ctx, ID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (s *PaymentUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	s.ctx = context.Background()
+	s.env = env
+	s.orderRepo = repository.NewOrderRepository(s.db, utils.NewProductUtil())
+	s.paymentRepo = repository.NewPaymentRepository(s.db)
+	s.paymentGateway = utils.NewSimulatorPaymentGateway(&domain.Env{PaymentSimulatorSecret: "secret"})
+}
+
+func (s *PaymentUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestPaymentUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(PaymentUsecaseSuite))
+}
+
+func (s *PaymentUsecaseSuite) TestPaymentUsecase() {
+	uc := usecase.NewPaymentUsecase(repository.NewTxManager(s.db), s.paymentRepo, s.orderRepo, s.paymentGateway)
+	var payment *domain.PaymentControllerResponseGetPayment
+
+	s.Run("Create payment should return error given unsupported channel", func() {
+		_, err := uc.CreatePayment(s.ctx, &domain.PaymentUsecasePayloadCreatePayment{
+			OrderUID: s.orderUID,
+			Method:   domain.PaymentMethodEWallet,
+			Channel:  "bca",
+			User:     s.user,
+		})
+		s.ErrorIs(err, domain.ErrUnsupportedPaymentChannel)
+	})
+
+	s.Run("Create payment should charge order total in IDR", func() {
+		order, err := s.orderUsecase.GetOrderByUID(s.ctx, s.orderUID)
+		s.NoError(err)
+
+		payment, err = uc.CreatePayment(s.ctx, &domain.PaymentUsecasePayloadCreatePayment{
+			OrderUID: s.orderUID,
+			Method:   domain.PaymentMethodVirtualAccount,
+			Channel:  "bca",
+			User:     s.user,
+		})
+		s.NoError(err)
+		s.Equal(order.TotalPriceValue, payment.Amount)
+		s.Equal(domain.PaymentCurrencyIDR, payment.Currency)
+		s.Equal(domain.PaymentStatusPending, payment.Status)
+		s.Equal(domain.PaymentGatewaySimulator, payment.Gateway)
+		s.NotEmpty(payment.VANumber)
+		s.NotNil(payment.ExpiresAt)
+	})
+
+	s.Run("Create payment should return pending payment if it exists", func() {
+		res, err := uc.CreatePayment(s.ctx, &domain.PaymentUsecasePayloadCreatePayment{
+			OrderUID: s.orderUID,
+			Method:   domain.PaymentMethodQRIS,
+			User:     s.user,
+		})
+		s.NoError(err)
+		s.Equal(payment.UID, res.UID)
+	})
+
+	s.Run("Create payment should expire the pending payment given it has expired", func() {
+		_, err := s.db.Exec("UPDATE payments SET expires_at = $1 WHERE uid = $2;", time.Now().Add(-time.Minute), payment.UID)
+		s.NoError(err)
+
+		res, err := uc.CreatePayment(s.ctx, &domain.PaymentUsecasePayloadCreatePayment{
+			OrderUID: s.orderUID,
+			Method:   domain.PaymentMethodVirtualAccount,
+			Channel:  "bca",
+			User:     s.user,
+		})
+		s.NoError(err)
+		s.NotEqual(payment.UID, res.UID)
+
+		err = uc.SimulatePayment(s.ctx, payment.TransactionID, domain.PaymentStatusPaid)
+		s.ErrorIs(err, domain.ErrPaymentSuperseded)
+
+		order, err := s.orderUsecase.GetOrderByUID(s.ctx, s.orderUID)
+		s.NoError(err)
+		s.Equal(domain.OrderStatusPendingPayment, order.Status)
+		payment = res
+	})
+
+	s.Run("Handle webhook should return error given invalid signature", func() {
+		err := uc.HandleWebhook(s.ctx, nil, []byte(fmt.Sprintf(`{"transaction_id":"%s","status":"PAID"}`, payment.TransactionID)))
+		s.ErrorIs(err, domain.ErrInvalidWebhookSignature)
+	})
+
+	s.Run("Simulate payment should mark payment and order as paid", func() {
+		err := uc.SimulatePayment(s.ctx, payment.TransactionID, domain.PaymentStatusPaid)
+		s.NoError(err)
+
+		res, err := uc.GetPaymentByOrderUID(s.ctx, s.orderUID, s.user.ID)
+		s.NoError(err)
+		s.Equal(domain.PaymentStatusPaid, res.Status)
+		s.NotNil(res.PaidAt)
+
+		order, err := s.orderUsecase.GetOrderByUID(s.ctx, s.orderUID)
+		s.NoError(err)
+		s.Equal(domain.OrderStatusPaid, order.Status)
+		s.Len(order.StatusHistory, 2)
+	})
+
+	s.Run("Handle webhook should ignore repeated notification", func() {
+		header, body, err := s.paymentGateway.(domain.PaymentSimulator).Simulate(s.ctx, payment.TransactionID, domain.PaymentStatusPaid)
+		s.NoError(err)
+
+		err = uc.HandleWebhook(s.ctx, header, body)
+		s.NoError(err)
+
+		order, err := s.orderUsecase.GetOrderByUID(s.ctx, s.orderUID)
+		s.NoError(err)
+		s.Len(order.StatusHistory, 2)
+	})
+
+	s.Run("Refund payment should return transition error given paid order", func() {
+		var transitionErr *domain.OrderTransitionError
+		err := uc.RefundPayment(s.ctx, s.orderUID, "test", s.user.ID)
+		s.ErrorAs(err, &transitionErr)
+	})
+
+	s.Run("Refund payment should refund cancelled order", func() {
+		err := s.orderUsecase.CancelOrder(s.ctx, s.orderUID, s.user.ID)
+		s.NoError(err)
+
+		err = uc.RefundPayment(s.ctx, s.orderUID, "test", s.user.ID)
+		s.NoError(err)
+
+		order, err := s.orderUsecase.GetOrderByUID(s.ctx, s.orderUID)
+		s.NoError(err)
+		s.Equal(domain.OrderStatusRefunded, order.Status)
+	})
+}
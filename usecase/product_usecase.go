@@ -47,7 +47,7 @@ func (b *baseProductUsecase) Create(ctx context.Context, payload *domain.Product
 		UpdatedAt:       metadata.UpdatedAt,
 	}
 
-	UID, err := b.productRepository.Create(productPayload)
+	UID, err := b.productRepository.Create(ctx, productPayload)
 	if err != nil {
 		return "", err
 	}
@@ -62,19 +62,19 @@ func (b *baseProductUsecase) List(ctx context.Context, limit int, encryptedCurso
 	if direction != "" {
 		_cursor, err := b.aesEncryptUtil.Decrypt(encryptedCursor)
 		if err != nil {
-			return nil, err
+			return nil, domain.ErrInvalidCursor
 		}
 		cursor, err = strconv.Atoi(_cursor)
 		if err != nil {
-			return nil, err
+			return nil, domain.ErrInvalidCursor
 		}
 	}
 
-	_products, err := b.productRepository.List(limit, cursor, direction)
+	_products, err := b.productRepository.List(ctx, limit, cursor, direction)
 	if err != nil {
 		return nil, err
 	}
-	if _products == nil {
+	if len(_products) == 0 {
 		return nil, nil
 	}
 
@@ -120,7 +120,7 @@ func (b *baseProductUsecase) List(ctx context.Context, limit int, encryptedCurso
 }
 
 func (b *baseProductUsecase) GetByUID(ctx context.Context, UID string) (*domain.ProductControllerResponseGetProductByUID, error) {
-	product, err := b.productRepository.GetByUID(UID)
+	product, err := b.productRepository.GetByUID(ctx, UID)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +164,7 @@ func (b *baseProductUsecase) UpdateByUID(ctx context.Context, UID string, payloa
 		UpdatedAt:       metadata.UpdatedAt,
 	}
 
-	err = b.productRepository.UpdateByUID(productPayload)
+	err = b.productRepository.UpdateByUID(ctx, productPayload)
 	if err != nil {
 		return err
 	}
@@ -173,7 +173,7 @@ func (b *baseProductUsecase) UpdateByUID(ctx context.Context, UID string, payloa
 }
 
 func (b *baseProductUsecase) DeleteByUID(ctx context.Context, UID string) error {
-	err := b.productRepository.DeleteByUID(UID)
+	err := b.productRepository.DeleteByUID(ctx, UID)
 	if err != nil {
 		return err
 	}
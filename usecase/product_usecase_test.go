@@ -66,7 +66,7 @@ func (s *ProductUsecaseSuite) BeforeTest(suiteName, testName string) {
 				UpdatedAt:       metadata.UpdatedAt,
 			}
 
-			_, err := s.repo.Create(payload)
+			_, err := s.repo.Create(s.ctx, payload)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -86,9 +86,9 @@ func (s *ProductUsecaseSuite) SetupTest() {
 
 	ctx := context.Background()
 	now := time.Now()
-	repo := repository.NewProductRepository(s.db)
-	aesEncryptUtil := utils.NewAesEncrypt(env.AesSecret)
 	productUtil := utils.NewProductUtil()
+	repo := repository.NewProductRepository(s.db, productUtil)
+	aesEncryptUtil := utils.NewAesEncrypt(env.AesSecret)
 
 	s.ctx = ctx
 	s.now = now
@@ -128,7 +128,7 @@ func (s *ProductUsecaseSuite) TestCreateUpdateProductUsecase() {
 		s.NoError(err)
 		createdProductUID = UID
 
-		product, err := s.repo.GetByUID(UID)
+		product, err := s.repo.GetByUID(s.ctx, UID)
 		s.NoError(err)
 		s.Equal(payload.Name, product.Name)
 		s.Equal("product-test-1", product.Slug)
@@ -155,7 +155,7 @@ func (s *ProductUsecaseSuite) TestCreateUpdateProductUsecase() {
 		s.NoError(err)
 		createdProductUID = UID
 
-		product, err := s.repo.GetByUID(UID)
+		product, err := s.repo.GetByUID(s.ctx, UID)
 		s.NoError(err)
 		s.Equal(payload.Name, product.Name)
 		s.Equal("product-test-2", product.Slug)
@@ -188,7 +188,7 @@ func (s *ProductUsecaseSuite) TestCreateUpdateProductUsecase() {
 		err := uc.UpdateByUID(s.ctx, createdProductUID, payload)
 		s.NoError(err)
 
-		product, err := s.repo.GetByUID(createdProductUID)
+		product, err := s.repo.GetByUID(s.ctx, createdProductUID)
 		s.NoError(err)
 		s.Equal(payload.Name, product.Name)
 		s.Equal("product-test-2-updated", product.Slug)
@@ -204,6 +204,20 @@ func (s *ProductUsecaseSuite) TestCreateUpdateProductUsecase() {
 		s.Equal(payload.SKU, product.SKU.String)
 	})
 
+	s.Run("Create products without sku", func() {
+		uc := usecase.NewProductUsecase(s.repo, s.aesEncryptUtil, s.productUtil)
+		for _, name := range []string{"Product Test 3", "Product Test 4"} {
+			payload.Name = name
+			payload.SKU = ""
+
+			UID, err := uc.Create(s.ctx, payload)
+			s.NoError(err)
+
+			product, err := s.repo.GetByUID(s.ctx, UID)
+			s.NoError(err)
+			s.False(product.SKU.Valid)
+		}
+	})
 }
 
 func (s *ProductUsecaseSuite) TestReadDeleteProductUsecase() {
@@ -297,7 +311,7 @@ func (s *ProductUsecaseSuite) TestReadDeleteProductUsecase() {
 		err := uc.DeleteByUID(s.ctx, s.productUIDS[0])
 		s.NoError(err)
 
-		product, err := s.repo.GetByUID(s.productUIDS[0])
+		product, err := s.repo.GetByUID(s.ctx, s.productUIDS[0])
 		s.NoError(err)
 		s.Nil(product)
 	})
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+)
+
+type baseRoleUsecase struct {
+	txManager          domain.TxManager
+	roleRepository     domain.RoleRepository
+	userRepository     domain.UserRepository
+	auditLogRepository domain.AuditLogRepository
+}
+
+func NewRoleUsecase(txManager domain.TxManager, roleRepository domain.RoleRepository, userRepository domain.UserRepository, auditLogRepository domain.AuditLogRepository) domain.RoleUsecase {
+	return &baseRoleUsecase{
+		txManager:          txManager,
+		roleRepository:     roleRepository,
+		userRepository:     userRepository,
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (b *baseRoleUsecase) ListRoles(ctx context.Context) ([]*domain.RoleControllerResponseRole, error) {
+	roles, err := b.roleRepository.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toResponse(ctx, roles)
+}
+
+func (b *baseRoleUsecase) ListUserRoles(ctx context.Context, userUID string) ([]*domain.RoleControllerResponseRole, error) {
+	user, err := b.userRepository.GetUserByUID(ctx, userUID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	roles, err := b.roleRepository.ListRolesByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toResponse(ctx, roles)
+}
+
+func (b *baseRoleUsecase) AssignRole(ctx context.Context, payload *domain.RoleUsecasePayloadUpdateUserRole) error {
+	user, role, err := b.getUserAndRole(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	return b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := b.roleRepository.AssignRole(ctx, user.ID, role.ID)
+		if err != nil {
+			return err
+		}
+
+		return b.createAuditLog(ctx, domain.AuditActionRoleAssign, payload, user.ID)
+	})
+}
+
+func (b *baseRoleUsecase) RemoveRole(ctx context.Context, payload *domain.RoleUsecasePayloadUpdateUserRole) error {
+	user, role, err := b.getUserAndRole(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	return b.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := b.roleRepository.RemoveRole(ctx, user.ID, role.ID)
+		if err != nil {
+			return err
+		}
+
+		return b.createAuditLog(ctx, domain.AuditActionRoleRemove, payload, user.ID)
+	})
+}
+
+func (b *baseRoleUsecase) HasPermission(ctx context.Context, userID int, permission string) (bool, error) {
+	return b.roleRepository.HasPermission(ctx, userID, permission)
+}
+
+// getUserAndRole refuses the super_admin role, it is kept in sync with the admins table by AdminUsecase.
+func (b *baseRoleUsecase) getUserAndRole(ctx context.Context, payload *domain.RoleUsecasePayloadUpdateUserRole) (*domain.UserModel, *domain.RoleModel, error) {
+	if payload.Role == domain.RoleSuperAdmin {
+		return nil, nil, domain.ErrRoleManagedByAdmin
+	}
+
+	user, err := b.userRepository.GetUserByUID(ctx, payload.UserUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, domain.ErrUserNotFound
+	}
+
+	role, err := b.roleRepository.GetRoleByName(ctx, payload.Role)
+	if err != nil {
+		return nil, nil, err
+	}
+	if role == nil {
+		return nil, nil, domain.ErrRoleNotFound
+	}
+
+	return user, role, nil
+}
+
+func (b *baseRoleUsecase) toResponse(ctx context.Context, roles []*domain.RoleModel) ([]*domain.RoleControllerResponseRole, error) {
+	res := make([]*domain.RoleControllerResponseRole, 0, len(roles))
+	for _, role := range roles {
+		permissions, err := b.roleRepository.ListPermissionsByRoleID(ctx, role.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &domain.RoleControllerResponseRole{
+			UID:         role.UID,
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		})
+	}
+
+	return res, nil
+}
+
+func (b *baseRoleUsecase) createAuditLog(ctx context.Context, action string, payload *domain.RoleUsecasePayloadUpdateUserRole, targetUserID int) error {
+	metadata := utils.GenerateMetadata()
+	return b.auditLogRepository.CreateAuditLog(ctx, &domain.AuditLogRepositoryPayloadCreateAuditLog{
+		UID:          metadata.UID(),
+		Action:       action,
+		ActorUserID:  sql.NullInt64{Int64: int64(payload.ActorUserID), Valid: payload.ActorUserID != 0},
+		TargetUserID: sql.NullInt64{Int64: int64(targetUserID), Valid: true},
+		IPAddress:    payload.IPAddress,
+		CreatedAt:    metadata.CreatedAt,
+	})
+}
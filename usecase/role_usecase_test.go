@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/ory/dockertest/v3"
+	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
+	"github.com/rizkyzhang/ayobeli-backend-golang/repository"
+	"github.com/rizkyzhang/ayobeli-backend-golang/usecase"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleUsecaseSuite struct {
+	suite.Suite
+	db           *sqlx.DB
+	pool         *dockertest.Pool
+	resource     *dockertest.Resource
+	ctx          context.Context
+	userRepo     domain.UserRepository
+	roleRepo     domain.RoleRepository
+	auditLogRepo domain.AuditLogRepository
+	user         *domain.UserModel
+}
+
+func (s *RoleUsecaseSuite) SetupTest() {
+	env := utils.LoadConfig("../.env")
+	pool, resource, db := utils.SetupTestDB(env)
+
+	s.pool = pool
+	s.resource = resource
+	s.db = db
+
+	s.ctx = context.Background()
+	s.userRepo = repository.NewUserRepository(s.db)
+	s.roleRepo = repository.NewRoleRepository(s.db)
+	s.auditLogRepo = repository.NewAuditLogRepository(s.db)
+
+	metadata := utils.GenerateMetadata()
+	email := gofakeit.Email()
+	_, err := s.userRepo.CreateUser(s.ctx, &domain.UserRepositoryPayloadCreateUser{
+		UID:       metadata.UID(),
+		Email:     email,
+		Name:      gofakeit.Name(),
+		CreatedAt: metadata.CreatedAt,
+		UpdatedAt: metadata.UpdatedAt,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.user, err = s.userRepo.GetUserByEmail(s.ctx, email)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (s *RoleUsecaseSuite) TearDownTest() {
+	if err := s.pool.Purge(s.resource); err != nil {
+		log.Fatalf("Could not purge resource: %s", err)
+	}
+}
+
+func TestRoleUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(RoleUsecaseSuite))
+}
+
+func (s *RoleUsecaseSuite) TestRoleUsecase() {
+	uc := usecase.NewRoleUsecase(repository.NewTxManager(s.db), s.roleRepo, s.userRepo, s.auditLogRepo)
+
+	s.Run("List roles should return seeded roles with permissions", func() {
+		roles, err := uc.ListRoles(s.ctx)
+		s.NoError(err)
+		s.Len(roles, 4)
+		s.Equal(domain.RoleSuperAdmin, roles[0].Name)
+		s.Contains(roles[0].Permissions, domain.PermissionProductWrite)
+	})
+
+	s.Run("Assign role should return error given super admin role", func() {
+		err := uc.AssignRole(s.ctx, &domain.RoleUsecasePayloadUpdateUserRole{UserUID: s.user.UID, Role: domain.RoleSuperAdmin})
+		s.ErrorIs(err, domain.ErrRoleManagedByAdmin)
+	})
+
+	s.Run("Assign role should grant its permissions only", func() {
+		err := uc.AssignRole(s.ctx, &domain.RoleUsecasePayloadUpdateUserRole{UserUID: s.user.UID, Role: "catalog_manager"})
+		s.NoError(err)
+
+		err = uc.AssignRole(s.ctx, &domain.RoleUsecasePayloadUpdateUserRole{UserUID: s.user.UID, Role: "catalog_manager"})
+		s.ErrorIs(err, domain.ErrRoleAlreadyExist)
+
+		hasPermission, err := uc.HasPermission(s.ctx, s.user.ID, domain.PermissionProductWrite)
+		s.NoError(err)
+		s.True(hasPermission)
+		hasPermission, err = uc.HasPermission(s.ctx, s.user.ID, domain.PermissionOrderWrite)
+		s.NoError(err)
+		s.False(hasPermission)
+
+		roles, err := uc.ListUserRoles(s.ctx, s.user.UID)
+		s.NoError(err)
+		s.Len(roles, 1)
+	})
+
+	s.Run("Remove role should revoke its permissions and be audit logged", func() {
+		err := uc.RemoveRole(s.ctx, &domain.RoleUsecasePayloadUpdateUserRole{UserUID: s.user.UID, Role: "catalog_manager"})
+		s.NoError(err)
+
+		err = uc.RemoveRole(s.ctx, &domain.RoleUsecasePayloadUpdateUserRole{UserUID: s.user.UID, Role: "catalog_manager"})
+		s.ErrorIs(err, domain.ErrUserRoleNotFound)
+
+		hasPermission, err := uc.HasPermission(s.ctx, s.user.ID, domain.PermissionProductWrite)
+		s.NoError(err)
+		s.False(hasPermission)
+
+		auditLogs, err := s.auditLogRepo.ListAuditLogsByTargetUserID(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Len(auditLogs, 2)
+		s.Equal(domain.AuditActionRoleAssign, auditLogs[0].Action)
+		s.Equal(domain.AuditActionRoleRemove, auditLogs[1].Action)
+	})
+}
@@ -1,25 +1,45 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/lucsky/cuid"
 	"github.com/rizkyzhang/ayobeli-backend-golang/domain"
+	"github.com/rizkyzhang/ayobeli-backend-golang/internal/utils"
 )
 
+var profileImageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
 type baseUserUsecase struct {
 	env            *domain.Env
+	loggerUtil     domain.LoggerUtil
 	userRepository domain.UserRepository
+	authUtil       domain.AuthUtil
+	storageUtil    domain.StorageUtil
 }
 
-func NewUserUsecase(env *domain.Env, userRepository domain.UserRepository) domain.UserUsecase {
+func NewUserUsecase(env *domain.Env, loggerUtil domain.LoggerUtil, userRepository domain.UserRepository, authUtil domain.AuthUtil, storageUtil domain.StorageUtil) domain.UserUsecase {
 	return &baseUserUsecase{
 		env:            env,
+		loggerUtil:     loggerUtil,
 		userRepository: userRepository,
+		authUtil:       authUtil,
+		storageUtil:    storageUtil,
 	}
 }
 
 func (b *baseUserUsecase) GetUserByFirebaseUID(ctx context.Context, UID string) (*domain.UserModel, error) {
-	user, err := b.userRepository.GetUserByFirebaseUID(UID)
+	user, err := b.userRepository.GetUserByFirebaseUID(ctx, UID)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +48,7 @@ func (b *baseUserUsecase) GetUserByFirebaseUID(ctx context.Context, UID string)
 }
 
 func (b *baseUserUsecase) GetUserByUID(ctx context.Context, UID string) (*domain.UserModel, error) {
-	user, err := b.userRepository.GetUserByUID(UID)
+	user, err := b.userRepository.GetUserByUID(ctx, UID)
 	if err != nil {
 		return nil, err
 	}
@@ -37,10 +57,140 @@ func (b *baseUserUsecase) GetUserByUID(ctx context.Context, UID string) (*domain
 }
 
 func (b *baseUserUsecase) GetAdminByUserID(ctx context.Context, userID int) (*domain.AdminModel, error) {
-	admin, err := b.userRepository.GetAdminByUserID(userID)
+	admin, err := b.userRepository.GetAdminByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	return admin, nil
 }
+
+func (b *baseUserUsecase) GetProfile(ctx context.Context, user *domain.UserModel) *domain.UserControllerResponseProfile {
+	return &domain.UserControllerResponseProfile{
+		UID:           user.UID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Phone:         user.Phone,
+		ProfileImage:  user.ProfileImage,
+		EmailVerified: user.EmailVerifiedAt.Valid,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+	}
+}
+
+// UpdateProfile only writes the fields of payload, user comes from the access token and may be stale.
+// Changing the email requires the current password and has to be verified again.
+func (b *baseUserUsecase) UpdateProfile(ctx context.Context, user *domain.UserModel, payload *domain.UserUsecasePayloadUpdateProfile) (*domain.UserControllerResponseProfile, error) {
+	userPayload := &domain.UserRepositoryPayloadUpdateProfile{
+		ID:        user.ID,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if payload.Name != nil {
+		name := strings.TrimSpace(*payload.Name)
+		userPayload.Name = &name
+	}
+	if payload.Phone != nil {
+		phone, err := utils.NormalizeIndonesianPhone(*payload.Phone)
+		if err != nil {
+			return nil, err
+		}
+		userPayload.Phone = &phone
+	}
+
+	var previousEmail string
+	if payload.Email != nil {
+		currentUser, err := b.userRepository.GetUserByUID(ctx, user.UID)
+		if err != nil {
+			return nil, err
+		}
+		if currentUser == nil {
+			return nil, domain.ErrUserNotFound
+		}
+
+		if !strings.EqualFold(*payload.Email, currentUser.Email) {
+			err = b.checkCurrentPassword(ctx, currentUser.Email, payload.CurrentPassword)
+			if err != nil {
+				return nil, err
+			}
+			existingUser, err := b.userRepository.GetUserByEmail(ctx, *payload.Email)
+			if err != nil {
+				return nil, err
+			}
+			if existingUser != nil {
+				return nil, domain.ErrEmailAlreadyExist
+			}
+
+			// The provider is updated first so a rejected email never reaches users.email
+			err = b.authUtil.UpdateEmail(ctx, currentUser.FirebaseUID, *payload.Email)
+			if err != nil {
+				return nil, err
+			}
+			userPayload.Email = payload.Email
+			previousEmail = currentUser.Email
+		}
+	}
+
+	updatedUser, err := b.userRepository.UpdateProfile(ctx, userPayload)
+	if err != nil {
+		if userPayload.Email != nil {
+			// Keeps the provider in sync with users.email, a failure leaves the user signing in with the new email
+			rollbackErr := b.authUtil.UpdateEmail(ctx, user.FirebaseUID, previousEmail)
+			if rollbackErr != nil {
+				b.loggerUtil.Errorf("failed to restore email of auth user %s after a failed profile update: %v", user.FirebaseUID, rollbackErr)
+			}
+		}
+		return nil, err
+	}
+
+	return b.GetProfile(ctx, updatedUser), nil
+}
+
+func (b *baseUserUsecase) UploadProfileImage(ctx context.Context, user *domain.UserModel, image io.Reader) (*domain.UserControllerResponseProfile, error) {
+	body, err := io.ReadAll(io.LimitReader(image, domain.MaxProfileImageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > domain.MaxProfileImageSize {
+		return nil, domain.ErrProfileImageTooLarge
+	}
+
+	contentType := http.DetectContentType(body)
+	ext, ok := profileImageExtensions[contentType]
+	if !ok {
+		return nil, domain.ErrUnsupportedProfileImage
+	}
+
+	key := fmt.Sprintf("profile-images/%s/%s.%s", user.UID, cuid.New(), ext)
+	url, err := b.storageUtil.Upload(ctx, key, contentType, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := b.userRepository.UpdateProfile(ctx, &domain.UserRepositoryPayloadUpdateProfile{
+		ID:           user.ID,
+		ProfileImage: &url,
+		UpdatedAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.GetProfile(ctx, updatedUser), nil
+}
+
+// checkCurrentPassword re-authenticates the user against the auth provider.
+func (b *baseUserUsecase) checkCurrentPassword(ctx context.Context, email string, password *string) error {
+	if password == nil || *password == "" {
+		return domain.ErrCurrentPasswordRequired
+	}
+
+	accessToken, err := b.authUtil.GetAccessToken(ctx, email, *password)
+	if err != nil {
+		return err
+	}
+	if accessToken == "" {
+		return domain.ErrInvalidCurrentPassword
+	}
+
+	return nil
+}
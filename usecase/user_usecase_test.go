@@ -1,7 +1,9 @@
 package usecase_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"log"
 	"testing"
 	"time"
@@ -30,6 +32,8 @@ type UserUsecaseSuite struct {
 	nowUTC       time.Time
 	userRepo     domain.UserRepository
 	userRepoMock *mocks.UserRepositoryMock
+	authUtil     *mocks.AuthUtilMock
+	storageUtil  *mocks.StorageUtilMock
 	user         *domain.UserModel
 }
 
@@ -44,7 +48,7 @@ func (s *UserUsecaseSuite) BeforeTest(suiteName, testName string) {
 		CreatedAt:    time.Now().UTC(),
 		UpdatedAt:    time.Now().UTC(),
 	}
-	userID, err := s.userRepo.CreateUser(userPayload)
+	userID, err := s.userRepo.CreateUser(s.ctx, userPayload)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -55,12 +59,12 @@ func (s *UserUsecaseSuite) BeforeTest(suiteName, testName string) {
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 	}
-	err = s.userRepo.CreateAdmin(adminPayload)
+	err = s.userRepo.CreateAdmin(s.ctx, adminPayload)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	user, err := s.userRepo.GetUserByUID(userPayload.UID)
+	user, err := s.userRepo.GetUserByUID(s.ctx, userPayload.UID)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -85,6 +89,8 @@ func (s *UserUsecaseSuite) SetupTest() {
 	s.nowUTC = now.UTC()
 	s.userRepo = userRepo
 	s.userRepoMock = userRepoMock
+	s.authUtil = &mocks.AuthUtilMock{}
+	s.storageUtil = &mocks.StorageUtilMock{}
 }
 
 func (s *UserUsecaseSuite) TearDownTest() {
@@ -99,23 +105,173 @@ func TestUserUsecase(t *testing.T) {
 
 func (s *UserUsecaseSuite) TestUserUsecase() {
 	s.Run("Get user by firebase uid should be successful", func() {
-		uc := usecase.NewUserUsecase(s.env, s.userRepo)
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
 		user, err := uc.GetUserByFirebaseUID(s.ctx, s.user.FirebaseUID)
 		s.NoError(err)
 		s.Equal(s.user, user)
 	})
 
 	s.Run("Get user by uid should be successful", func() {
-		uc := usecase.NewUserUsecase(s.env, s.userRepo)
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
 		user, err := uc.GetUserByUID(s.ctx, s.user.UID)
 		s.NoError(err)
 		s.Equal(s.user, user)
 	})
 
 	s.Run("Get admin by user id should be successful", func() {
-		uc := usecase.NewUserUsecase(s.env, s.userRepo)
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
 		admin, err := uc.GetAdminByUserID(s.ctx, s.user.ID)
 		s.NoError(err)
 		s.Equal(s.user.ID, admin.UserID)
 	})
 }
+
+func (s *UserUsecaseSuite) TestUpdateProfile() {
+	password := "test1234"
+
+	s.Run("Update name and phone should normalize phone and persist", func() {
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		name := "Budi Santoso"
+		phone := "0812-3456-7890"
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Name: &name, Phone: &phone})
+		s.NoError(err)
+		s.Equal(name, profile.Name)
+		s.Equal("+6281234567890", profile.Phone)
+		s.Equal(s.user.Email, profile.Email)
+
+		user, err := s.userRepo.GetUserByUID(s.ctx, s.user.UID)
+		s.NoError(err)
+		s.Equal(name, user.Name)
+		s.Equal("+6281234567890", user.Phone)
+		s.Equal(0, s.authUtil.UpdateEmailCallCount())
+	})
+
+	s.Run("Update name should keep fields changed after the user was loaded", func() {
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		staleUser := *s.user
+		phone := "081298765432"
+		_, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Phone: &phone})
+		s.NoError(err)
+
+		name := gofakeit.Name()
+		profile, err := uc.UpdateProfile(s.ctx, &staleUser, &domain.UserUsecasePayloadUpdateProfile{Name: &name})
+		s.NoError(err)
+		s.Equal(name, profile.Name)
+		s.Equal("+6281298765432", profile.Phone)
+	})
+
+	s.Run("Update with non Indonesian phone should fail", func() {
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		phone := "+14155552671"
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Phone: &phone})
+		s.ErrorIs(err, domain.ErrInvalidPhone)
+		s.Nil(profile)
+	})
+
+	s.Run("Update email without current password should fail", func() {
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		email := gofakeit.Email()
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Email: &email})
+		s.ErrorIs(err, domain.ErrCurrentPasswordRequired)
+		s.Nil(profile)
+		s.Equal(0, s.authUtil.UpdateEmailCallCount())
+	})
+
+	s.Run("Update email with wrong current password should fail", func() {
+		s.authUtil.GetAccessTokenReturns("", nil)
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		email := gofakeit.Email()
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Email: &email, CurrentPassword: &password})
+		s.ErrorIs(err, domain.ErrInvalidCurrentPassword)
+		s.Nil(profile)
+		s.Equal(0, s.authUtil.UpdateEmailCallCount())
+	})
+
+	s.Run("Update email should update auth provider and users.email", func() {
+		s.authUtil.GetAccessTokenReturns(gofakeit.UUID(), nil)
+		s.authUtil.UpdateEmailReturns(nil)
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		email := gofakeit.Email()
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Email: &email, CurrentPassword: &password})
+		s.NoError(err)
+		s.Equal(email, profile.Email)
+		s.False(profile.EmailVerified)
+		_, providerEmail, providerPassword := s.authUtil.GetAccessTokenArgsForCall(s.authUtil.GetAccessTokenCallCount() - 1)
+		s.Equal(s.user.Email, providerEmail)
+		s.Equal(password, providerPassword)
+
+		_, authUID, providerEmail := s.authUtil.UpdateEmailArgsForCall(s.authUtil.UpdateEmailCallCount() - 1)
+		s.Equal(s.user.FirebaseUID, authUID)
+		s.Equal(email, providerEmail)
+		user, err := s.userRepo.GetUserByEmail(s.ctx, email)
+		s.NoError(err)
+		s.Equal(s.user.ID, user.ID)
+		admin, err := s.userRepo.GetAdminByUserID(s.ctx, s.user.ID)
+		s.NoError(err)
+		s.Equal(email, admin.Email)
+	})
+
+	s.Run("Update email used by another user should fail", func() {
+		otherUserPayload := &domain.UserRepositoryPayloadCreateUser{
+			UID:         gofakeit.UUID(),
+			FirebaseUID: gofakeit.UUID(),
+			Email:       gofakeit.Email(),
+			CreatedAt:   s.nowUTC,
+			UpdatedAt:   s.nowUTC,
+		}
+		_, err := s.userRepo.CreateUser(s.ctx, otherUserPayload)
+		s.NoError(err)
+
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		callCount := s.authUtil.UpdateEmailCallCount()
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Email: &otherUserPayload.Email, CurrentPassword: &password})
+		s.ErrorIs(err, domain.ErrEmailAlreadyExist)
+		s.Nil(profile)
+		s.Equal(callCount, s.authUtil.UpdateEmailCallCount())
+	})
+
+	s.Run("Update email rejected by auth provider should not change users.email", func() {
+		s.authUtil.UpdateEmailReturns(errors.New("email already exists"))
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		email := gofakeit.Email()
+		profile, err := uc.UpdateProfile(s.ctx, s.user, &domain.UserUsecasePayloadUpdateProfile{Email: &email, CurrentPassword: &password})
+		s.Error(err)
+		s.Nil(profile)
+
+		user, err := s.userRepo.GetUserByEmail(s.ctx, email)
+		s.NoError(err)
+		s.Nil(user)
+	})
+}
+
+func (s *UserUsecaseSuite) TestUploadProfileImage() {
+	s.Run("Upload png should store image and update profile image", func() {
+		s.storageUtil.UploadReturns("/uploads/profile.png", nil)
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		png := []byte("\x89PNG\r\n\x1a\n0000")
+		profile, err := uc.UploadProfileImage(s.ctx, s.user, bytes.NewReader(png))
+		s.NoError(err)
+		s.Equal("/uploads/profile.png", profile.ProfileImage)
+
+		_, key, contentType, _ := s.storageUtil.UploadArgsForCall(0)
+		s.Contains(key, "profile-images/"+s.user.UID+"/")
+		s.Equal("image/png", contentType)
+		user, err := s.userRepo.GetUserByUID(s.ctx, s.user.UID)
+		s.NoError(err)
+		s.Equal("/uploads/profile.png", user.ProfileImage)
+	})
+
+	s.Run("Upload non image should fail", func() {
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		profile, err := uc.UploadProfileImage(s.ctx, s.user, bytes.NewReader([]byte("plain text")))
+		s.ErrorIs(err, domain.ErrUnsupportedProfileImage)
+		s.Nil(profile)
+	})
+
+	s.Run("Upload image larger than limit should fail", func() {
+		uc := usecase.NewUserUsecase(s.env, utils.NewLoggerUtil(s.env), s.userRepo, s.authUtil, s.storageUtil)
+		profile, err := uc.UploadProfileImage(s.ctx, s.user, bytes.NewReader(make([]byte, domain.MaxProfileImageSize+1)))
+		s.ErrorIs(err, domain.ErrProfileImageTooLarge)
+		s.Nil(profile)
+	})
+}